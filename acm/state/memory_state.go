@@ -1,7 +1,9 @@
 package state
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/binary"
@@ -79,3 +81,42 @@ func (ms *MemoryState) IterateStorage(address crypto.Address, consumer func(key,
 	}
 	return false, nil
 }
+
+func (ms *MemoryState) IterateAccountsFrom(start crypto.Address,
+	consumer func(acm.Account) (stop bool)) (stopped bool, err error) {
+	addresses := make([]crypto.Address, 0, len(ms.Accounts))
+	for address := range ms.Accounts {
+		if bytes.Compare(address.Bytes(), start.Bytes()) >= 0 {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	for _, address := range addresses {
+		if consumer(ms.Accounts[address]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (ms *MemoryState) IterateStorageRange(address crypto.Address, start, end []byte,
+	consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error) {
+	storage := ms.Storage[address]
+	keys := make([]binary.Word256, 0, len(storage))
+	for key := range storage {
+		if (start == nil || bytes.Compare(key.Bytes(), start) >= 0) && (end == nil || bytes.Compare(key.Bytes(), end) < 0) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+	})
+	for _, key := range keys {
+		if consumer(key, storage[key]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
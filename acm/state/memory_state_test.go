@@ -0,0 +1,54 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryState_IterateAccountsFrom(t *testing.T) {
+	ms := NewMemoryState()
+	for _, b := range []byte{4, 2, 5, 1, 3} {
+		require.NoError(t, ms.UpdateAccount(acm.ConcreteAccount{Address: crypto.Address{b}}.Account()))
+	}
+	var listed []crypto.Address
+	_, err := ms.IterateAccountsFrom(crypto.Address{3}, func(acc acm.Account) (stop bool) {
+		listed = append(listed, acc.Address())
+		return len(listed) == 2
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.Address{{3}, {4}}, listed)
+}
+
+func TestMemoryState_IterateStorageRange(t *testing.T) {
+	ms := NewMemoryState()
+	address := crypto.Address{1}
+	for _, key := range []binary.Word256{{0x12}, {0x11, 0x02}, {0x10}, {0x11, 0x01}} {
+		require.NoError(t, ms.SetStorage(address, key, binary.Word256{0xff}))
+	}
+	var listed []binary.Word256
+	start, end := KeyPrefixRange([]byte{0x11})
+	_, err := ms.IterateStorageRange(address, start, end, func(key, value binary.Word256) (stop bool) {
+		listed = append(listed, key)
+		return
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []binary.Word256{{0x11, 0x01}, {0x11, 0x02}}, listed)
+}
+
+func TestKeyPrefixRange(t *testing.T) {
+	start, end := KeyPrefixRange([]byte{0x01, 0xff})
+	assert.Equal(t, []byte{0x01, 0xff}, start)
+	assert.Equal(t, []byte{0x02}, end)
+
+	_, end = KeyPrefixRange([]byte{0xff, 0xff})
+	assert.Nil(t, end)
+
+	start, end = KeyPrefixRange(nil)
+	assert.Nil(t, start)
+	assert.Nil(t, end)
+}
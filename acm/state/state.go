@@ -17,6 +17,9 @@ type AccountIterable interface {
 	// returns true the iteration breaks and returns true to indicate it iteration
 	// was escaped
 	IterateAccounts(consumer func(acm.Account) (stop bool)) (stopped bool, err error)
+	// Iterates through accounts in address order beginning with the account at start, or the next account after
+	// start if there is none, so that listings may be paged through
+	IterateAccountsFrom(start crypto.Address, consumer func(acm.Account) (stop bool)) (stopped bool, err error)
 }
 
 type AccountUpdater interface {
@@ -43,6 +46,10 @@ type StorageIterable interface {
 	// if the iterator function returns true the iteration breaks and returns true to indicate it iteration
 	// was escaped
 	IterateStorage(address crypto.Address, consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error)
+	// Iterates through the storage of account at address in key order over keys k with start <= k < end, where a nil
+	// start or end leaves that side of the range unbounded. Keys are compared as left-aligned byte strings.
+	IterateStorageRange(address crypto.Address, start, end []byte,
+		consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error)
 }
 
 // Compositions
@@ -81,6 +88,24 @@ type IterableReaderWriter interface {
 	Writer
 }
 
+// KeyPrefixRange returns the range of storage keys beginning with prefix for use with IterateStorageRange. The end of
+// the range is nil if there is no key above every key with prefix.
+func KeyPrefixRange(prefix []byte) (start, end []byte) {
+	if len(prefix) == 0 {
+		return nil, nil
+	}
+	start = prefix
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			end = make([]byte, i+1)
+			copy(end, prefix)
+			end[i]++
+			return
+		}
+	}
+	return
+}
+
 func GetConcreteAccount(getter AccountGetter, address crypto.Address) (*acm.ConcreteAccount, error) {
 	acc, err := getter.GetAccount(address)
 	if err != nil {
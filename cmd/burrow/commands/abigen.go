@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/deploy/abigen"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/keys/common"
+	cli "github.com/jawher/mow.cli"
+)
+
+// Abigen generates typed Go bindings to contracts
+func Abigen(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		packageOpt := cmd.StringOpt("p package", "",
+			"package of the generated files, by default named after the output directory")
+
+		outputOpt := cmd.StringOpt("o output", ".", "directory to write a <Contract>.abi.go file to for each contract")
+
+		compilerCacheOpt := cmd.String(cli.StringOpt{
+			Name:   "compiler-cache",
+			Desc:   "directory of compiler binaries from which the version given by --solc or --vyper is taken",
+			Value:  filepath.Join(common.HomeDir(), ".burrow", "compilers"),
+			EnvVar: "BURROW_COMPILER_CACHE",
+		})
+
+		solcOpt := cmd.StringOpt("solc", "", "version of solc to compile Solidity sources with")
+
+		vyperOpt := cmd.StringOpt("vyper", "", "version of vyper to compile Vyper sources with")
+
+		librariesOpt := cmd.StringsOpt("l libraries", nil,
+			"addresses of libraries to link contracts against as name:address, without which contracts that use "+
+				"libraries cannot be deployed by their bindings")
+
+		filesArg := cmd.StringsArg("FILE", nil,
+			"plain ABIs (.abi or .json), contracts written by burrow deploy (.bin), contract sources (.sol or .vy), "+
+				"or Truffle and Hardhat artifacts (.json)")
+
+		cmd.Spec = "[OPTIONS] FILE..."
+
+		cmd.Action = func() {
+			versions := make(map[string]string)
+			if *solcOpt != "" {
+				versions["solc"] = *solcOpt
+			}
+			if *vyperOpt != "" {
+				versions["vyper"] = *vyperOpt
+			}
+			compilers, err := compile.NewCompilers(*compilerCacheOpt, versions)
+			if err != nil {
+				output.Fatalf("%v", err)
+			}
+
+			libraries := make(map[string]string)
+			for _, l := range *librariesOpt {
+				v := strings.Split(l, ":")
+				if len(v) != 2 {
+					output.Fatalf("library %s should be name:address", l)
+				}
+				libraries[v[0]] = v[1]
+			}
+
+			dir, err := filepath.Abs(*outputOpt)
+			if err != nil {
+				output.Fatalf("%v", err)
+			}
+			pkg := *packageOpt
+			if pkg == "" {
+				pkg = strings.Replace(filepath.Base(dir), "-", "_", -1)
+			}
+			err = os.MkdirAll(dir, 0755)
+			if err != nil {
+				output.Fatalf("could not make output directory: %v", err)
+			}
+
+			for _, file := range *filesArg {
+				contracts, err := abigen.ReadContracts(file, compilers, libraries)
+				if err != nil {
+					output.Fatalf("could not read contracts from %s: %v", file, err)
+				}
+				for _, contract := range contracts {
+					if contract.Unlinked() {
+						output.Logf("%s uses libraries not given by --libraries so its binding cannot deploy it",
+							contract.Name)
+					}
+					src, err := abigen.Generate(pkg, contract)
+					if err != nil {
+						output.Fatalf("could not generate binding to %s: %v", contract.Name, err)
+					}
+					out := filepath.Join(dir, contract.Name+".abi.go")
+					err = ioutil.WriteFile(out, src, 0644)
+					if err != nil {
+						output.Fatalf("could not write binding: %v", err)
+					}
+					output.Printf("%s", out)
+				}
+			}
+		}
+	}
+}
@@ -114,12 +114,20 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				if err != nil {
 					output.Fatalf("Could not read GenesisSpec: %v", err)
 				}
+				// Addresses of accounts must be derived as they will be on the chain
+				err = crypto.SetAddressDerivation(genesisSpec.AddressDerivation)
+				if err != nil {
+					output.Fatalf("Could not set address derivation of GenesisSpec: %v", err)
+				}
 				if conf.Keys.RemoteAddress == "" {
-					dir := conf.Keys.KeysDirectory
+					keysConf := *conf.Keys
 					if *keysDir != "" {
-						dir = *keysDir
+						keysConf.KeysDirectory = *keysDir
+					}
+					keyStore, err := keys.NewKeyStoreFromConfig(&keysConf, logging.NewNoopLogger())
+					if err != nil {
+						output.Fatalf("Could not open keys: %v", err)
 					}
-					keyStore := keys.NewKeyStore(dir, conf.Keys.AllowBadFilePermissions, logging.NewNoopLogger())
 
 					keyClient := keys.NewLocalKeyClient(keyStore, logging.NewNoopLogger())
 					conf.GenesisDoc, err = genesisSpec.GenesisDoc(keyClient, *generateNodeKeys)
@@ -177,7 +185,7 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 						}
 					}
 				} else {
-					keyClient, err := keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, logging.NewNoopLogger())
+					keyClient, err := keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, conf.Keys.RemoteTLS, logging.NewNoopLogger())
 					if err != nil {
 						output.Fatalf("Could not create remote key client: %v", err)
 					}
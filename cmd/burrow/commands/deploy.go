@@ -3,10 +3,12 @@ package commands
 import (
 	"bytes"
 	"fmt"
+	"path/filepath"
 
 	"github.com/hyperledger/burrow/deploy"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/keys/common"
 	cli "github.com/jawher/mow.cli"
 	log "github.com/sirupsen/logrus"
 )
@@ -47,10 +49,28 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 		defaultAmountOpt := cmd.StringOpt("m amount", "9999",
 			"default amount to use")
 
+		concurrencyOpt := cmd.IntOpt("j jobs", 1,
+			"number of jobs to run concurrently; jobs wait only for the jobs they refer to and transactions are "+
+				"broadcast in the order they appear in the deploy file")
+
+		forceOpt := cmd.StringsOpt("force", []string{},
+			"rerun the named job even if the deploy state file shows it was done by a previous run against the same chain")
+
+		compilerCacheOpt := cmd.String(cli.StringOpt{
+			Name:   "compiler-cache",
+			Desc:   "directory of compiler binaries from which versions pinned by the compilers section of the deploy file are taken",
+			Value:  filepath.Join(common.HomeDir(), ".burrow", "compilers"),
+			EnvVar: "BURROW_COMPILER_CACHE",
+		})
+
 		verboseOpt := cmd.BoolOpt("v verbose", false, "verbose output")
 
 		debugOpt := cmd.BoolOpt("d debug", false, "debug level output")
 
+		tlsConf := tlsClientOpts(cmd)
+
+		keysTLSConf := keysTLSClientOpts(cmd)
+
 		cmd.Action = func() {
 			do := new(def.Packages)
 
@@ -66,8 +86,13 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 			do.Address = *addressOpt
 			do.DefaultFee = *defaultFeeOpt
 			do.DefaultAmount = *defaultAmountOpt
+			do.Concurrency = *concurrencyOpt
+			do.Force = *forceOpt
+			do.CompilerCache = *compilerCacheOpt
 			do.Verbose = *verboseOpt
 			do.Debug = *debugOpt
+			do.ChainTLS = tlsConf()
+			do.KeysTLS = keysTLSConf(do.ChainTLS)
 			log.SetFormatter(new(PlainFormatter))
 			log.SetLevel(log.WarnLevel)
 			if do.Verbose {
@@ -9,6 +9,8 @@ import (
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/genesis"
 	logging_config "github.com/hyperledger/burrow/logging/logconfig"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
+	cli "github.com/jawher/mow.cli"
 )
 
 type Output interface {
@@ -100,3 +102,44 @@ func parseRange(rangeString string) (start int64, end int64, err error) {
 	}
 	return 0, 0, fmt.Errorf("could not parse range from %s", rangeString)
 }
+
+// Adds options for connecting to a GRPC server over TLS, the returned function provides the resulting config once the
+// command line has been parsed
+func tlsClientOpts(cmd *cli.Cmd) func() *tlsconfig.ClientConfig {
+	tlsOpt := cmd.BoolOpt("tls", false, "Connect using TLS (implied by the other --tls-* options)")
+	caOpt := cmd.StringOpt("tls-ca", "",
+		"PEM file of CAs used to verify the server's TLS certificate, the system roots are used if not provided")
+	certOpt := cmd.StringOpt("tls-cert", "", "PEM file of client certificate for servers that require one")
+	keyOpt := cmd.StringOpt("tls-key", "", "PEM file of client certificate key for servers that require one")
+	return func() *tlsconfig.ClientConfig {
+		return &tlsconfig.ClientConfig{
+			Enabled:  *tlsOpt || *caOpt != "" || *certOpt != "" || *keyOpt != "",
+			CAFile:   *caOpt,
+			CertFile: *certOpt,
+			KeyFile:  *keyOpt,
+		}
+	}
+}
+
+// Options for the TLS connection to a keys server, which is configured as the connection to the chain is when none
+// of them are given
+func keysTLSClientOpts(cmd *cli.Cmd) func(chainConf *tlsconfig.ClientConfig) *tlsconfig.ClientConfig {
+	tlsOpt := cmd.BoolOpt("keys-tls", false, "Connect to the keys server using TLS (implied by the other "+
+		"--keys-tls-* options), the --tls-* options are used for the keys server if no --keys-tls-* option is given")
+	caOpt := cmd.StringOpt("keys-tls-ca", "",
+		"PEM file of CAs used to verify the keys server's TLS certificate, the system roots are used if not provided")
+	certOpt := cmd.StringOpt("keys-tls-cert", "", "PEM file of client certificate for a keys server that requires one")
+	keyOpt := cmd.StringOpt("keys-tls-key", "",
+		"PEM file of client certificate key for a keys server that requires one")
+	return func(chainConf *tlsconfig.ClientConfig) *tlsconfig.ClientConfig {
+		if !*tlsOpt && *caOpt == "" && *certOpt == "" && *keyOpt == "" {
+			return chainConf
+		}
+		return &tlsconfig.ClientConfig{
+			Enabled:  true,
+			CAFile:   *caOpt,
+			CertFile: *certOpt,
+			KeyFile:  *keyOpt,
+		}
+	}
+}
@@ -3,8 +3,12 @@ package commands
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
+	"os/signal"
+	"path"
+	"syscall"
 
 	"github.com/hyperledger/burrow/deployment"
 	cli "github.com/jawher/mow.cli"
@@ -17,7 +21,9 @@ import (
 	"github.com/hyperledger/burrow/config"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/keys/hd"
 	"github.com/hyperledger/burrow/logging/lifecycle"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
 	"google.golang.org/grpc"
 )
 
@@ -37,9 +43,15 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			EnvVar: "MONAX_KEYS_PORT",
 		})
 
+		tlsConf := tlsClientOpts(cmd)
+
 		grpcKeysClient := func(output Output) keys.KeysClient {
 			var opts []grpc.DialOption
-			opts = append(opts, grpc.WithInsecure())
+			transportOpt, err := tlsConf().GRPCDialOption()
+			if err != nil {
+				output.Fatalf("Could not configure TLS: %v", err)
+			}
+			opts = append(opts, transportOpt)
 			conn, err := grpc.Dial(*keysHost+":"+*keysPort, opts...)
 			if err != nil {
 				output.Fatalf("Failed to connect to grpc server: %v", err)
@@ -74,7 +86,41 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 					conf.Keys.KeysDirectory = *keysDir
 				}
 
-				err = keys.StartStandAloneServer(conf.Keys.KeysDirectory, *keysHost, *keysPort, conf.Keys.AllowBadFilePermissions, logger)
+				tlsServer, err := tlsconfig.NewServer(conf.Keys.ServerTLS)
+				if err != nil {
+					output.Fatalf("Could not load TLS certificates: %v", err)
+				}
+				var opts []grpc.ServerOption
+				if tlsServer != nil {
+					opts = append(opts, tlsServer.GRPCServerOption())
+					reloadCh := make(chan os.Signal, 1)
+					signal.Notify(reloadCh, syscall.SIGHUP)
+					go func() {
+						for range reloadCh {
+							err := tlsServer.Reload()
+							if err != nil {
+								output.Logf("Could not reload TLS certificates, continuing to use existing certificates: %v",
+									err)
+							}
+						}
+					}()
+				}
+
+				addressDerivation := conf.Keys.AddressDerivation
+				if conf.GenesisDoc != nil && conf.GenesisDoc.AddressDerivation != "" {
+					addressDerivation = conf.GenesisDoc.AddressDerivation
+				}
+				err = crypto.SetAddressDerivation(addressDerivation)
+				if err != nil {
+					output.Fatalf("Could not set address derivation: %v", err)
+				}
+
+				keyStore, err := keys.NewKeyStoreFromConfig(conf.Keys, logger)
+				if err != nil {
+					output.Fatalf("Could not open keys backend: %v", err)
+				}
+
+				err = keys.StartKeyStoreServer(keyStore, *keysHost, *keysPort, opts...)
 				if err != nil {
 					output.Fatalf("Failed to start server: %v", err)
 				}
@@ -84,7 +130,7 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 		cmd.Command("gen", "Generates a key using (insert crypto pkgs used)", func(cmd *cli.Cmd) {
 			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this key")
 
-			keyType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+			keyType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint), 'bls12-381' (aggregate signatures)")
 
 			keyName := cmd.StringOpt("name", "", "name of key to use")
 
@@ -116,6 +162,97 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("seed", "Generates or imports a BIP39 mnemonic seed from which keys can be derived", func(cmd *cli.Cmd) {
+			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this seed")
+
+			seedName := cmd.StringOpt("seed-name", keys.DefaultSeedName, "name under which to store the seed")
+
+			mnemonic := cmd.StringOpt("mnemonic", "", "import an existing mnemonic rather than generating one")
+
+			mnemonicPassphrase := cmd.StringOpt("mnemonic-passphrase", "", "optional BIP39 passphrase used with the mnemonic")
+
+			bits := cmd.IntOpt("bits", hd.DefaultEntropyBits, "bits of entropy for a generated mnemonic (128-256)")
+
+			cmd.Action = func() {
+				var password string
+				if !*noPassword {
+					fmt.Printf("Enter Password:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					password = string(pwd)
+				}
+
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.GenerateFromSeed(ctx, &keys.GenerateFromSeedRequest{
+					Passphrase:         password,
+					SeedName:           *seedName,
+					Mnemonic:           *mnemonic,
+					MnemonicPassphrase: *mnemonicPassphrase,
+					EntropyBits:        uint32(*bits),
+				})
+				if err != nil {
+					output.Fatalf("failed to store seed: %v", err)
+				}
+
+				if resp.GetMnemonic() != "" {
+					output.Logf("Write down this mnemonic, it is the only way to recover keys derived from seed '%s'",
+						resp.GetSeedName())
+					fmt.Printf("%v\n", resp.GetMnemonic())
+				}
+			}
+		})
+
+		cmd.Command("derive", "Derives a key from a stored seed along a BIP32/SLIP-0010 path", func(cmd *cli.Cmd) {
+			noPassword := cmd.BoolOpt("n no-password", false, "the seed and derived key have no password")
+
+			seedName := cmd.StringOpt("seed-name", keys.DefaultSeedName, "name of the seed to derive from")
+
+			path := cmd.StringOpt("path", "", fmt.Sprintf("derivation path, defaults to %s for secp256k1 and %s for ed25519",
+				hd.DefaultSecp256k1Path, hd.DefaultEd25519Path))
+
+			keyType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to derive. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+
+			keyName := cmd.StringOpt("name", "", "name of key to use")
+
+			cmd.Action = func() {
+				curve, err := crypto.CurveTypeFromString(*keyType)
+				if err != nil {
+					output.Fatalf("Unrecognised curve type %v", *keyType)
+				}
+
+				var password string
+				if !*noPassword {
+					fmt.Printf("Enter Password:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					password = string(pwd)
+				}
+
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.DeriveKey(ctx, &keys.DeriveKeyRequest{
+					Passphrase: password,
+					SeedName:   *seedName,
+					Path:       *path,
+					CurveType:  curve.String(),
+					KeyName:    *keyName,
+				})
+				if err != nil {
+					output.Fatalf("failed to derive key: %v", err)
+				}
+
+				output.Logf("Derived key at %s", resp.GetPath())
+				fmt.Printf("%v\n", resp.GetAddress())
+			}
+		})
+
 		cmd.Command("hash", "hash <some data>", func(cmd *cli.Cmd) {
 			hashType := cmd.StringOpt("t type", keys.DefaultHashType, "specify the hash function to use")
 
@@ -147,21 +284,29 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
-		cmd.Command("export", "Export a key to tendermint format", func(cmd *cli.Cmd) {
+		cmd.Command("export", "Export a key via a template or in burrow, ethereum, or tendermint key format", func(cmd *cli.Cmd) {
 			keyName := cmd.StringOpt("name", "", "name of key to use")
 			keyAddr := cmd.StringOpt("addr", "", "address of key to use")
 			passphrase := cmd.StringOpt("passphrase", "", "passphrase for encrypted key")
 			keyTemplate := cmd.StringOpt("t template", deployment.DefaultKeysExportFormat, "template for export key")
+			format := cmd.StringOpt("f format", "", "export in 'burrow', 'ethereum' (V3 keystore encrypted with "+
+				"passphrase), or 'tendermint' (priv_validator_key.json) format rather than via template")
 
 			cmd.Action = func() {
 				c := grpcKeysClient(output)
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
-				resp, err := c.Export(ctx, &keys.ExportRequest{Passphrase: *passphrase, Name: *keyName, Address: *keyAddr})
+				resp, err := c.Export(ctx, &keys.ExportRequest{Passphrase: *passphrase, Name: *keyName, Address: *keyAddr,
+					Format: *format})
 				if err != nil {
 					output.Fatalf("failed to export key: %v", err)
 				}
 
+				if *format != "" {
+					fmt.Printf("%s\n", resp.GetJSON())
+					return
+				}
+
 				addr, err := crypto.AddressFromBytes(resp.GetAddress())
 				if err != nil {
 					output.Fatalf("failed to convert address: %v", err)
@@ -184,8 +329,9 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
-		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json>", func(cmd *cli.Cmd) {
-			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+		cmd.Command("import", "import <priv key> | /path/to/keyfile | <key json> where key files and json may be "+
+			"burrow, ethereum V3 keystore, or tendermint priv_validator_key.json", func(cmd *cli.Cmd) {
+			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint), 'bls12-381' (aggregate signatures)")
 			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for this key")
 			key := cmd.StringArg("KEY", "", "private key, filename, or raw json")
 
@@ -211,7 +357,7 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 				defer cancel()
 
 				if (*key)[:1] == "{" {
-					resp, err := c.ImportJSON(ctx, &keys.ImportJSONRequest{JSON: *key})
+					resp, err := c.ImportJSON(ctx, &keys.ImportJSONRequest{Passphrase: password, JSON: *key})
 					if err != nil {
 						output.Fatalf("failed to import json key: %v", err)
 					}
@@ -273,8 +419,76 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("unlock", "unlock a key so that it signs without a passphrase for a time", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key to unlock")
+			addr := cmd.StringOpt("addr", "", "address of key to unlock")
+			passphrase := cmd.StringOpt("passphrase", "", "passphrase for encrypted key")
+			timeout := cmd.IntOpt("t timeout", int(keys.DefaultUnlockTimeout/time.Second), "seconds for which the key remains unlocked")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.UnlockKey(ctx, &keys.UnlockRequest{Passphrase: *passphrase, Name: *name, Address: *addr,
+					Timeout: uint64(*timeout)})
+				if err != nil {
+					output.Fatalf("failed to unlock key: %v", err)
+				}
+				output.Logf("Unlocked %s for %d seconds", resp.GetAddress(), resp.GetTimeout())
+			}
+		})
+
+		cmd.Command("lock", "lock an unlocked key, or all keys if none is given", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key to lock")
+			addr := cmd.StringOpt("addr", "", "address of key to lock")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				_, err := c.LockKey(ctx, &keys.LockRequest{Name: *name, Address: *addr})
+				if err != nil {
+					output.Fatalf("failed to lock key: %v", err)
+				}
+			}
+		})
+
+		cmd.Command("passwd", "change the passphrase of a key", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key")
+			addr := cmd.StringOpt("addr", "", "address of key")
+			passphrase := cmd.StringOpt("passphrase", "", "current passphrase of key")
+			newPassphrase := cmd.StringOpt("new-passphrase", "", "new passphrase of key")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				_, err := c.ChangePassphrase(ctx, &keys.ChangePassphraseRequest{Passphrase: *passphrase,
+					NewPassphrase: *newPassphrase, Name: *name, Address: *addr})
+				if err != nil {
+					output.Fatalf("failed to change passphrase: %v", err)
+				}
+			}
+		})
+
+		cmd.Command("reencrypt", "re-encrypt a key with the encryption parameters currently configured", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key")
+			addr := cmd.StringOpt("addr", "", "address of key")
+			passphrase := cmd.StringOpt("passphrase", "", "passphrase of key")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				_, err := c.ReEncrypt(ctx, &keys.ReEncryptRequest{Passphrase: *passphrase, Name: *name, Address: *addr})
+				if err != nil {
+					output.Fatalf("failed to re-encrypt key: %v", err)
+				}
+			}
+		})
+
 		cmd.Command("verify", "verify <some data> <sig> <pubkey>", func(cmd *cli.Cmd) {
-			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint), 'bls12-381' (aggregate signatures)")
 
 			msg := cmd.StringArg("MSG", "", "hash/message to check")
 			sig := cmd.StringArg("SIG", "", "signature")
@@ -350,6 +564,116 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("audit", "show the audit log of sign requests, or verify an audit log file", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "only show requests to sign with the key of this name")
+			addr := cmd.StringOpt("addr", "", "only show requests to sign with the key at this address")
+			from := cmd.IntOpt("from", 0, "only show records from this index")
+			limit := cmd.IntOpt("limit", 0, "maximum number of records to show")
+			verifyFile := cmd.StringOpt("verify", "", "verify the audit log file at this path rather than querying the server")
+
+			cmd.Action = func() {
+				if *verifyFile != "" {
+					length, headHash, err := keys.VerifyAuditLog(*verifyFile)
+					if err != nil {
+						output.Fatalf("audit log %s failed verification: %v", *verifyFile, err)
+					}
+					output.Printf("Verified %d records with head hash %s", length, headHash)
+					return
+				}
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				resp, err := c.AuditLog(ctx, &keys.AuditLogRequest{Name: *name, Address: *addr,
+					FromIndex: uint64(*from), Limit: uint64(*limit)})
+				if err != nil {
+					output.Fatalf("failed to get audit log: %v", err)
+				}
+				for _, record := range resp.Records {
+					bs, err := json.Marshal(record)
+					if err != nil {
+						output.Fatalf("failed to serialise audit record: %v", err)
+					}
+					output.Printf("%s", bs)
+				}
+				output.Logf("Audit log has %d records with head hash %s", resp.Length, resp.HeadHash)
+			}
+		})
+
+		cmd.Command("backup", "split a key or seed into Shamir shares any threshold of which can recover it", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key")
+			addr := cmd.StringOpt("addr", "", "address of key")
+			seedName := cmd.StringOpt("seed", "", "back up the seed of this name rather than a key")
+			passphrase := cmd.StringOpt("passphrase", "", "passphrase of key or seed")
+			shares := cmd.IntOpt("n shares", 5, "number of shares to create")
+			threshold := cmd.IntOpt("m threshold", 3, "number of shares required to recover")
+			dir := cmd.StringOpt("dir", ".", "directory in which to write a file for each share")
+
+			cmd.Spec = "[--name=<key name>|--addr=<address>|--seed=<seed name>] [--passphrase] [--shares] " +
+				"[--threshold] [--dir]"
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				resp, err := c.Backup(ctx, &keys.BackupRequest{Passphrase: *passphrase, Name: *name, Address: *addr,
+					SeedName: *seedName, Shares: uint32(*shares), Threshold: uint32(*threshold)})
+				if err != nil {
+					output.Fatalf("failed to back up: %v", err)
+				}
+				for _, encoded := range resp.Shares {
+					share, err := keys.DecodeKeyShare(encoded)
+					if err != nil {
+						output.Fatalf("could not read share returned by server: %v", err)
+					}
+					printable, err := share.Printable()
+					if err != nil {
+						output.Fatalf("could not encode share: %v", err)
+					}
+					label := share.Name
+					if share.Kind == keys.ShareOfKey {
+						label = share.Address
+					}
+					file := path.Join(*dir, fmt.Sprintf("%s-%s-share-%d-of-%d.txt", label, share.BackupID,
+						share.Index, share.Shares))
+					err = ioutil.WriteFile(file, []byte(printable), 0600)
+					if err != nil {
+						output.Fatalf("could not write share: %v", err)
+					}
+					output.Printf("%s", file)
+				}
+			}
+		})
+
+		cmd.Command("recover", "rebuild a key or seed from the files of its Shamir shares", func(cmd *cli.Cmd) {
+			passphrase := cmd.StringOpt("passphrase", "", "passphrase with which to store the recovered key or seed")
+			files := cmd.StringsArg("SHARE", nil, "files containing shares")
+
+			cmd.Spec = "[--passphrase] SHARE..."
+
+			cmd.Action = func() {
+				req := &keys.RecoverRequest{Passphrase: *passphrase}
+				for _, file := range *files {
+					bs, err := ioutil.ReadFile(file)
+					if err != nil {
+						output.Fatalf("could not read share: %v", err)
+					}
+					req.Shares = append(req.Shares, string(bs))
+				}
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				resp, err := c.Recover(ctx, req)
+				if err != nil {
+					output.Fatalf("failed to recover: %v", err)
+				}
+				if resp.SeedName != "" {
+					output.Printf("Recovered seed %s", resp.SeedName)
+				} else {
+					output.Printf("%s", resp.Address)
+				}
+			}
+		})
+
 		cmd.Command("rm", "rm key name", func(cmd *cli.Cmd) {
 			name := cmd.StringArg("NAME", "", "key to remove")
 
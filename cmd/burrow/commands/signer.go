@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/consensus/tendermint/signer"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging/lifecycle"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
+	cli "github.com/jawher/mow.cli"
+	"google.golang.org/grpc"
+)
+
+func Signer(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		configOpt := cmd.StringOpt("c config", "", "Use the a specified burrow config file")
+
+		genesisOpt := cmd.StringOpt("g genesis", "",
+			"Use the specified genesis JSON file rather than a key in the main config, use - to read from STDIN")
+
+		listenOpt := cmd.StringOpt("l listen", "", "Address on which to listen for the validator node, "+
+			"overriding Signer.ListenAddress")
+
+		stateFileOpt := cmd.StringOpt("s state-file", "", "File in which to record the last height, round, and "+
+			"step signed, overriding Signer.StateFile")
+
+		cmd.Spec = "[--config=<config file>] [--genesis=<genesis json file>] [--listen=<host:port>] " +
+			"[--state-file=<file>]"
+
+		configOpts := addConfigOptions(cmd)
+
+		cmd.Action = func() {
+			conf, err := obtainBurrowConfig(*configOpt, *genesisOpt)
+			if err != nil {
+				output.Fatalf("could not obtain config: %v", err)
+			}
+
+			err = configOpts.configure(conf)
+			if err != nil {
+				output.Fatalf("could not update burrow config: %v", err)
+			}
+
+			if conf.ValidatorAddress == nil {
+				output.Fatalf("could not finalise validator address - please provide one in config or via --validator-address")
+			}
+
+			signerConf := signer.DefaultSignerConfig()
+			if conf.Signer != nil {
+				signerConf = conf.Signer
+			}
+			if *listenOpt != "" {
+				signerConf.ListenAddress = *listenOpt
+			}
+			if *stateFileOpt != "" {
+				signerConf.StateFile = *stateFileOpt
+			}
+
+			logger, err := lifecycle.NewLoggerFromLoggingConfig(conf.Logging)
+			if err != nil {
+				output.Fatalf("could not generate logger from logging config: %v", err)
+			}
+
+			keyClient, _, err := conf.KeyClient(logger)
+			if err != nil {
+				output.Fatalf("could not create key client: %v", err)
+			}
+			val, err := keys.AddressableSigner(keyClient, *conf.ValidatorAddress)
+			if err != nil {
+				output.Fatalf("could not get validator key %v: %v", *conf.ValidatorAddress, err)
+			}
+			privValidator, err := tendermint.NewPrivValidatorFile(val, val, signerConf.StateFile)
+			if err != nil {
+				output.Fatalf("could not load signer state from %s: %v", signerConf.StateFile, err)
+			}
+
+			tlsServer, err := tlsconfig.NewServer(signerConf.ServerTLS)
+			if err != nil {
+				output.Fatalf("Could not load TLS certificates: %v", err)
+			}
+			var opts []grpc.ServerOption
+			if tlsServer != nil {
+				opts = append(opts, tlsServer.GRPCServerOption())
+			} else {
+				output.Logf("Serving signatures without TLS, anything that can connect to %s can sign as validator %v",
+					signerConf.ListenAddress, *conf.ValidatorAddress)
+			}
+
+			listener, err := net.Listen("tcp", signerConf.ListenAddress)
+			if err != nil {
+				output.Fatalf("Could not listen on %s: %v", signerConf.ListenAddress, err)
+			}
+			grpcServer := grpc.NewServer(opts...)
+			signer.RegisterSignerServer(grpcServer, signer.NewSignerServer(privValidator, logger))
+
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-signals
+				output.Logf("Shutting down signer")
+				grpcServer.GracefulStop()
+			}()
+
+			output.Logf("Signing as validator %v on %s", *conf.ValidatorAddress, signerConf.ListenAddress)
+			err = grpcServer.Serve(listener)
+			if err != nil {
+				output.Fatalf("Signer stopped with error: %v", err)
+			}
+		}
+	}
+}
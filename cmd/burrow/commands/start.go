@@ -28,12 +28,14 @@ func Start(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("could not update burrow config: %v", err)
 			}
 
-			if conf.ValidatorAddress == nil {
+			if conf.RemoteSigning() {
+				output.Logf("Using remote signer at: %s", conf.Signer.RemoteAddress)
+			} else if conf.ValidatorAddress == nil {
 				output.Fatalf("could not finalise validator address - please provide one in config or via --validator-address")
+			} else {
+				output.Logf("Using validator address: %s", *conf.ValidatorAddress)
 			}
 
-			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
-
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
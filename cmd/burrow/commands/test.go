@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/burrow/deploy/test"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/keys/common"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/sirupsen/logrus"
+)
+
+// Test runs deploy packages and Solidity test contracts each against a chain of its own held in memory
+func Test(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		junitOpt := cmd.StringOpt("junit", "", "file to write the results to as JUnit XML for CI servers")
+
+		compilerCacheOpt := cmd.String(cli.StringOpt{
+			Name:   "compiler-cache",
+			Desc:   "directory of compiler binaries from which versions pinned by the compilers section of packages are taken",
+			Value:  filepath.Join(common.HomeDir(), ".burrow", "compilers"),
+			EnvVar: "BURROW_COMPILER_CACHE",
+		})
+
+		gasOpt := cmd.StringOpt("g gas", test.DefaultGas,
+			"gas given to each test function and, unless they say otherwise, to each job")
+
+		verboseOpt := cmd.BoolOpt("v verbose", false, "verbose output")
+
+		debugOpt := cmd.BoolOpt("d debug", false, "debug level output")
+
+		filesArg := cmd.StringsArg("FILE", nil,
+			"deploy packages (.yaml), whose jobs are each a test, or contract files (.sol, .vy, or .json artifacts), "+
+				"whose functions named test* are each a test")
+
+		cmd.Spec = "[OPTIONS] FILE..."
+
+		cmd.Action = func() {
+			log.SetFormatter(new(PlainFormatter))
+			log.SetLevel(log.ErrorLevel)
+			if *verboseOpt {
+				log.SetLevel(log.InfoLevel)
+			} else if *debugOpt {
+				log.SetLevel(log.DebugLevel)
+			}
+
+			results := new(test.TestSuites)
+			for _, file := range *filesArg {
+				chain, err := test.NewChain(spec.FullAccount(test.TesterName), logging.NewNoopLogger())
+				if err != nil {
+					output.Fatalf("could not make test chain: %v", err)
+				}
+				runner, err := test.NewRunner(chain, *compilerCacheOpt)
+				if err != nil {
+					output.Fatalf("could not run tests: %v", err)
+				}
+				runner.Gas = *gasOpt
+				suite := runner.Run(file)
+				chain.Shutdown()
+
+				results.Add(suite)
+				printSuite(output, suite)
+			}
+
+			if *junitOpt != "" {
+				f, err := os.Create(*junitOpt)
+				if err != nil {
+					output.Fatalf("could not create JUnit report: %v", err)
+				}
+				err = results.WriteXML(f)
+				f.Close()
+				if err != nil {
+					output.Fatalf("could not write JUnit report: %v", err)
+				}
+			}
+
+			if results.Failed() {
+				output.Fatalf("FAIL: %d of %d tests failed", results.Failures+results.Errors, results.Tests)
+			}
+			output.Printf("PASS: %d tests passed, %d skipped", results.Tests-results.Skipped, results.Skipped)
+		}
+	}
+}
+
+func printSuite(output Output, suite *test.TestSuite) {
+	output.Printf("%s", suite.Name)
+	for _, tc := range suite.Cases {
+		name := tc.Name
+		if tc.Classname != suite.Name {
+			name = tc.Classname + "." + tc.Name
+		}
+		gas := ""
+		if g, ok := tc.Gas(); ok {
+			gas = ", gas " + g
+		}
+		switch {
+		case tc.Failure != nil:
+			output.Printf("  FAIL %s (%ss%s): %s", name, tc.Time, gas, tc.Failure.Message)
+		case tc.Error != nil:
+			output.Printf("  ERROR %s: %s", name, tc.Error.Message)
+		case tc.Skipped != nil:
+			output.Printf("  SKIP %s", name)
+		default:
+			output.Printf("  PASS %s (%ss%s)", name, tc.Time, gas)
+		}
+	}
+}
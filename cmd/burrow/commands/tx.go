@@ -1 +1,359 @@
 package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/sirupsen/logrus"
+)
+
+// Tx provides an offline signing workflow: a transaction is formulated against the chain's state on a connected
+// machine, signed on (possibly several) machines holding keys, and then broadcast back to the chain
+func Tx(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainUrlOpt := cmd.StringOpt("u chain-url", "127.0.0.1:10997", "chain-url to be used in IP:PORT format")
+
+		keysUrlOpt := cmd.StringOpt("s keys", keys.DefaultHost+":"+keys.DefaultPort,
+			"IP:PORT of keys server used by sign")
+
+		tlsConf := tlsClientOpts(cmd)
+
+		chainClient := func() *def.Client {
+			log.SetLevel(log.WarnLevel)
+			client := &def.Client{ChainTLS: tlsConf()}
+			err := client.Dial(*chainUrlOpt, "")
+			if err != nil {
+				output.Fatalf("could not connect to chain at %s: %v", *chainUrlOpt, err)
+			}
+			// Signing happens elsewhere so we need sequence numbers from the chain rather than the mempool
+			client.MempoolSigning = false
+			return client
+		}
+
+		formulate := func(cmd *cli.Cmd, build func(client *def.Client) (payload.Payload, error)) {
+			fileOpt := cmd.StringOpt("f file", "", "file to write the unsigned transaction envelope to, "+
+				"or standard output if not given")
+
+			cmd.Action = func() {
+				client := chainClient()
+				tx, err := build(client)
+				if err != nil {
+					output.Fatalf("could not formulate transaction: %v", err)
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				formulated, err := client.Transact().FormulateTx(ctx, tx.Any())
+				if err != nil {
+					output.Fatalf("could not formulate transaction: %v", err)
+				}
+				writeEnvelope(output, *fileOpt, formulated.Envelope)
+			}
+		}
+
+		cmd.Command("formulate", "formulate an unsigned transaction using sequence numbers and chain ID from the chain",
+			func(cmd *cli.Cmd) {
+				cmd.Command("send", "send value from one or more inputs to one or more outputs", func(cmd *cli.Cmd) {
+					inputsOpt := cmd.StringsOpt("i input", nil, "input as ADDRESS:AMOUNT")
+					outputsOpt := cmd.StringsOpt("o output", nil, "output as ADDRESS:AMOUNT")
+
+					cmd.Spec = "--input=<address:amount>... --output=<address:amount>... [--file]"
+
+					formulate(cmd, func(client *def.Client) (payload.Payload, error) {
+						tx := new(payload.SendTx)
+						for _, in := range *inputsOpt {
+							address, amount, err := splitAddressAmount(in)
+							if err != nil {
+								return nil, err
+							}
+							input, err := client.TxInput(address, amount, "")
+							if err != nil {
+								return nil, err
+							}
+							tx.Inputs = append(tx.Inputs, input)
+						}
+						for _, out := range *outputsOpt {
+							address, amount, err := splitAddressAmount(out)
+							if err != nil {
+								return nil, err
+							}
+							outputAddress, err := crypto.AddressFromHexString(address)
+							if err != nil {
+								return nil, err
+							}
+							value, err := client.ParseUint64(amount)
+							if err != nil {
+								return nil, err
+							}
+							tx.Outputs = append(tx.Outputs, &payload.TxOutput{Address: outputAddress, Amount: value})
+						}
+						return tx, nil
+					})
+				})
+
+				cmd.Command("call", "call a contract, or create one if no address is given", func(cmd *cli.Cmd) {
+					sourceOpt := cmd.StringOpt("source", "", "address of the caller")
+					addressOpt := cmd.StringOpt("address", "", "address of the contract")
+					dataOpt := cmd.StringOpt("data", "", "hex encoded call data or code")
+					amountOpt := cmd.StringOpt("amount", "", "value to send with the call")
+					feeOpt := cmd.StringOpt("fee", "", "fee to pay")
+					gasOpt := cmd.StringOpt("gas", "1111111111", "gas limit")
+					sequenceOpt := cmd.StringOpt("sequence", "", "sequence number to use rather than the next one from the chain")
+
+					cmd.Spec = "--source [--address] [--data] [--amount] [--fee] [--gas] [--sequence] [--file]"
+
+					formulate(cmd, func(client *def.Client) (payload.Payload, error) {
+						return client.Call(&def.CallArg{
+							Input:    *sourceOpt,
+							Address:  *addressOpt,
+							Data:     *dataOpt,
+							Amount:   *amountOpt,
+							Fee:      *feeOpt,
+							Gas:      *gasOpt,
+							Sequence: *sequenceOpt,
+						})
+					})
+				})
+
+				cmd.Command("name", "register an entry in the name registry", func(cmd *cli.Cmd) {
+					sourceOpt := cmd.StringOpt("source", "", "address of the registrant")
+					nameOpt := cmd.StringOpt("name", "", "name to register")
+					dataOpt := cmd.StringOpt("data", "", "data to register against the name")
+					amountOpt := cmd.StringOpt("amount", "", "amount to pay for the lease")
+					feeOpt := cmd.StringOpt("fee", "", "fee to pay")
+					sequenceOpt := cmd.StringOpt("sequence", "", "sequence number to use rather than the next one from the chain")
+
+					cmd.Spec = "--source --name [--data] [--amount] [--fee] [--sequence] [--file]"
+
+					formulate(cmd, func(client *def.Client) (payload.Payload, error) {
+						return client.Name(&def.NameArg{
+							Input:    *sourceOpt,
+							Name:     *nameOpt,
+							Data:     *dataOpt,
+							Amount:   *amountOpt,
+							Fee:      *feeOpt,
+							Sequence: *sequenceOpt,
+						})
+					})
+				})
+			})
+
+		cmd.Command("sign", "add signatures from the keys server to a transaction envelope", func(cmd *cli.Cmd) {
+			fileArg := cmd.StringArg("FILE", "", "file containing the transaction envelope")
+			addressesOpt := cmd.StringsOpt("a address", nil,
+				"inputs to sign for, by default all unsigned inputs for which the keys server holds a key")
+			outOpt := cmd.StringOpt("o out", "", "file to write the signed envelope to, by default FILE is updated")
+
+			cmd.Spec = "[--address=<address>...] [--out] FILE"
+
+			cmd.Action = func() {
+				txEnv := readEnvelope(output, *fileArg)
+				keyClient, err := keys.NewRemoteKeyClient(*keysUrlOpt, tlsConf(), logging.NewNoopLogger())
+				if err != nil {
+					output.Fatalf("could not connect to keys server at %s: %v", *keysUrlOpt, err)
+				}
+				var signers []acm.AddressableSigner
+				if len(*addressesOpt) > 0 {
+					for _, addr := range *addressesOpt {
+						address, err := crypto.AddressFromHexString(addr)
+						if err != nil {
+							output.Fatalf("could not parse address: %v", err)
+						}
+						signer, err := keys.AddressableSigner(keyClient, address)
+						if err != nil {
+							output.Fatalf("could not get key for %v: %v", address, err)
+						}
+						signers = append(signers, signer)
+					}
+				} else {
+					for _, address := range unsignedInputs(txEnv) {
+						signer, err := keys.AddressableSigner(keyClient, address)
+						if err != nil {
+							output.Logf("Not signing for input %v: %v", address, err)
+							continue
+						}
+						signers = append(signers, signer)
+					}
+				}
+				if len(signers) == 0 {
+					output.Fatalf("no keys available to sign for any input of transaction %X", txEnv.Tx.Hash())
+				}
+				err = txEnv.AddSignatures(signers...)
+				if err != nil {
+					output.Fatalf("could not sign transaction: %v", err)
+				}
+				for _, signer := range signers {
+					output.Logf("Signed for input %v", signer.Address())
+				}
+				out := *outOpt
+				if out == "" {
+					out = *fileArg
+				}
+				writeEnvelope(output, out, txEnv)
+			}
+		})
+
+		cmd.Command("broadcast", "broadcast a signed transaction envelope", func(cmd *cli.Cmd) {
+			fileArg := cmd.StringArg("FILE", "", "file containing the transaction envelope")
+			asyncOpt := cmd.BoolOpt("async", false, "return once the transaction is accepted into the mempool "+
+				"rather than waiting for it to be executed in a block")
+
+			cmd.Spec = "[--async] FILE"
+
+			cmd.Action = func() {
+				txEnv := readEnvelope(output, *fileArg)
+				if unsigned := unsignedInputs(txEnv); len(unsigned) > 0 {
+					output.Fatalf("transaction %X has not been signed by inputs %v", txEnv.Tx.Hash(), unsigned)
+				}
+				client := chainClient()
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+				param := &rpctransact.TxEnvelopeParam{Envelope: txEnv}
+				var receipt *txs.Receipt
+				if *asyncOpt {
+					var err error
+					receipt, err = client.Transact().BroadcastTxAsync(ctx, param)
+					if err != nil {
+						output.Fatalf("failed to broadcast transaction: %v", err)
+					}
+				} else {
+					txe, err := client.Transact().BroadcastTxSync(ctx, param)
+					if err != nil {
+						output.Fatalf("failed to broadcast transaction: %v", err)
+					}
+					if txe.Exception != nil {
+						output.Fatalf("transaction %X failed: %v", txe.TxHash, txe.Exception)
+					}
+					receipt = txe.Receipt
+				}
+				bs, err := json.Marshal(receipt)
+				if err != nil {
+					output.Fatalf("could not serialise receipt: %v", err)
+				}
+				output.Printf("%s", bs)
+			}
+		})
+
+		cmd.Command("inspect", "describe a transaction envelope and the state of its signatures", func(cmd *cli.Cmd) {
+			fileArg := cmd.StringArg("FILE", "", "file containing the transaction envelope")
+
+			cmd.Spec = "FILE"
+
+			cmd.Action = func() {
+				txEnv := readEnvelope(output, *fileArg)
+				output.Printf("Transaction %X", txEnv.Tx.Hash())
+				output.Printf("ChainID:  %s", txEnv.Tx.ChainID)
+				output.Printf("Type:     %v", txEnv.Tx.Type())
+				bs, err := json.Marshal(txEnv.Tx.Payload)
+				if err != nil {
+					output.Fatalf("could not serialise payload: %v", err)
+				}
+				output.Printf("Payload:  %s", bs)
+				signBytes, err := txEnv.Tx.SignBytes()
+				if err != nil {
+					output.Fatalf("could not get sign bytes: %v", err)
+				}
+				signatories := make(map[crypto.Address]txs.Signatory)
+				for _, s := range txEnv.Signatories {
+					signatories[*s.Address] = s
+				}
+				for _, in := range txEnv.Tx.GetInputs() {
+					s, ok := signatories[in.Address]
+					switch {
+					case !ok:
+						output.Printf("Input %v: unsigned (sequence %d)", in.Address, in.Sequence)
+					case len(s.Signature.Signature) == 0:
+						output.Printf("Input %v: aggregated %v signature", in.Address, s.PublicKey.CurveType)
+					default:
+						status := "valid"
+						if err := s.PublicKey.Verify(signBytes, s.Signature); err != nil {
+							status = fmt.Sprintf("INVALID (%v)", err)
+						}
+						output.Printf("Input %v: %s %v signature", in.Address, status, s.Signature.CurveType)
+					}
+				}
+				if unsigned := unsignedInputs(txEnv); len(unsigned) > 0 {
+					output.Printf("Awaiting %d of %d signatures", len(unsigned), len(txEnv.Tx.GetInputs()))
+					return
+				}
+				err = txEnv.Verify(nil, txEnv.Tx.ChainID)
+				if err != nil {
+					output.Fatalf("Signatures do not verify: %v", err)
+				}
+				output.Printf("All signatures verified, ready to broadcast")
+			}
+		})
+	}
+}
+
+func readEnvelope(output Output, file string) *txs.Envelope {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		output.Fatalf("could not read transaction envelope: %v", err)
+	}
+	txEnv, err := txs.NewJSONCodec().DecodeTx(bs)
+	if err != nil {
+		output.Fatalf("could not decode transaction envelope from %s: %v", file, err)
+	}
+	if txEnv.Tx == nil {
+		output.Fatalf("%s does not contain a transaction", file)
+	}
+	// The envelope may come from anyone so make sure each signatory says who it is before we rely on it
+	for i, s := range txEnv.Signatories {
+		err = s.Validate()
+		if err != nil {
+			output.Fatalf("signatory %d in %s is invalid: %v", i, file, err)
+		}
+	}
+	return txEnv
+}
+
+func writeEnvelope(output Output, file string, txEnv *txs.Envelope) {
+	bs, err := txs.NewJSONCodec().EncodeTx(txEnv)
+	if err != nil {
+		output.Fatalf("could not encode transaction envelope: %v", err)
+	}
+	if file == "" {
+		output.Printf("%s", bs)
+		return
+	}
+	err = ioutil.WriteFile(file, bs, 0644)
+	if err != nil {
+		output.Fatalf("could not write transaction envelope: %v", err)
+	}
+	output.Logf("Wrote transaction %X to %s", txEnv.Tx.Hash(), file)
+}
+
+func unsignedInputs(txEnv *txs.Envelope) []crypto.Address {
+	signed := make(map[crypto.Address]bool)
+	for _, s := range txEnv.Signatories {
+		signed[*s.Address] = true
+	}
+	var unsigned []crypto.Address
+	for _, in := range txEnv.Tx.GetInputs() {
+		if !signed[in.Address] {
+			unsigned = append(unsigned, in.Address)
+		}
+	}
+	return unsigned
+}
+
+func splitAddressAmount(addressAmount string) (string, string, error) {
+	parts := strings.Split(addressAmount, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected ADDRESS:AMOUNT but got '%s'", addressAmount)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hyperledger/burrow/logging/lifecycle"
+	"github.com/hyperledger/burrow/vent"
+	cli "github.com/jawher/mow.cli"
+)
+
+func Vent(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		conf := vent.DefaultVentConfig()
+
+		dbAdapterOpt := cmd.StringOpt("db-adapter", conf.DBAdapter, "Database adapter, 'postgres' or 'sqlite3'")
+		dbURLOpt := cmd.StringOpt("db-url", conf.DBURL, "PostgreSQL database URL or SQLite database file path")
+		dbSchemaOpt := cmd.StringOpt("db-schema", conf.DBSchema,
+			"PostgreSQL database schema into which tables are projected (ignored by SQLite)")
+		grpcAddrOpt := cmd.StringOpt("grpc-addr", conf.GRPCAddr, "Address of the Burrow GRPC server to consume events from")
+		specFileOpt := cmd.StringOpt("spec-file", "", "JSON file containing the specifications of the tables to project")
+		abiFilesOpt := cmd.StringsOpt("abi-file", nil, "ABI file defining the events referenced by the table specifications")
+		replayOpt := cmd.BoolOpt("replay", false,
+			"Project events from the first block rather than resuming from the last block projected")
+
+		tlsConf := tlsClientOpts(cmd)
+
+		cmd.Spec = "--spec-file=<spec file> [--abi-file=<abi file>...] [--db-adapter] [--db-url] [--db-schema] " +
+			"[--grpc-addr] [--replay] [--tls] [--tls-ca] [--tls-cert] [--tls-key]"
+
+		cmd.Action = func() {
+			conf.DBAdapter = *dbAdapterOpt
+			conf.DBURL = *dbURLOpt
+			conf.DBSchema = *dbSchemaOpt
+			conf.GRPCAddr = *grpcAddrOpt
+			conf.GRPCTLS = tlsConf()
+			conf.SpecFile = *specFileOpt
+			conf.AbiFiles = *abiFilesOpt
+			conf.Replay = *replayOpt
+
+			logger, err := lifecycle.NewStdErrLogger()
+			if err != nil {
+				output.Fatalf("Could not create logger: %v", err)
+			}
+			consumer, err := vent.NewConsumer(conf, logger)
+			if err != nil {
+				output.Fatalf("Could not create vent consumer: %v", err)
+			}
+			defer consumer.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-signals
+				output.Logf("Shutting down vent")
+				cancel()
+			}()
+
+			err = consumer.Run(ctx)
+			if err != nil && ctx.Err() == nil {
+				output.Fatalf("Vent stopped with error: %v", err)
+			}
+		}
+	}
+}
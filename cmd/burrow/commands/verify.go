@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/verify"
+	"github.com/hyperledger/burrow/keys/common"
+	cli "github.com/jawher/mow.cli"
+	log "github.com/sirupsen/logrus"
+)
+
+// Verify checks that a contract on chain was built from a source file by recompiling it
+func Verify(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		chainUrlOpt := cmd.StringOpt("u chain-url", "127.0.0.1:10997", "chain-url to be used in IP:PORT format")
+
+		compilerCacheOpt := cmd.String(cli.StringOpt{
+			Name:   "compiler-cache",
+			Desc:   "directory of compiler binaries from which the compiler version the contract was built with is taken",
+			Value:  filepath.Join(common.HomeDir(), ".burrow", "compilers"),
+			EnvVar: "BURROW_COMPILER_CACHE",
+		})
+
+		contractOpt := cmd.StringOpt("c contract", "",
+			"name of the contract in the source file, needed to verify contracts whose source was not published")
+
+		versionOpt := cmd.StringOpt("compiler-version", "",
+			"version of the compiler to use rather than the one the published source names")
+
+		optimizeOpt := cmd.BoolOpt("optimize", false, "compile with the optimiser enabled")
+
+		librariesOpt := cmd.StringsOpt("l library", nil,
+			"address of a library linked into the contract as NAME:ADDRESS, as well as any published with the source")
+
+		tlsConf := tlsClientOpts(cmd)
+
+		addressArg := cmd.StringArg("ADDRESS", "", "address of the contract to verify")
+		fileArg := cmd.StringArg("FILE", "", "source file to compile the contract from")
+
+		cmd.Spec = "[OPTIONS] ADDRESS FILE"
+
+		cmd.Action = func() {
+			log.SetLevel(log.WarnLevel)
+			address, err := crypto.AddressFromHexString(*addressArg)
+			if err != nil {
+				output.Fatalf("could not read contract address: %v", err)
+			}
+			client := &def.Client{ChainTLS: tlsConf()}
+			err = client.Dial(*chainUrlOpt, "")
+			if err != nil {
+				output.Fatalf("could not connect to chain at %s: %v", *chainUrlOpt, err)
+			}
+
+			src, err := verify.GetSource(client, address)
+			if err != nil {
+				if *contractOpt == "" {
+					output.Fatalf("%v, pass --contract to verify it anyway", err)
+				}
+				output.Logf("Verifying without published source: %v", err)
+				src = &verify.Source{Compiler: compile.CompilerName(*fileArg)}
+			}
+			if *contractOpt != "" {
+				src.Contract = *contractOpt
+			}
+			if *versionOpt != "" {
+				src.Version = *versionOpt
+			}
+			src.Optimize = src.Optimize || *optimizeOpt
+			for _, lib := range *librariesOpt {
+				nameAddress := strings.Split(lib, ":")
+				if len(nameAddress) != 2 {
+					output.Fatalf("library %s should be given as NAME:ADDRESS", lib)
+				}
+				if src.Libraries == nil {
+					src.Libraries = make(map[string]string)
+				}
+				src.Libraries[nameAddress[0]] = nameAddress[1]
+			}
+
+			versions := make(map[string]string)
+			if src.Compiler != "" && src.Version != "" {
+				versions[src.Compiler] = src.Version
+			}
+			compilers, err := compile.NewCompilers(*compilerCacheOpt, versions)
+			if err != nil {
+				output.Fatalf("could not find compiler: %v", err)
+			}
+
+			acc, err := client.GetAccount(address)
+			if err != nil {
+				output.Fatalf("could not get contract %v: %v", address, err)
+			}
+			if acc == nil || len(acc.Code) == 0 {
+				output.Fatalf("there is no contract at %v", address)
+			}
+
+			result, err := verify.Verify(address, acc.Code, *fileArg, src, compilers)
+			if err != nil {
+				output.Fatalf("could not verify %v: %v", address, err)
+			}
+			if !result.Exact {
+				output.Printf("%v is contract %s from %s, though its code differs in the compiler metadata appended "+
+					"to it, so %s may have been compiled from a different path or with different comments",
+					address, result.Contract, *fileArg, *fileArg)
+				return
+			}
+			output.Printf("%v is contract %s from %s", address, result.Contract, *fileArg)
+		}
+	}
+}
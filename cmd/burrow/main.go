@@ -41,15 +41,33 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("keys", "A tool for doing a bunch of cool stuff with keys",
 		commands.Keys(output))
 
+	app.Command("signer", "Sign consensus messages on behalf of a validator node configured with Signer.RemoteAddress",
+		commands.Signer(output))
+
 	app.Command("dump", "Dump objects from an offline Burrow .burrow directory",
 		commands.Dump(output))
 
+	app.Command("tx", "Formulate, sign, inspect, and broadcast transactions for offline signing",
+		commands.Tx(output))
+
 	app.Command("deploy", "Deploy and test contracts",
 		commands.Deploy(output))
 
+	app.Command("verify", "Check a deployed contract's code is that compiled from a source file",
+		commands.Verify(output))
+
+	app.Command("test", "Run deploy packages and Solidity test contracts against a chain held in memory",
+		commands.Test(output))
+
+	app.Command("abigen", "Generate typed Go bindings to contracts from their ABIs",
+		commands.Abigen(output))
+
 	app.Command("snatives", "Dump Solidity interface contracts for SNatives",
 		commands.Snatives(output))
 
+	app.Command("vent", "Project contract events into SQL database tables",
+		commands.Vent(output))
+
 	return app
 }
 
@@ -6,14 +6,17 @@ import (
 
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/consensus/tendermint/signer"
 	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/lifecycle"
 	logging_config "github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc"
+	tmTypes "github.com/tendermint/tendermint/types"
 )
 
 const DefaultBurrowConfigTOMLFileName = "burrow.toml"
@@ -31,6 +34,8 @@ type BurrowConfig struct {
 	Keys       *keys.KeysConfig                   `json:",omitempty" toml:",omitempty"`
 	RPC        *rpc.RPCConfig                     `json:",omitempty" toml:",omitempty"`
 	Logging    *logging_config.LoggingConfig      `json:",omitempty" toml:",omitempty"`
+	// Remote signing of consensus messages by a standalone validator signer
+	Signer *signer.SignerConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultBurrowConfig() *BurrowConfig {
@@ -47,34 +52,40 @@ func (conf *BurrowConfig) Kernel(ctx context.Context) (*core.Kernel, error) {
 	if conf.GenesisDoc == nil {
 		return nil, fmt.Errorf("no GenesisDoc defined in config, cannot make Kernel")
 	}
-	if conf.ValidatorAddress == nil {
+	if conf.ValidatorAddress == nil && !conf.RemoteSigning() {
 		return nil, fmt.Errorf("no validator address provided, cannot make Kernel")
 	}
 	logger, err := lifecycle.NewLoggerFromLoggingConfig(conf.Logging)
 	if err != nil {
 		return nil, fmt.Errorf("could not generate logger from logging config: %v", err)
 	}
-	var keyClient keys.KeyClient
-	var keyStore *keys.KeyStore
-	if conf.Keys.RemoteAddress != "" {
-		keyClient, err = keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, logger)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		keyStore = keys.NewKeyStore(conf.Keys.KeysDirectory, conf.Keys.AllowBadFilePermissions, logger)
-		keyClient = keys.NewLocalKeyClient(keyStore, logger)
-	}
-
-	val, err := keys.AddressableSigner(keyClient, *conf.ValidatorAddress)
+	// Keys must derive addresses the same way as the chain
+	err = crypto.SetAddressDerivation(conf.GenesisDoc.AddressDerivation)
 	if err != nil {
-		return nil, fmt.Errorf("could not get validator addressable from keys client: %v", err)
+		return nil, err
 	}
-	signer, err := keys.AddressableSigner(keyClient, val.Address())
+	keyClient, keyStore, err := conf.KeyClient(logger)
 	if err != nil {
 		return nil, err
 	}
-	privValidator := tendermint.NewPrivValidatorMemory(val, signer)
+
+	var privValidator tmTypes.PrivValidator
+	if conf.RemoteSigning() {
+		remote, err := signer.NewRemotePrivValidator(conf.Signer.RemoteAddress, conf.Signer.RemoteTLS, logger)
+		if err != nil {
+			return nil, err
+		}
+		if conf.ValidatorAddress != nil && *conf.ValidatorAddress != remote.Address() {
+			return nil, fmt.Errorf("remote signer holds key for validator %v but validator address %v is configured",
+				remote.Address(), *conf.ValidatorAddress)
+		}
+		privValidator = remote
+	} else {
+		privValidator, err = conf.PrivValidator(keyClient, *conf.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	var exeOptions []execution.ExecutionOption
 	if conf.Execution != nil {
@@ -88,6 +99,38 @@ func (conf *BurrowConfig) Kernel(ctx context.Context) (*core.Kernel, error) {
 		conf.Keys, keyStore, exeOptions, logger)
 }
 
+// RemoteSigning returns true if consensus messages are signed by a remote signer rather than with a local key
+func (conf *BurrowConfig) RemoteSigning() bool {
+	return conf.Signer != nil && conf.Signer.RemoteAddress != ""
+}
+
+// KeyClient returns a client for the keys server at Keys.RemoteAddress or else for a local KeyStore, which is also
+// returned
+func (conf *BurrowConfig) KeyClient(logger *logging.Logger) (keys.KeyClient, *keys.KeyStore, error) {
+	if conf.Keys.RemoteAddress != "" {
+		keyClient, err := keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, conf.Keys.RemoteTLS, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return keyClient, nil, nil
+	}
+	keyStore, err := keys.NewKeyStoreFromConfig(conf.Keys, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys.NewLocalKeyClient(keyStore, logger), keyStore, nil
+}
+
+// PrivValidator returns an in-memory PrivValidator signing with the key at validatorAddress
+func (conf *BurrowConfig) PrivValidator(keyClient keys.KeyClient,
+	validatorAddress crypto.Address) (tmTypes.PrivValidator, error) {
+	val, err := keys.AddressableSigner(keyClient, validatorAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator addressable from keys client: %v", err)
+	}
+	return tendermint.NewPrivValidatorMemory(val, val), nil
+}
+
 func (conf *BurrowConfig) JSONString() string {
 	return source.JSONString(conf)
 }
@@ -0,0 +1,114 @@
+package tendermint
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// A PrivValidator that persists the last height, round, and step it signed to a file before releasing each signature
+// so that it will not double sign after a restart
+type privValidatorFile struct {
+	*privValidatorMemory
+	sync.Mutex
+	stateFile string
+}
+
+var _ tmTypes.PrivValidator = &privValidatorFile{}
+
+// Create a PrivValidator like NewPrivValidatorMemory but that loads and stores its LastSignedInfo in stateFile
+func NewPrivValidatorFile(addressable crypto.Addressable, signer crypto.Signer,
+	stateFile string) (*privValidatorFile, error) {
+	lastSignedInfo, err := LoadLastSignedInfo(stateFile)
+	if err != nil {
+		return nil, err
+	}
+	pvm := NewPrivValidatorMemory(addressable, signer)
+	pvm.lastSignedInfo = lastSignedInfo
+	return &privValidatorFile{
+		privValidatorMemory: pvm,
+		stateFile:           stateFile,
+	}, nil
+}
+
+func (pvf *privValidatorFile) SignVote(chainID string, vote *tmTypes.Vote) error {
+	pvf.Lock()
+	defer pvf.Unlock()
+	restore := pvf.lastSignedInfo.checkpoint()
+	err := pvf.privValidatorMemory.SignVote(chainID, vote)
+	if err != nil {
+		return err
+	}
+	return pvf.save(vote, restore)
+}
+
+func (pvf *privValidatorFile) SignProposal(chainID string, proposal *tmTypes.Proposal) error {
+	pvf.Lock()
+	defer pvf.Unlock()
+	restore := pvf.lastSignedInfo.checkpoint()
+	err := pvf.privValidatorMemory.SignProposal(chainID, proposal)
+	if err != nil {
+		return err
+	}
+	return pvf.save(proposal, restore)
+}
+
+// Heartbeats do not advance height, round, or step so need not be persisted
+func (pvf *privValidatorFile) SignHeartbeat(chainID string, heartbeat *tmTypes.Heartbeat) error {
+	pvf.Lock()
+	defer pvf.Unlock()
+	return pvf.privValidatorMemory.SignHeartbeat(chainID, heartbeat)
+}
+
+// If we cannot persist our state we must withhold the signature and forget we made it, otherwise a retry would be
+// handed the cached signature that a restart would not know about
+func (pvf *privValidatorFile) save(signed interface{}, restore func()) error {
+	err := pvf.lastSignedInfo.Save(pvf.stateFile)
+	if err != nil {
+		restore()
+		switch s := signed.(type) {
+		case *tmTypes.Vote:
+			s.Signature = nil
+		case *tmTypes.Proposal:
+			s.Signature = nil
+		}
+	}
+	return err
+}
+
+// LoadLastSignedInfo reads LastSignedInfo from file returning an initial LastSignedInfo if file does not exist
+func LoadLastSignedInfo(file string) (*LastSignedInfo, error) {
+	bs, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return NewLastSignedInfo(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lsi := new(LastSignedInfo)
+	err = json.Unmarshal(bs, lsi)
+	if err != nil {
+		return nil, err
+	}
+	return lsi, nil
+}
+
+// Save atomically replaces file with the current LastSignedInfo
+func (lsi *LastSignedInfo) Save(file string) error {
+	lsi.Lock()
+	bs, err := json.Marshal(lsi)
+	lsi.Unlock()
+	if err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	err = ioutil.WriteFile(tmp, bs, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
@@ -0,0 +1,66 @@
+package tendermint
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestPrivValidatorFileFailedSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-priv-validator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	// The state file's directory does not exist yet so saving will fail
+	stateDir := path.Join(dir, "state")
+	stateFile := path.Join(stateDir, "priv_validator_state.json")
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+	pvf, err := NewPrivValidatorFile(val, val, stateFile)
+	require.NoError(t, err)
+
+	timestamp := time.Now().UTC()
+	vote := newVote(val, 1, "block one", timestamp)
+	require.Error(t, pvf.SignVote(chainID, vote))
+	assert.Nil(t, vote.Signature, "signature must be withheld")
+	assert.Equal(t, int64(0), pvf.lastSignedInfo.Height, "unpersisted signature must be forgotten")
+	assert.Nil(t, pvf.lastSignedInfo.Signature)
+
+	// A retry must not be handed a signature that was never persisted
+	vote = newVote(val, 1, "block one", timestamp)
+	require.Error(t, pvf.SignVote(chainID, vote))
+	assert.Nil(t, vote.Signature)
+
+	proposal := &tmTypes.Proposal{Height: 1, Round: 0, Timestamp: timestamp, POLRound: -1}
+	require.Error(t, pvf.SignProposal(chainID, proposal))
+	assert.Nil(t, proposal.Signature)
+	assert.Equal(t, int64(0), pvf.lastSignedInfo.Height)
+
+	// Once the state can be persisted we sign and remember what we signed
+	require.NoError(t, os.Mkdir(stateDir, 0700))
+	vote = newVote(val, 1, "block one", timestamp)
+	require.NoError(t, pvf.SignVote(chainID, vote))
+	require.NoError(t, vote.Verify(chainID, pvf.GetPubKey()))
+
+	lsi, err := LoadLastSignedInfo(stateFile)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), lsi.Height)
+	assert.Equal(t, vote.Signature, lsi.Signature)
+}
+
+const chainID = "priv-validator-test-chain"
+
+func newVote(val *acm.PrivateAccount, height int64, block string, timestamp time.Time) *tmTypes.Vote {
+	return &tmTypes.Vote{
+		ValidatorAddress: val.Address().Bytes(),
+		Height:           height,
+		Timestamp:        timestamp,
+		Type:             tmTypes.VoteTypePrevote,
+		BlockID:          tmTypes.BlockID{Hash: []byte(block)},
+	}
+}
@@ -50,6 +50,18 @@ func NewLastSignedInfo() *LastSignedInfo {
 
 type tmCryptoSigner func(msg []byte) []byte
 
+// checkpoint captures the current height, round, step, and signature returning a function that restores them
+func (lsi *LastSignedInfo) checkpoint() func() {
+	lsi.Lock()
+	defer lsi.Unlock()
+	height, round, step, signature, signBytes := lsi.Height, lsi.Round, lsi.Step, lsi.Signature, lsi.SignBytes
+	return func() {
+		lsi.Lock()
+		defer lsi.Unlock()
+		lsi.Height, lsi.Round, lsi.Step, lsi.Signature, lsi.SignBytes = height, round, step, signature, signBytes
+	}
+}
+
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
 func (lsi *LastSignedInfo) SignVote(sign tmCryptoSigner, chainID string, vote *types.Vote) error {
@@ -131,6 +143,9 @@ func (lsi *LastSignedInfo) signVote(sign tmCryptoSigner, chainID string, vote *t
 
 	// It passed the checks. Sign the vote
 	sig := sign(signBytes)
+	if sig == nil {
+		return errors.New("could not sign vote")
+	}
 	lsi.saveSigned(height, round, step, signBytes, sig)
 	vote.Signature = sig
 	return nil
@@ -167,6 +182,9 @@ func (lsi *LastSignedInfo) signProposal(sign tmCryptoSigner, chainID string, pro
 
 	// It passed the checks. Sign the proposal
 	sig := sign(signBytes)
+	if sig == nil {
+		return errors.New("could not sign proposal")
+	}
 	lsi.saveSigned(height, round, step, signBytes, sig)
 	proposal.Signature = sig
 	return nil
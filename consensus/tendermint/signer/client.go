@@ -0,0 +1,104 @@
+package signer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
+	tmCrypto "github.com/tendermint/tendermint/crypto"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	// How long we wait for the signer to become available on startup
+	connectTimeout = time.Minute
+	// How long we wait for a signature
+	signTimeout = 10 * time.Second
+)
+
+// A PrivValidator that obtains its signatures from a remote signer
+type remotePrivValidator struct {
+	client    SignerClient
+	publicKey crypto.PublicKey
+	logger    *logging.Logger
+}
+
+var _ tmTypes.PrivValidator = &remotePrivValidator{}
+
+// NewRemotePrivValidator connects to the signer at address, waiting for it to become available, and returns a
+// PrivValidator for its key. If tlsConf is nil or not enabled the connection is plaintext.
+func NewRemotePrivValidator(address string, tlsConf *tlsconfig.ClientConfig,
+	logger *logging.Logger) (*remotePrivValidator, error) {
+	transportOpt, err := tlsConf.GRPCDialOption()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(address, transportOpt)
+	if err != nil {
+		return nil, err
+	}
+	client := NewSignerClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+	result, err := client.PublicKey(ctx, &PublicKeyParam{}, grpc.FailFast(false))
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator public key from signer at %s: %v", address, err)
+	}
+	logger = logger.WithScope("RemotePrivValidator").With("signer_address", address)
+	logger.InfoMsg("Connected to remote signer", "validator_address", result.PublicKey.Address())
+	return &remotePrivValidator{
+		client:    client,
+		publicKey: result.PublicKey,
+		logger:    logger,
+	}, nil
+}
+
+func (rpv *remotePrivValidator) Address() crypto.Address {
+	return rpv.publicKey.Address()
+}
+
+func (rpv *remotePrivValidator) PublicKey() crypto.PublicKey {
+	return rpv.publicKey
+}
+
+func (rpv *remotePrivValidator) GetAddress() tmTypes.Address {
+	return rpv.publicKey.Address().Bytes()
+}
+
+func (rpv *remotePrivValidator) GetPubKey() tmCrypto.PubKey {
+	return rpv.publicKey.TendermintPubKey()
+}
+
+func (rpv *remotePrivValidator) SignVote(chainID string, vote *tmTypes.Vote) error {
+	return rpv.sign(rpv.client.SignVote, chainID, vote)
+}
+
+func (rpv *remotePrivValidator) SignProposal(chainID string, proposal *tmTypes.Proposal) error {
+	return rpv.sign(rpv.client.SignProposal, chainID, proposal)
+}
+
+func (rpv *remotePrivValidator) SignHeartbeat(chainID string, heartbeat *tmTypes.Heartbeat) error {
+	return rpv.sign(rpv.client.SignHeartbeat, chainID, heartbeat)
+}
+
+type signFunc func(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error)
+
+// Sends the amino encoding of signable (a pointer) to the signer and replaces it with the signed result
+func (rpv *remotePrivValidator) sign(sign signFunc, chainID string, signable interface{}) error {
+	bs, err := cdc.MarshalBinaryBare(signable)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	result, err := sign(ctx, &SignParam{ChainID: chainID, Message: bs})
+	if err != nil {
+		rpv.logger.InfoMsg("Remote signer did not sign", "error", err)
+		return err
+	}
+	return cdc.UnmarshalBinaryBare(result.Message, signable)
+}
@@ -0,0 +1,28 @@
+package signer
+
+import "github.com/hyperledger/burrow/rpc/tlsconfig"
+
+const (
+	DefaultListenAddress = "localhost:10998"
+	DefaultStateFile     = "signer_state.json"
+)
+
+type SignerConfig struct {
+	// Address of the signer the node uses to sign consensus messages, if empty the node signs them itself
+	RemoteAddress string
+	// TLS used to connect to the signer at RemoteAddress
+	RemoteTLS *tlsconfig.ClientConfig `json:",omitempty" toml:",omitempty"`
+	// Address on which the standalone signer listens
+	ListenAddress string
+	// TLS served by the standalone signer, which should require client certificates
+	ServerTLS *tlsconfig.ServerConfig `json:",omitempty" toml:",omitempty"`
+	// File in which the standalone signer records what it last signed to protect against double signing
+	StateFile string
+}
+
+func DefaultSignerConfig() *SignerConfig {
+	return &SignerConfig{
+		ListenAddress: DefaultListenAddress,
+		StateFile:     DefaultStateFile,
+	}
+}
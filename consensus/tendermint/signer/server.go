@@ -0,0 +1,89 @@
+package signer
+
+import (
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/tendermint/go-amino"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"golang.org/x/net/context"
+)
+
+var cdc = amino.NewCodec()
+
+type signerServer struct {
+	privValidator tmTypes.PrivValidator
+	logger        *logging.Logger
+}
+
+var _ SignerServer = &signerServer{}
+
+// NewSignerServer serves the signatures of privValidator, which is responsible for refusing to double sign
+func NewSignerServer(privValidator tmTypes.PrivValidator, logger *logging.Logger) *signerServer {
+	return &signerServer{
+		privValidator: privValidator,
+		logger:        logger.WithScope("SignerServer"),
+	}
+}
+
+func (ss *signerServer) PublicKey(ctx context.Context, param *PublicKeyParam) (*PublicKeyResult, error) {
+	publicKey, err := crypto.PublicKeyFromTendermintPubKey(ss.privValidator.GetPubKey())
+	if err != nil {
+		return nil, err
+	}
+	return &PublicKeyResult{PublicKey: publicKey}, nil
+}
+
+func (ss *signerServer) SignVote(ctx context.Context, param *SignParam) (*SignResult, error) {
+	vote := new(tmTypes.Vote)
+	err := cdc.UnmarshalBinaryBare(param.Message, vote)
+	if err != nil {
+		return nil, err
+	}
+	err = ss.privValidator.SignVote(param.ChainID, vote)
+	if err != nil {
+		ss.logger.InfoMsg("Refused to sign vote", "error", err, "chain_id", param.ChainID,
+			"height", vote.Height, "round", vote.Round, "type", vote.Type)
+		return nil, err
+	}
+	ss.logger.TraceMsg("Signed vote", "chain_id", param.ChainID, "height", vote.Height, "round", vote.Round,
+		"type", vote.Type)
+	return signResult(vote)
+}
+
+func (ss *signerServer) SignProposal(ctx context.Context, param *SignParam) (*SignResult, error) {
+	proposal := new(tmTypes.Proposal)
+	err := cdc.UnmarshalBinaryBare(param.Message, proposal)
+	if err != nil {
+		return nil, err
+	}
+	err = ss.privValidator.SignProposal(param.ChainID, proposal)
+	if err != nil {
+		ss.logger.InfoMsg("Refused to sign proposal", "error", err, "chain_id", param.ChainID,
+			"height", proposal.Height, "round", proposal.Round)
+		return nil, err
+	}
+	ss.logger.TraceMsg("Signed proposal", "chain_id", param.ChainID, "height", proposal.Height,
+		"round", proposal.Round)
+	return signResult(proposal)
+}
+
+func (ss *signerServer) SignHeartbeat(ctx context.Context, param *SignParam) (*SignResult, error) {
+	heartbeat := new(tmTypes.Heartbeat)
+	err := cdc.UnmarshalBinaryBare(param.Message, heartbeat)
+	if err != nil {
+		return nil, err
+	}
+	err = ss.privValidator.SignHeartbeat(param.ChainID, heartbeat)
+	if err != nil {
+		return nil, err
+	}
+	return signResult(heartbeat)
+}
+
+func signResult(signed interface{}) (*SignResult, error) {
+	bs, err := cdc.MarshalBinaryBare(signed)
+	if err != nil {
+		return nil, err
+	}
+	return &SignResult{Message: bs}, nil
+}
@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: signer.proto
+
+/*
+	Package signer is a generated protocol buffer package.
+
+	It is generated from these files:
+		signer.proto
+
+	It has these top-level messages:
+		PublicKeyParam
+		PublicKeyResult
+		SignParam
+		SignResult
+*/
+package signer
+
+import proto "github.com/gogo/protobuf/proto"
+import golang_proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import crypto "github.com/hyperledger/burrow/crypto"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type PublicKeyParam struct {
+}
+
+func (m *PublicKeyParam) Reset()                    { *m = PublicKeyParam{} }
+func (m *PublicKeyParam) String() string            { return proto.CompactTextString(m) }
+func (*PublicKeyParam) ProtoMessage()               {}
+func (*PublicKeyParam) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{0} }
+
+func (*PublicKeyParam) XXX_MessageName() string {
+	return "signer.PublicKeyParam"
+}
+
+type PublicKeyResult struct {
+	PublicKey crypto.PublicKey `protobuf:"bytes,1,opt,name=PublicKey" json:"PublicKey"`
+}
+
+func (m *PublicKeyResult) Reset()                    { *m = PublicKeyResult{} }
+func (m *PublicKeyResult) String() string            { return proto.CompactTextString(m) }
+func (*PublicKeyResult) ProtoMessage()               {}
+func (*PublicKeyResult) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{1} }
+
+func (m *PublicKeyResult) GetPublicKey() crypto.PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (*PublicKeyResult) XXX_MessageName() string {
+	return "signer.PublicKeyResult"
+}
+
+type SignParam struct {
+	ChainID string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	// Amino encoded Tendermint Vote, Proposal, or Heartbeat to sign
+	Message []byte `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (m *SignParam) Reset()                    { *m = SignParam{} }
+func (m *SignParam) String() string            { return proto.CompactTextString(m) }
+func (*SignParam) ProtoMessage()               {}
+func (*SignParam) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{2} }
+
+func (m *SignParam) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *SignParam) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (*SignParam) XXX_MessageName() string {
+	return "signer.SignParam"
+}
+
+type SignResult struct {
+	// Amino encoded signed Vote, Proposal, or Heartbeat
+	Message []byte `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (m *SignResult) Reset()                    { *m = SignResult{} }
+func (m *SignResult) String() string            { return proto.CompactTextString(m) }
+func (*SignResult) ProtoMessage()               {}
+func (*SignResult) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{3} }
+
+func (m *SignResult) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (*SignResult) XXX_MessageName() string {
+	return "signer.SignResult"
+}
+func init() {
+	proto.RegisterType((*PublicKeyParam)(nil), "signer.PublicKeyParam")
+	golang_proto.RegisterType((*PublicKeyParam)(nil), "signer.PublicKeyParam")
+	proto.RegisterType((*PublicKeyResult)(nil), "signer.PublicKeyResult")
+	golang_proto.RegisterType((*PublicKeyResult)(nil), "signer.PublicKeyResult")
+	proto.RegisterType((*SignParam)(nil), "signer.SignParam")
+	golang_proto.RegisterType((*SignParam)(nil), "signer.SignParam")
+	proto.RegisterType((*SignResult)(nil), "signer.SignResult")
+	golang_proto.RegisterType((*SignResult)(nil), "signer.SignResult")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Signer service
+
+type SignerClient interface {
+	PublicKey(ctx context.Context, in *PublicKeyParam, opts ...grpc.CallOption) (*PublicKeyResult, error)
+	SignVote(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error)
+	SignProposal(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error)
+	SignHeartbeat(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error)
+}
+
+type signerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSignerClient(cc *grpc.ClientConn) SignerClient {
+	return &signerClient{cc}
+}
+
+func (c *signerClient) PublicKey(ctx context.Context, in *PublicKeyParam, opts ...grpc.CallOption) (*PublicKeyResult, error) {
+	out := new(PublicKeyResult)
+	err := grpc.Invoke(ctx, "/signer.Signer/PublicKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignVote(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error) {
+	out := new(SignResult)
+	err := grpc.Invoke(ctx, "/signer.Signer/SignVote", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignProposal(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error) {
+	out := new(SignResult)
+	err := grpc.Invoke(ctx, "/signer.Signer/SignProposal", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signerClient) SignHeartbeat(ctx context.Context, in *SignParam, opts ...grpc.CallOption) (*SignResult, error) {
+	out := new(SignResult)
+	err := grpc.Invoke(ctx, "/signer.Signer/SignHeartbeat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Signer service
+
+type SignerServer interface {
+	PublicKey(context.Context, *PublicKeyParam) (*PublicKeyResult, error)
+	SignVote(context.Context, *SignParam) (*SignResult, error)
+	SignProposal(context.Context, *SignParam) (*SignResult, error)
+	SignHeartbeat(context.Context, *SignParam) (*SignResult, error)
+}
+
+func RegisterSignerServer(s *grpc.Server, srv SignerServer) {
+	s.RegisterService(&_Signer_serviceDesc, srv)
+}
+
+func _Signer_PublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeyParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).PublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.Signer/PublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).PublicKey(ctx, req.(*PublicKeyParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.Signer/SignVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignVote(ctx, req.(*SignParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.Signer/SignProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignProposal(ctx, req.(*SignParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Signer_SignHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignerServer).SignHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.Signer/SignHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignerServer).SignHeartbeat(ctx, req.(*SignParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "signer.Signer",
+	HandlerType: (*SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublicKey",
+			Handler:    _Signer_PublicKey_Handler,
+		},
+		{
+			MethodName: "SignVote",
+			Handler:    _Signer_SignVote_Handler,
+		},
+		{
+			MethodName: "SignProposal",
+			Handler:    _Signer_SignProposal_Handler,
+		},
+		{
+			MethodName: "SignHeartbeat",
+			Handler:    _Signer_SignHeartbeat_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
+
+func (m *PublicKeyParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublicKeyParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *PublicKeyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublicKeyResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintSigner(dAtA, i, uint64(m.PublicKey.Size()))
+	n1, err := m.PublicKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	return i, nil
+}
+
+func (m *SignParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.ChainID)))
+		i += copy(dAtA[i:], m.ChainID)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	return i, nil
+}
+
+func (m *SignResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	return i, nil
+}
+
+func encodeVarintSigner(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *PublicKeyParam) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PublicKeyResult) Size() (n int) {
+	var l int
+	_ = l
+	l = m.PublicKey.Size()
+	n += 1 + l + sovSigner(uint64(l))
+	return n
+}
+
+func (m *SignParam) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignResult) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func sovSigner(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSigner(x uint64) (n int) {
+	return sovSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PublicKeyParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublicKeyParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublicKeyParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublicKeyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublicKeyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublicKeyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthSigner
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSigner
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSigner(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSigner = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigner   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("signer.proto", fileDescriptorSigner) }
+func init() { golang_proto.RegisterFile("signer.proto", fileDescriptorSigner) }
+
+var fileDescriptorSigner = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x5d, 0x91, 0x6a, 0xd7, 0xf8, 0xa7, 0x7b, 0xd0, 0x92, 0x43, 0x2c, 0x39, 0x48, 0x2f,
+	0x26, 0x58, 0x51, 0x10, 0x04, 0xa1, 0x7a, 0xa8, 0x88, 0x58, 0x52, 0xf0, 0xe0, 0x6d, 0x93, 0x8e,
+	0xdb, 0x40, 0xba, 0x1b, 0x76, 0x37, 0x48, 0xdf, 0xce, 0x63, 0x8f, 0x3e, 0x81, 0x48, 0x7b, 0xf1,
+	0x31, 0x24, 0xdb, 0x34, 0xa6, 0x78, 0xe9, 0x2d, 0xbf, 0x6f, 0xbe, 0xf9, 0xb2, 0x33, 0x0c, 0xb6,
+	0x54, 0xcc, 0x38, 0x48, 0x2f, 0x95, 0x42, 0x0b, 0x52, 0x5b, 0x90, 0x7d, 0xc6, 0x62, 0x3d, 0xca,
+	0x42, 0x2f, 0x12, 0x63, 0x9f, 0x09, 0x26, 0x7c, 0x53, 0x0e, 0xb3, 0x37, 0x43, 0x06, 0xcc, 0xd7,
+	0xa2, 0xcd, 0xb6, 0x22, 0x39, 0x49, 0x75, 0x41, 0xee, 0x21, 0xde, 0xef, 0x67, 0x61, 0x12, 0x47,
+	0x8f, 0x30, 0xe9, 0x53, 0x49, 0xc7, 0x6e, 0x0f, 0x1f, 0x94, 0x4a, 0x00, 0x2a, 0x4b, 0x34, 0xb9,
+	0xc4, 0xf5, 0x52, 0x6a, 0xa2, 0x16, 0x6a, 0xef, 0x76, 0x1a, 0x5e, 0x11, 0x53, 0x16, 0xba, 0x5b,
+	0xd3, 0xaf, 0x93, 0x8d, 0xe0, 0xcf, 0xe9, 0xde, 0xe2, 0xfa, 0x20, 0x66, 0xdc, 0xc4, 0x92, 0x26,
+	0xde, 0xbe, 0x1b, 0xd1, 0x98, 0x3f, 0xdc, 0x9b, 0x84, 0x7a, 0xb0, 0xc4, 0xbc, 0xf2, 0x04, 0x4a,
+	0x51, 0x06, 0xcd, 0xcd, 0x16, 0x6a, 0x5b, 0xc1, 0x12, 0xdd, 0x53, 0x8c, 0xf3, 0x80, 0xe2, 0x15,
+	0x15, 0x1f, 0x5a, 0xf1, 0x75, 0x7e, 0x10, 0xae, 0x0d, 0xcc, 0x32, 0xc8, 0x4d, 0xe5, 0xa9, 0xe4,
+	0xc8, 0x2b, 0x16, 0xb6, 0x3a, 0xa2, 0x7d, 0xfc, 0x4f, 0x2f, 0x7e, 0x71, 0x8e, 0x77, 0xf2, 0x9c,
+	0x17, 0xa1, 0x81, 0x34, 0x96, 0xa6, 0x72, 0x06, 0x9b, 0x54, 0xa5, 0x72, 0x37, 0x96, 0x31, 0x48,
+	0x91, 0x0a, 0x45, 0x93, 0x75, 0xdb, 0xae, 0xf0, 0x5e, 0x4e, 0x3d, 0xa0, 0x52, 0x87, 0x40, 0xf5,
+	0x9a, 0x7d, 0xdd, 0xe7, 0xe9, 0xcc, 0x41, 0x9f, 0x33, 0x07, 0x7d, 0xcf, 0x1c, 0xf4, 0x31, 0x77,
+	0xd0, 0x74, 0xee, 0xa0, 0xd7, 0xeb, 0xca, 0x09, 0x8c, 0x26, 0x29, 0xc8, 0x04, 0x86, 0x0c, 0xa4,
+	0x1f, 0x66, 0x52, 0x8a, 0x77, 0x3f, 0x12, 0x5c, 0x01, 0x57, 0x99, 0xf2, 0x35, 0xf0, 0x21, 0xc8,
+	0x71, 0xcc, 0xb5, 0xbf, 0xc8, 0x0e, 0x6b, 0xe6, 0x0e, 0x2e, 0x7e, 0x07, 0x00, 0x1a, 0x0c, 0x68,
+	0xef, 0x5c, 0x02, 0x00, 0x00,
+}
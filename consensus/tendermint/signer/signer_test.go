@@ -0,0 +1,81 @@
+package signer
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+)
+
+const chainID = "signer-test-chain"
+
+func TestRemotePrivValidator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	stateFile := path.Join(dir, DefaultStateFile)
+	val := acm.GeneratePrivateAccountFromSecret("validator")
+
+	address, stop := startSigner(t, val, stateFile)
+	pv, err := NewRemotePrivValidator(address, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.Equal(t, val.Address(), pv.Address())
+	assert.Equal(t, val.PublicKey(), pv.PublicKey())
+
+	vote := newVote(val, 1, "block one")
+	require.NoError(t, pv.SignVote(chainID, vote))
+	require.NoError(t, vote.Verify(chainID, pv.GetPubKey()))
+
+	// Signing the same vote again is idempotent
+	again := newVote(val, 1, "block one")
+	again.Timestamp = vote.Timestamp
+	require.NoError(t, pv.SignVote(chainID, again))
+	assert.Equal(t, vote.Signature, again.Signature)
+
+	// But signing a conflicting vote for the same height, round, and step is refused
+	assert.Error(t, pv.SignVote(chainID, newVote(val, 1, "block two")))
+
+	proposal := &tmTypes.Proposal{Height: 2, Round: 0, Timestamp: time.Now().UTC(), POLRound: -1}
+	require.NoError(t, pv.SignProposal(chainID, proposal))
+	assert.True(t, pv.GetPubKey().VerifyBytes(proposal.SignBytes(chainID), proposal.Signature))
+	stop()
+
+	// A restarted signer must remember what it signed
+	address, stop = startSigner(t, val, stateFile)
+	defer stop()
+	pv, err = NewRemotePrivValidator(address, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.Error(t, pv.SignVote(chainID, newVote(val, 1, "block two")), "height regression")
+	require.NoError(t, pv.SignVote(chainID, newVote(val, 3, "block three")))
+}
+
+func startSigner(t *testing.T, val *acm.PrivateAccount, stateFile string) (string, func()) {
+	privValidator, err := tendermint.NewPrivValidatorFile(val, val, stateFile)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	RegisterSignerServer(grpcServer, NewSignerServer(privValidator, logging.NewNoopLogger()))
+	go grpcServer.Serve(listener)
+	return listener.Addr().String(), grpcServer.Stop
+}
+
+func newVote(val *acm.PrivateAccount, height int64, block string) *tmTypes.Vote {
+	return &tmTypes.Vote{
+		ValidatorAddress: val.Address().Bytes(),
+		Height:           height,
+		Timestamp:        time.Now().UTC(),
+		Type:             tmTypes.VoteTypePrevote,
+		BlockID:          tmTypes.BlockID{Hash: []byte(block)},
+	}
+}
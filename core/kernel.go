@@ -30,6 +30,7 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/consensus/tendermint/abci"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/genesis"
@@ -43,12 +44,14 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
 	tmConfig "github.com/tendermint/tendermint/config"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/node"
 	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -75,13 +78,19 @@ type Kernel struct {
 	processes      map[string]process.Process
 	shutdownNotify chan struct{}
 	shutdownOnce   sync.Once
+	// Server certificates to reload on SIGHUP
+	tlsServers []*tlsconfig.Server
 }
 
 func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTypes.PrivValidator,
 	genesisDoc *genesis.GenesisDoc, tmConf *tmConfig.Config, rpcConfig *rpc.RPCConfig, keyConfig *keys.KeysConfig,
 	keyStore *keys.KeyStore, exeOptions []execution.ExecutionOption, logger *logging.Logger) (*Kernel, error) {
 
-	var err error
+	// Must precede anything deriving addresses
+	err := crypto.SetAddressDerivation(genesisDoc.AddressDerivation)
+	if err != nil {
+		return nil, fmt.Errorf("could not set address derivation from GenesisDoc: %v", err)
+	}
 	kern := &Kernel{
 		processes:      make(map[string]process.Process),
 		shutdownNotify: make(chan struct{}),
@@ -142,7 +151,22 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 	if err != nil {
 		return nil, err
 	}
-	kern.Service = rpc.NewService(accountState, nameRegState, kern.Blockchain, nodeView, kern.Logger)
+	var limiter *rpc.Limiter
+	if rpcConfig.Limits != nil && rpcConfig.Limits.Enabled {
+		limiter, err = rpc.NewLimiter(rpcConfig.Limits, kern.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not configure RPC limits: %v", err)
+		}
+	}
+	kern.Service = rpc.NewService(accountState, nameRegState, kern.Blockchain, nodeView, limiter, kern.Logger)
+
+	var auth *rpc.Auth
+	if rpcConfig.Auth != nil && rpcConfig.Auth.Enabled {
+		auth, err = rpc.NewAuth(rpcConfig.Auth, kern.Logger)
+		if err != nil {
+			return nil, fmt.Errorf("could not configure RPC authentication: %v", err)
+		}
+	}
 
 	kern.Launchers = []process.Launcher{
 		{
@@ -202,7 +226,12 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 			Name:    "RPC/info",
 			Enabled: rpcConfig.Info.Enabled,
 			Launch: func() (process.Process, error) {
-				server, err := rpcinfo.StartServer(kern.Service, "/websocket", rpcConfig.Info.ListenAddress, kern.Logger)
+				tlsServer, err := kern.loadTLS(rpcConfig.Info.TLS)
+				if err != nil {
+					return nil, err
+				}
+				server, err := rpcinfo.StartServer(kern.Service, "/websocket", rpcConfig.Info.ListenAddress, auth,
+					tlsServer.TLSConfig(), kern.Logger)
 				if err != nil {
 					return nil, err
 				}
@@ -213,8 +242,13 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 			Name:    "RPC/metrics",
 			Enabled: rpcConfig.Metrics.Enabled,
 			Launch: func() (process.Process, error) {
+				tlsServer, err := kern.loadTLS(rpcConfig.Metrics.TLS)
+				if err != nil {
+					return nil, err
+				}
 				server, err := metrics.StartServer(kern.Service, rpcConfig.Metrics.MetricsPath,
-					rpcConfig.Metrics.ListenAddress, rpcConfig.Metrics.BlockSampleSize, kern.Logger)
+					rpcConfig.Metrics.ListenAddress, rpcConfig.Metrics.BlockSampleSize, tlsServer.TLSConfig(),
+					kern.Logger)
 				if err != nil {
 					return nil, err
 				}
@@ -225,12 +259,21 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 			Name:    "RPC/GRPC",
 			Enabled: rpcConfig.GRPC.Enabled,
 			Launch: func() (process.Process, error) {
+				tlsServer, err := kern.loadTLS(rpcConfig.GRPC.TLS)
+				if err != nil {
+					return nil, err
+				}
+				var opts []grpc.ServerOption
+				if tlsServer != nil {
+					opts = append(opts, tlsServer.GRPCServerOption())
+				}
+
 				listen, err := net.Listen("tcp", rpcConfig.GRPC.ListenAddress)
 				if err != nil {
 					return nil, err
 				}
 
-				grpcServer := rpc.NewGRPCServer(kern.Logger)
+				grpcServer := rpc.NewGRPCServer(kern.Logger, auth, limiter, opts...)
 				var ks *keys.KeyStore
 				if keyStore != nil {
 					ks = keyStore
@@ -238,13 +281,16 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 
 				if keyConfig.GRPCServiceEnabled {
 					if keyStore == nil {
-						ks = keys.NewKeyStore(keyConfig.KeysDirectory, keyConfig.AllowBadFilePermissions, kern.Logger)
+						ks, err = keys.NewKeyStoreFromConfig(keyConfig, kern.Logger)
+						if err != nil {
+							return nil, err
+						}
 					}
 					keys.RegisterKeysServer(grpcServer, ks)
 				}
 
 				rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, nameRegState,
-					kern.Blockchain, nodeView, kern.Logger))
+					kern.Blockchain, nodeView, limiter, kern.Logger))
 
 				rpctransact.RegisterTransactServer(grpcServer, rpctransact.NewTransactServer(transactor, txCodec))
 
@@ -290,6 +336,28 @@ func (kern *Kernel) Panic(err error) {
 	os.Exit(1)
 }
 
+// Loads the certificates for a server's TLS config (nil if TLS is not enabled) and registers them for reloading
+func (kern *Kernel) loadTLS(conf *tlsconfig.ServerConfig) (*tlsconfig.Server, error) {
+	tlsServer, err := tlsconfig.NewServer(conf)
+	if err != nil {
+		return nil, err
+	}
+	if tlsServer != nil {
+		kern.tlsServers = append(kern.tlsServers, tlsServer)
+	}
+	return tlsServer, nil
+}
+
+func (kern *Kernel) reloadTLS() {
+	for _, tlsServer := range kern.tlsServers {
+		err := tlsServer.Reload()
+		if err != nil {
+			kern.Logger.InfoMsg("Could not reload TLS certificates, continuing to use existing certificates",
+				structure.ErrorKey, err)
+		}
+	}
+}
+
 // Wait for a graceful shutdown
 func (kern *Kernel) WaitForShutdown() {
 	// Supports multiple goroutines waiting for shutdown since channel is closed
@@ -309,6 +377,7 @@ func (kern *Kernel) supervise() {
 		select {
 		case <-reloadCh:
 			kern.Logger.Reload()
+			kern.reloadTLS()
 		case <-syncCh:
 			kern.Logger.Sync()
 		case sig := <-shutdownCh:
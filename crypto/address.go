@@ -186,7 +186,15 @@ func (address *Address) Size() int {
 	return binary.Word160Length
 }
 
+// NewContractAddress derives the address of a contract from its creator and the creator's sequence once incremented
+// for the creating transaction (so 1 for an account's first transaction)
 func NewContractAddress(caller Address, sequence uint64) (newAddr Address) {
+	if ethereumAddresses() {
+		// Only the hash is Ethereum's, the sequence stands in for the nonce. A contract's sequence counts the contracts
+		// it has created, as its Ethereum nonce does from 1, so their addresses agree. But a creating CallTx increments
+		// its sender's sequence twice so contracts deployed by accounts are not where Ethereum would put them.
+		return ethereumContractAddress(caller, sequence)
+	}
 	temp := make([]byte, 32+8)
 	copy(temp, caller[:])
 	binary.PutUint64BE(temp[32:], uint64(sequence))
@@ -0,0 +1,112 @@
+package crypto
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+)
+
+const (
+	// Burrow's own derivation, RIPEMD160(SHA256(compressed public key)) for secp256k1 keys
+	AddressDerivationBurrow = "burrow"
+	// Ethereum's derivation, the last 20 bytes of the Keccak-256 hash of the uncompressed secp256k1 public key.
+	// Contract addresses are likewise the last 20 bytes of the Keccak-256 hash of RLP([creator, sequence]), which is
+	// not RLP([creator, nonce]) for contracts deployed by accounts (see NewContractAddress).
+	AddressDerivationEthereum = "ethereum"
+)
+
+// Addresses of a chain must be derived the same way everywhere they are derived within a process (accounts, keys,
+// signatures, and contracts), since PublicKey.Address takes no context this is held process-wide and set from the
+// GenesisDoc (or keys configuration for a standalone keys server) before any addresses are derived
+var ethereumAddressDerivation int32
+
+// SetAddressDerivation selects how addresses are derived from secp256k1 public keys and contract creators, the empty
+// string selects the default AddressDerivationBurrow
+func SetAddressDerivation(derivation string) error {
+	switch derivation {
+	case "", AddressDerivationBurrow:
+		atomic.StoreInt32(&ethereumAddressDerivation, 0)
+	case AddressDerivationEthereum:
+		atomic.StoreInt32(&ethereumAddressDerivation, 1)
+	default:
+		return fmt.Errorf("unknown address derivation '%s', expected '%s' or '%s'", derivation,
+			AddressDerivationBurrow, AddressDerivationEthereum)
+	}
+	return nil
+}
+
+// AddressDerivation returns the address derivation in use
+func AddressDerivation() string {
+	if ethereumAddresses() {
+		return AddressDerivationEthereum
+	}
+	return AddressDerivationBurrow
+}
+
+func ethereumAddresses() bool {
+	return atomic.LoadInt32(&ethereumAddressDerivation) == 1
+}
+
+// EthereumAddress returns the Ethereum address of a secp256k1 public key whatever the address derivation in use
+func (p PublicKey) EthereumAddress() (Address, error) {
+	if p.CurveType != CurveTypeSecp256k1 {
+		return Address{}, fmt.Errorf("only secp256k1 keys have Ethereum addresses but key has curve type %v",
+			p.CurveType)
+	}
+	return ethereumAddress(p.PublicKey), nil
+}
+
+func ethereumAddress(publicKey []byte) Address {
+	pub, err := btcec.ParsePubKey(publicKey, btcec.S256())
+	if err != nil {
+		// Consistent with the other derivations which hash whatever bytes they are given
+		addr, _ := AddressFromBytes(sha3.Sha3(publicKey)[12:])
+		return addr
+	}
+	// Drop the 0x04 uncompressed prefix
+	addr, _ := AddressFromBytes(sha3.Sha3(pub.SerializeUncompressed()[1:])[12:])
+	return addr
+}
+
+func ethereumContractAddress(caller Address, sequence uint64) (newAddr Address) {
+	copy(newAddr[:], sha3.Sha3(rlpList(rlpBytes(caller[:]), rlpUint64(sequence)))[12:])
+	return
+}
+
+// Just enough RLP to encode a contract creator and sequence
+
+func rlpBytes(bs []byte) []byte {
+	if len(bs) == 1 && bs[0] < 0x80 {
+		return bs
+	}
+	return append(rlpLength(0x80, len(bs)), bs...)
+}
+
+func rlpUint64(i uint64) []byte {
+	var bs []byte
+	for ; i > 0; i >>= 8 {
+		bs = append([]byte{byte(i)}, bs...)
+	}
+	return rlpBytes(bs)
+}
+
+func rlpList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	return append(rlpLength(0xc0, len(payload)), payload...)
+}
+
+func rlpLength(offset byte, length int) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+	var bs []byte
+	for l := length; l > 0; l >>= 8 {
+		bs = append([]byte{byte(l)}, bs...)
+	}
+	return append([]byte{offset + 55 + byte(len(bs))}, bs...)
+}
@@ -25,6 +25,37 @@ func TestNewContractAddress(t *testing.T) {
 	}, addr)
 }
 
+func TestEthereumAddressDerivation(t *testing.T) {
+	require.NoError(t, SetAddressDerivation(AddressDerivationEthereum))
+	defer SetAddressDerivation("")
+	assert.Equal(t, AddressDerivationEthereum, AddressDerivation())
+
+	raw := make([]byte, 32)
+	raw[31] = 1
+	privateKey, err := PrivateKeyFromRawBytes(raw, CurveTypeSecp256k1)
+	require.NoError(t, err)
+	assert.Equal(t, "7E5F4552091A69125D5DFCB7B8C2659029395BDF", privateKey.GetPublicKey().Address().String())
+
+	// ed25519 is unaffected
+	edKey, err := GeneratePrivateKey(nil, CurveTypeEd25519)
+	require.NoError(t, err)
+	require.NoError(t, SetAddressDerivation(AddressDerivationBurrow))
+	edAddress := edKey.GetPublicKey().Address()
+	require.NoError(t, SetAddressDerivation(AddressDerivationEthereum))
+	assert.Equal(t, edAddress, edKey.GetPublicKey().Address())
+
+	caller, err := AddressFromHexString("6AC7EA33F8831EA9DCC53393AAA88B25A785DBF0")
+	require.NoError(t, err)
+	// Ethereum puts a contract's first two creations at nonces 1 and 2, as does Burrow at sequences 1 and 2
+	assert.Equal(t, "343C43A37D37DFF08AE8C4A11544C718ABB4FCF8", NewContractAddress(caller, 1).String())
+	assert.Equal(t, "F778B86FA74E846C4F0A1FBD1335FE81C00A0C91", NewContractAddress(caller, 2).String())
+	// But an account's two consecutive deploys, at Ethereum nonces 0 and 1, are at Burrow sequences 1 and 3 so land
+	// where Ethereum would put its deploys at nonces 1 and 3
+	assert.Equal(t, "FFFD933A0BC612844EAF0C6FE3E5B8E9B6C1D19C", NewContractAddress(caller, 3).String())
+
+	assert.Error(t, SetAddressDerivation("ripple"))
+}
+
 func TestAddress_MarshalJSON(t *testing.T) {
 	addr := Address{
 		73, 234, 48, 252, 174,
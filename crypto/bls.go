@@ -0,0 +1,159 @@
+package crypto
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// BLS12-381 keys are scalars with public keys in G1 and signatures in G2 (the 'minimal public key size' variant)
+const (
+	BLSPrivateKeyLength = 32
+	BLSPublicKeyLength  = 48
+	BLSSignatureLength  = 96
+)
+
+// We use the message augmentation scheme in which each signer signs its public key prepended to the message. This
+// means an aggregate signature over the same message by many signers cannot be forged by a rogue key without needing
+// proofs of possession for public keys
+var blsDomain = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_")
+
+func blsOrder() *big.Int {
+	return bls12381.NewG1().Q()
+}
+
+// Derive a BLS secret scalar in the range [1, r) from 32 random bytes, which we expand to 64 so that reduction modulo
+// the group order is not noticeably biased
+func generateBLSPrivateKey(random io.Reader) (PrivateKey, error) {
+	seed := make([]byte, 32)
+	_, err := io.ReadFull(random, seed)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+	expanded := sha512.Sum512(seed)
+	order := blsOrder()
+	sk := new(big.Int).SetBytes(expanded[:])
+	sk.Mod(sk, new(big.Int).Sub(order, big.NewInt(1)))
+	sk.Add(sk, big.NewInt(1))
+	return blsPrivateKeyFromScalar(sk)
+}
+
+func blsPrivateKeyFromRawBytes(privKeyBytes []byte) (PrivateKey, error) {
+	if len(privKeyBytes) != BLSPrivateKeyLength {
+		return PrivateKey{}, fmt.Errorf("bytes passed have length %v but BLS12-381 private keys have %v bytes",
+			len(privKeyBytes), BLSPrivateKeyLength)
+	}
+	sk := new(big.Int).SetBytes(privKeyBytes)
+	if sk.Sign() == 0 || sk.Cmp(blsOrder()) >= 0 {
+		return PrivateKey{}, fmt.Errorf("BLS12-381 private key is not a valid scalar")
+	}
+	return blsPrivateKeyFromScalar(sk)
+}
+
+func blsPrivateKeyFromScalar(sk *big.Int) (PrivateKey, error) {
+	g1 := bls12381.NewG1()
+	pk := g1.MulScalarBig(g1.New(), g1.One(), sk)
+	privKeyBytes := make([]byte, BLSPrivateKeyLength)
+	sk.FillBytes(privKeyBytes)
+	return PrivateKey{
+		PrivateKey: privKeyBytes,
+		PublicKey:  g1.ToCompressed(pk),
+		CurveType:  CurveTypeBLS12381,
+	}, nil
+}
+
+func blsSign(privateKey PrivateKey, msg []byte) (Signature, error) {
+	if len(privateKey.PrivateKey) != BLSPrivateKeyLength {
+		return Signature{}, fmt.Errorf("bytes passed have length %v but BLS12-381 private keys have %v bytes",
+			len(privateKey.PrivateKey), BLSPrivateKeyLength)
+	}
+	g2 := bls12381.NewG2()
+	point, err := g2.HashToCurve(blsAugment(privateKey.PublicKey, msg), blsDomain)
+	if err != nil {
+		return Signature{}, err
+	}
+	sig := g2.MulScalarBig(g2.New(), point, new(big.Int).SetBytes(privateKey.PrivateKey))
+	return Signature{CurveType: CurveTypeBLS12381, Signature: g2.ToCompressed(sig)}, nil
+}
+
+func blsVerify(publicKey PublicKey, msg []byte, signature Signature) error {
+	return VerifyAggregateSignature([]PublicKey{publicKey}, [][]byte{msg}, signature)
+}
+
+// AggregateSignatures combines BLS12-381 signatures (possibly over different messages) into a single signature that
+// can be checked with VerifyAggregateSignature
+func AggregateSignatures(signatures ...Signature) (Signature, error) {
+	if len(signatures) == 0 {
+		return Signature{}, fmt.Errorf("no signatures to aggregate")
+	}
+	g2 := bls12381.NewG2()
+	aggregate := g2.Zero()
+	for i, signature := range signatures {
+		if signature.CurveType != CurveTypeBLS12381 {
+			return Signature{}, fmt.Errorf("can only aggregate BLS12-381 signatures but signature %d has curve "+
+				"type %v", i, signature.CurveType)
+		}
+		sig, err := g2.FromCompressed(signature.Signature)
+		if err != nil {
+			return Signature{}, fmt.Errorf("could not decode BLS12-381 signature %d: %v", i, err)
+		}
+		g2.Add(aggregate, aggregate, sig)
+	}
+	return Signature{CurveType: CurveTypeBLS12381, Signature: g2.ToCompressed(aggregate)}, nil
+}
+
+// VerifyAggregateSignature checks that aggregate is the aggregation of a signature by each of publicKeys over the
+// message at the same position in msgs. Messages need not be distinct.
+func VerifyAggregateSignature(publicKeys []PublicKey, msgs [][]byte, aggregate Signature) error {
+	if len(publicKeys) == 0 {
+		return fmt.Errorf("no public keys provided to verify aggregate signature")
+	}
+	if len(publicKeys) != len(msgs) {
+		return fmt.Errorf("number of public keys (= %d) should equal number of messages (= %d)",
+			len(publicKeys), len(msgs))
+	}
+	if aggregate.CurveType != CurveTypeBLS12381 {
+		return fmt.Errorf("signature has curve type %v but BLS12-381 is required", aggregate.CurveType)
+	}
+	g1 := bls12381.NewG1()
+	g2 := bls12381.NewG2()
+	sig, err := g2.FromCompressed(aggregate.Signature)
+	if err != nil {
+		return fmt.Errorf("could not decode BLS12-381 signature: %v", err)
+	}
+	engine := bls12381.NewEngine()
+	// e(G1, sig) == Π e(pk_i, H(pk_i || msg_i))
+	engine.AddPairInv(g1.One(), sig)
+	for i, publicKey := range publicKeys {
+		if publicKey.CurveType != CurveTypeBLS12381 {
+			return fmt.Errorf("public key %v has curve type %v but BLS12-381 is required", publicKey,
+				publicKey.CurveType)
+		}
+		pk, err := g1.FromCompressed(publicKey.PublicKey)
+		if err != nil {
+			return fmt.Errorf("could not decode BLS12-381 public key %v: %v", publicKey, err)
+		}
+		if g1.IsZero(pk) {
+			return fmt.Errorf("BLS12-381 public key %v is the identity", publicKey)
+		}
+		point, err := g2.HashToCurve(blsAugment(publicKey.PublicKey, msgs[i]), blsDomain)
+		if err != nil {
+			return err
+		}
+		engine.AddPair(pk, point)
+	}
+	if !engine.Check() {
+		return fmt.Errorf("'%X' is not a valid BLS12-381 signature for the messages given", aggregate.Signature)
+	}
+	return nil
+}
+
+func blsAugment(publicKey, msg []byte) []byte {
+	augmented := make([]byte, len(publicKey)+len(msg))
+	copy(augmented, publicKey)
+	copy(augmented[len(publicKey):], msg)
+	return augmented
+}
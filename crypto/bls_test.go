@@ -0,0 +1,57 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLSSignAndVerify(t *testing.T) {
+	privateKey, err := GeneratePrivateKey(nil, CurveTypeBLS12381)
+	require.NoError(t, err)
+	publicKey := privateKey.GetPublicKey()
+	assert.True(t, publicKey.IsValid())
+
+	msg := []byte("Flat is better than nested")
+	sig, err := privateKey.Sign(msg)
+	require.NoError(t, err)
+	assert.Len(t, sig.Signature, BLSSignatureLength)
+	assert.NoError(t, publicKey.Verify(msg, sig))
+	assert.Error(t, publicKey.Verify([]byte("Nested is better than flat"), sig))
+
+	reinitialised, err := PrivateKeyFromRawBytes(privateKey.RawBytes(), CurveTypeBLS12381)
+	require.NoError(t, err)
+	assert.Equal(t, privateKey, reinitialised)
+	assert.Equal(t, PrivateKeyFromSecret("foo", CurveTypeBLS12381), PrivateKeyFromSecret("foo", CurveTypeBLS12381))
+}
+
+func TestBLSAggregateSignatures(t *testing.T) {
+	msg := []byte("one message")
+	var publicKeys []PublicKey
+	var msgs [][]byte
+	var sigs []Signature
+	for i := 0; i < 4; i++ {
+		privateKey, err := GeneratePrivateKey(nil, CurveTypeBLS12381)
+		require.NoError(t, err)
+		sig, err := privateKey.Sign(msg)
+		require.NoError(t, err)
+		publicKeys = append(publicKeys, privateKey.GetPublicKey())
+		msgs = append(msgs, msg)
+		sigs = append(sigs, sig)
+	}
+	aggregate, err := AggregateSignatures(sigs...)
+	require.NoError(t, err)
+	assert.Len(t, aggregate.Signature, BLSSignatureLength)
+	require.NoError(t, VerifyAggregateSignature(publicKeys, msgs, aggregate))
+
+	// Missing a signatory
+	assert.Error(t, VerifyAggregateSignature(publicKeys[1:], msgs[1:], aggregate))
+	// Different message for one signatory
+	assert.Error(t, VerifyAggregateSignature(publicKeys, append([][]byte{[]byte("other")}, msgs[1:]...), aggregate))
+	// Cannot mix curves
+	ed25519Sig, err := PrivateKeyFromSecret("foo", CurveTypeEd25519).Sign(msg)
+	require.NoError(t, err)
+	_, err = AggregateSignatures(sigs[0], ed25519Sig)
+	assert.Error(t, err)
+}
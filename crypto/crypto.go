@@ -10,6 +10,7 @@ const (
 	CurveTypeUnset CurveType = iota
 	CurveTypeEd25519
 	CurveTypeSecp256k1
+	CurveTypeBLS12381
 )
 
 func (k CurveType) String() string {
@@ -18,6 +19,8 @@ func (k CurveType) String() string {
 		return "secp256k1"
 	case CurveTypeEd25519:
 		return "ed25519"
+	case CurveTypeBLS12381:
+		return "bls12-381"
 	case CurveTypeUnset:
 		return ""
 	default:
@@ -48,6 +51,8 @@ func CurveTypeFromString(s string) (CurveType, error) {
 		return CurveTypeSecp256k1, nil
 	case "ed25519":
 		return CurveTypeEd25519, nil
+	case "bls12-381":
+		return CurveTypeBLS12381, nil
 	case "":
 		return CurveTypeUnset, nil
 	default:
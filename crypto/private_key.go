@@ -25,6 +25,11 @@ func PublicKeyFromBytes(bs []byte, curveType CurveType) (PublicKey, error) {
 			return PublicKey{}, fmt.Errorf("bytes passed have length %v but secp256k1 public keys have %v bytes",
 				len(bs), btcec.PubKeyBytesLenCompressed)
 		}
+	case CurveTypeBLS12381:
+		if len(bs) != BLSPublicKeyLength {
+			return PublicKey{}, fmt.Errorf("bytes passed have length %v but BLS12-381 public keys have %v bytes",
+				len(bs), BLSPublicKeyLength)
+		}
 	case CurveTypeUnset:
 		if len(bs) > 0 {
 			return PublicKey{}, fmt.Errorf("attempting to create an 'unset' PublicKey but passed non-empty key bytes: %X", bs)
@@ -61,6 +66,8 @@ func (p PrivateKey) Sign(msg []byte) (Signature, error) {
 			return Signature{}, err
 		}
 		return Signature{CurveType: CurveTypeSecp256k1, Signature: sig.Serialize()}, nil
+	case CurveTypeBLS12381:
+		return blsSign(p, msg)
 	default:
 		return Signature{}, ErrInvalidCurve(p.CurveType)
 	}
@@ -102,6 +109,8 @@ func PrivateKeyFromRawBytes(privKeyBytes []byte, curveType CurveType) (PrivateKe
 			return PrivateKey{}, fmt.Errorf("serialisation of Secp256k1 private key bytes does not equal")
 		}
 		return PrivateKey{PrivateKey: privKeyBytes, PublicKey: pubKey.SerializeCompressed(), CurveType: CurveTypeSecp256k1}, nil
+	case CurveTypeBLS12381:
+		return blsPrivateKeyFromRawBytes(privKeyBytes)
 	default:
 		return PrivateKey{}, ErrInvalidCurve(curveType)
 	}
@@ -125,6 +134,8 @@ func GeneratePrivateKey(random io.Reader, curveType CurveType) (PrivateKey, erro
 			return PrivateKey{}, err
 		}
 		return PrivateKeyFromRawBytes(privKeyBytes, CurveTypeSecp256k1)
+	case CurveTypeBLS12381:
+		return generateBLSPrivateKey(random)
 	default:
 		return PrivateKey{}, ErrInvalidCurve(curveType)
 	}
@@ -24,6 +24,8 @@ func PublicKeyLength(curveType CurveType) int {
 		return ed25519.PublicKeySize
 	case CurveTypeSecp256k1:
 		return btcec.PubKeyBytesLenCompressed
+	case CurveTypeBLS12381:
+		return BLSPublicKeyLength
 	default:
 		// Other functions rely on this
 		return 0
@@ -97,6 +99,8 @@ func (p PublicKey) Verify(msg []byte, signature Signature) error {
 			return nil
 		}
 		return fmt.Errorf("'%X' is not a valid secp256k1 signature for message: %X", signature, msg)
+	case CurveTypeBLS12381:
+		return blsVerify(p, msg, signature)
 	default:
 		return fmt.Errorf("invalid curve type")
 	}
@@ -104,10 +108,13 @@ func (p PublicKey) Verify(msg []byte, signature Signature) error {
 
 func (p PublicKey) Address() Address {
 	switch p.CurveType {
-	case CurveTypeEd25519:
+	case CurveTypeEd25519, CurveTypeBLS12381:
 		addr, _ := AddressFromBytes(tmhash.Sum(p.PublicKey))
 		return addr
 	case CurveTypeSecp256k1:
+		if ethereumAddresses() {
+			return ethereumAddress(p.PublicKey)
+		}
 		sha := sha256.New()
 		sha.Write(p.PublicKey[:])
 
@@ -125,7 +132,12 @@ func (p PublicKey) AddressHashType() string {
 	case CurveTypeEd25519:
 		return "go-crypto-0.5.0"
 	case CurveTypeSecp256k1:
+		if ethereumAddresses() {
+			return "keccak256"
+		}
 		return "btc"
+	case CurveTypeBLS12381:
+		return "sha256"
 	default:
 		return ""
 	}
@@ -16,6 +16,11 @@ func SignatureFromBytes(bs []byte, curveType CurveType) (Signature, error) {
 		}
 	case CurveTypeSecp256k1:
 		// TODO: validate?
+	case CurveTypeBLS12381:
+		if len(bs) != BLSSignatureLength {
+			return Signature{}, fmt.Errorf("bytes passed have length %v but BLS12-381 signatures have %v bytes",
+				len(bs), BLSSignatureLength)
+		}
 	}
 
 	return Signature{CurveType: curveType, Signature: bs}, nil
@@ -61,6 +61,16 @@ func (p PublicKey) TendermintPubKey() tmCrypto.PubKey {
 	}
 }
 
+// TendermintAddress returns the address Tendermint derives for the key, which is independent of our address derivation
+func (p PublicKey) TendermintAddress() Address {
+	pubKey := p.TendermintPubKey()
+	if pubKey == nil {
+		return Address{}
+	}
+	addr, _ := AddressFromBytes(pubKey.Address())
+	return addr
+}
+
 // Signature extensions
 
 func (sig Signature) TendermintSignature() []byte {
@@ -0,0 +1,436 @@
+// Package abigen generates typed Go bindings to contracts from their ABIs. The bindings deploy, call, simulate calls
+// to, and watch the events of contracts through the bind package.
+package abigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+)
+
+// A contract to generate a binding to
+type Contract struct {
+	// Name of the contract, which names the binding
+	Name string
+	Abi  json.RawMessage
+	// Creation code as hex, empty when the binding cannot deploy the contract
+	Bytecode string
+}
+
+// ReadContracts reads the contracts of file, which may be a plain ABI (.abi or .json), a contract written by burrow
+// deploy to its bin directory (.bin), or anything compilers compile, linking any libraries whose addresses are given
+func ReadContracts(file string, compilers compile.Compilers, libraries map[string]string) ([]Contract, error) {
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	switch filepath.Ext(file) {
+	case ".abi", ".json":
+		bs, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		// Unlike compiler artifacts a plain ABI is a JSON array
+		if filepath.Ext(file) == ".abi" || bytes.HasPrefix(bytes.TrimSpace(bs), []byte("[")) {
+			return []Contract{{Name: name, Abi: bs}}, nil
+		}
+	case ".bin":
+		bs, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		contract := compile.SolidityOutputContract{}
+		err = json.Unmarshal(bs, &contract)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s as contract written by deploy: %v", file, err)
+		}
+		bin, err := compile.LinkContract(contract, libraries)
+		if err != nil {
+			return nil, err
+		}
+		return []Contract{{Name: name, Abi: contract.Abi, Bytecode: bin.Binary}}, nil
+	}
+
+	resp, err := compilers.Compile(file, false, libraries)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("could not compile %s: %s", file, resp.Error)
+	}
+	contracts := make([]Contract, len(resp.Objects))
+	for i, object := range resp.Objects {
+		bin, err := compile.LinkContract(object.Binary, libraries)
+		if err != nil {
+			return nil, err
+		}
+		contracts[i] = Contract{Name: object.Objectname, Abi: object.Binary.Abi, Bytecode: bin.Binary}
+	}
+	return contracts, nil
+}
+
+// Whether the bytecode of the contract still has placeholders for the addresses of libraries, in which case its
+// binding cannot deploy it
+func (contract Contract) Unlinked() bool {
+	return strings.Contains(contract.Bytecode, "__")
+}
+
+// Generate returns the gofmted source of a binding to contract in package pkg
+func Generate(pkg string, contract Contract) ([]byte, error) {
+	spec, err := abi.ReadAbiSpec(contract.Abi)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ABI of %s: %v", contract.Name, err)
+	}
+	compact := new(bytes.Buffer)
+	err = json.Compact(compact, contract.Abi)
+	if err != nil {
+		return nil, err
+	}
+	b := &binding{
+		Package: pkg,
+		Name:    exported(contract.Name, "Contract"),
+		ABI:     compact.String(),
+	}
+	if !contract.Unlinked() {
+		b.Bytecode = strings.TrimPrefix(contract.Bytecode, "0x")
+	}
+	b.Spec = unexported(b.Name) + "Spec"
+	b.Constructor = params(spec.Constructor.Inputs, "arg", deployNames)
+
+	for _, name := range sortedKeys(spec.Functions) {
+		fs := spec.Functions[name]
+		f := function{
+			Name:      name,
+			Method:    exported(name, "Function"),
+			Signature: signature(name, fs.Inputs),
+			Inputs:    params(fs.Inputs, "arg", methodNames),
+		}
+		used := make(map[string]bool)
+		for _, in := range f.Inputs {
+			used[in.Name] = true
+		}
+		for i, out := range params(fs.Outputs, "ret", methodNames) {
+			if used[out.Name] {
+				out.Name = fmt.Sprintf("ret%d", i)
+			}
+			f.Outputs = append(f.Outputs, out)
+		}
+		b.Functions = append(b.Functions, f)
+	}
+
+	for _, name := range sortedKeys(spec.Events) {
+		ev := spec.Events[name]
+		e := event{
+			Name:      name,
+			Method:    exported(name, "Event"),
+			Signature: signature(name, ev.Inputs),
+		}
+		e.Type = b.Name + e.Method
+		used := map[string]bool{"Raw": true}
+		for i, arg := range ev.Inputs {
+			f := field{
+				Name: exported(arg.Name, fmt.Sprintf("Arg%d", i)),
+				Type: goType(arg.EVM, arg.IsArray, arg.ArrayLength),
+			}
+			if used[f.Name] {
+				f.Name = fmt.Sprintf("Arg%d", i)
+			}
+			used[f.Name] = true
+			// Only the hash of indexed values that are not stored in a single word is logged
+			if _, tuple := arg.EVM.(abi.EVMTuple); arg.Indexed && (tuple || arg.IsArray || isDynamic(arg.EVM)) {
+				f.Type = "[]byte"
+			}
+			f.Alloc = allocates(f.Type)
+			e.Fields = append(e.Fields, f)
+		}
+		b.Events = append(b.Events, e)
+	}
+
+	buf := new(bytes.Buffer)
+	err = bindingTemplate.Execute(buf, b)
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+type binding struct {
+	Package     string
+	Name        string
+	ABI         string
+	Bytecode    string
+	Spec        string
+	Constructor []field
+	Functions   []function
+	Events      []event
+}
+
+type function struct {
+	Name      string
+	Method    string
+	Signature string
+	Inputs    []field
+	Outputs   []field
+}
+
+type event struct {
+	Name      string
+	Method    string
+	Type      string
+	Signature string
+	Fields    []field
+}
+
+// A parameter, return value, or field of a binding
+type field struct {
+	Name string
+	Type string
+	// Whether the Go type is a pointer that must be allocated before a value is unpacked into it
+	Alloc bool
+}
+
+// Names the generated functions use themselves or that would shadow packages they use
+var (
+	methodNames = []string{"c", "ctx", "opts", "txe", "err", "new",
+		"big", "bind", "context", "crypto", "exec", "hex", "rpcevents", "rpctransact"}
+	deployNames = append([]string{"transact", "events", "address"}, methodNames...)
+)
+
+func params(args []abi.Argument, prefix string, reserved []string) []field {
+	used := make(map[string]bool)
+	for _, name := range reserved {
+		used[name] = true
+	}
+	fields := make([]field, len(args))
+	for i, arg := range args {
+		name := unexported(identifier(arg.Name))
+		if name == "" || used[name] || token.Lookup(name).IsKeyword() {
+			name = fmt.Sprintf("%s%d", prefix, i)
+		}
+		used[name] = true
+		typ := goType(arg.EVM, arg.IsArray, arg.ArrayLength)
+		fields[i] = field{Name: name, Type: typ, Alloc: allocates(typ)}
+	}
+	return fields
+}
+
+// The Go type values of an EVM type, or an array of them, are packed from and unpacked into
+func goType(evm abi.EVMType, isArray bool, length uint64) string {
+	if isArray {
+		if length > 0 {
+			return fmt.Sprintf("[%d]%s", length, goType(evm, false, 0))
+		}
+		return "[]" + goType(evm, false, 0)
+	}
+	switch evm := evm.(type) {
+	case abi.EVMBool:
+		return "bool"
+	case abi.EVMUint:
+		if evm.M == 8 || evm.M == 16 || evm.M == 32 || evm.M == 64 {
+			return fmt.Sprintf("uint%d", evm.M)
+		}
+		return "*big.Int"
+	case abi.EVMInt:
+		if evm.M == 8 || evm.M == 16 || evm.M == 32 || evm.M == 64 {
+			return fmt.Sprintf("int%d", evm.M)
+		}
+		return "*big.Int"
+	case abi.EVMAddress:
+		return "crypto.Address"
+	case abi.EVMBytes:
+		return "[]byte"
+	case abi.EVMArray:
+		return goType(evm.Elem, true, evm.Length)
+	case abi.EVMTuple:
+		fields := make([]string, len(evm.Components))
+		used := make(map[string]bool)
+		for i, c := range evm.Components {
+			name := exported(c.Name, fmt.Sprintf("Field%d", i))
+			if used[name] {
+				name = fmt.Sprintf("Field%d", i)
+			}
+			used[name] = true
+			fields[i] = name + " " + goType(c.EVM, c.IsArray, c.ArrayLength)
+		}
+		return "struct{" + strings.Join(fields, "; ") + "}"
+	default:
+		// Strings, and fixed point numbers which the abi package cannot yet pack
+		return "string"
+	}
+}
+
+func allocates(goType string) bool {
+	return goType == "*big.Int"
+}
+
+func isDynamic(evm abi.EVMType) bool {
+	switch evm := evm.(type) {
+	case abi.EVMString:
+		return true
+	case abi.EVMBytes:
+		return evm.M == 0
+	}
+	return false
+}
+
+func signature(name string, args []abi.Argument) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.EVM.GetSignature()
+		if arg.IsArray {
+			types[i] = abi.EVMArray{Elem: arg.EVM, Length: arg.ArrayLength}.GetSignature()
+		}
+	}
+	return name + "(" + strings.Join(types, ",") + ")"
+}
+
+// Makes name a valid Go identifier, dropping any characters that cannot be in one
+func identifier(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// Makes name an exported Go identifier, or uses fallback if it has nothing to make one from
+func exported(name, fallback string) string {
+	name = strings.TrimLeft(identifier(name), "_0123456789")
+	if name == "" {
+		return fallback
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func unexported(name string) string {
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		return ""
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]abi.FunctionSpec:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]abi.Event:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var bindingTemplate = template.Must(template.New("binding").Parse(`// Code generated by burrow abigen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/abigen/bind"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/tmthrgd/go-hex"
+)
+
+// Reference imports that not every binding uses
+var (
+	_ = big.NewInt
+	_ = hex.MustDecodeString
+	_ context.Context
+	_ *exec.Event
+	_ *rpcevents.BlockRange
+)
+
+// {{.Name}}ABI is the ABI of the {{.Name}} contract
+const {{.Name}}ABI = {{printf "%q" .ABI}}
+{{if .Bytecode}}
+// {{.Name}}Bytecode is the code that creates a {{.Name}} contract
+var {{.Name}}Bytecode = hex.MustDecodeString("{{.Bytecode}}")
+{{end}}
+var {{.Spec}} = bind.MustReadAbiSpec({{.Name}}ABI)
+
+// {{.Name}} is bound to a {{.Name}} contract on chain
+type {{.Name}} struct {
+	*bind.Contract
+}
+
+// New{{.Name}} binds to the {{.Name}} contract at address
+func New{{.Name}}(address crypto.Address, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) *{{.Name}} {
+	return &{{.Name}}{Contract: bind.NewContract(address, {{.Spec}}, transact, events)}
+}
+{{if .Bytecode}}
+// Deploy{{.Name}} creates a {{.Name}} contract and binds to it
+func Deploy{{.Name}}(ctx context.Context, transact rpctransact.TransactClient, events rpcevents.ExecutionEventsClient,
+	opts *bind.TransactOpts{{range .Constructor}}, {{.Name}} {{.Type}}{{end}}) (*{{.Name}}, *exec.TxExecution, error) {
+	address, txe, err := bind.Deploy(ctx, transact, opts, {{.Spec}}, {{.Name}}Bytecode{{range .Constructor}}, {{.Name}}{{end}})
+	if err != nil {
+		return nil, txe, err
+	}
+	return New{{.Name}}(address, transact, events), txe, nil
+}
+{{end}}
+{{- range .Functions}}
+// {{.Method}} sends a transaction calling {{.Signature}}
+func (c *{{$.Name}}) {{.Method}}(ctx context.Context, opts *bind.TransactOpts{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) (
+	{{- range .Outputs}}{{.Name}} {{.Type}}, {{end}}txe *exec.TxExecution, err error) {
+	{{- range .Outputs}}{{if .Alloc}}
+	{{.Name}} = new(big.Int){{end}}{{end}}
+	txe, err = c.Contract.Call(ctx, opts, "{{.Name}}", []interface{}{ {{- range $i, $in := .Inputs}}{{if $i}}, {{end}}{{$in.Name}}{{end -}} }
+	{{- range .Outputs}}, {{if not .Alloc}}&{{end}}{{.Name}}{{end}})
+	return
+}
+
+// Simulate{{.Method}} runs {{.Signature}} without committing a transaction
+func (c *{{$.Name}}) Simulate{{.Method}}(ctx context.Context, opts *bind.TransactOpts{{range .Inputs}}, {{.Name}} {{.Type}}{{end}}) (
+	{{- range .Outputs}}{{.Name}} {{.Type}}, {{end}}err error) {
+	{{- range .Outputs}}{{if .Alloc}}
+	{{.Name}} = new(big.Int){{end}}{{end}}
+	_, err = c.Contract.Simulate(ctx, opts, "{{.Name}}", []interface{}{ {{- range $i, $in := .Inputs}}{{if $i}}, {{end}}{{$in.Name}}{{end -}} }
+	{{- range .Outputs}}, {{if not .Alloc}}&{{end}}{{.Name}}{{end}})
+	return
+}
+{{end}}
+{{- range .Events}}
+// {{.Type}} is a {{.Signature}} event logged by a {{$.Name}} contract
+type {{.Type}} struct {
+	{{- range .Fields}}
+	{{.Name}} {{.Type}}{{end}}
+	// The event the arguments are unpacked from, with the height and transaction it was logged at
+	Raw *exec.Event
+}
+
+// Watch{{.Method}} passes the {{.Name}} events the contract logs in blockRange to handler, watching from the latest
+// block onwards if blockRange is nil
+func (c *{{$.Name}}) Watch{{.Method}}(ctx context.Context, blockRange *rpcevents.BlockRange,
+	handler func(*{{.Type}}) error) error {
+	return c.Contract.Watch(ctx, blockRange, "{{.Name}}", func(ev *exec.Event) error {
+		e := &{{.Type}}{Raw: ev}
+		{{- range .Fields}}{{if .Alloc}}
+		e.{{.Name}} = new(big.Int){{end}}{{end}}
+		err := c.Contract.UnpackEvent("{{.Name}}", ev{{range .Fields}}, {{if not .Alloc}}&{{end}}e.{{.Name}}{{end}})
+		if err != nil {
+			return err
+		}
+		return handler(e)
+	})
+}
+{{end}}`))
@@ -0,0 +1,104 @@
+package abigen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenABI = `[
+	{"type":"constructor","inputs":[{"name":"supply","type":"uint256"}]},
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"holders","inputs":[{"name":"type","type":"uint8"}],
+		"outputs":[{"name":"","type":"tuple[]","components":[{"name":"holder","type":"address"},{"name":"","type":"uint64"}]}]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},
+		{"name":"memo","type":"string","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("tokens", Contract{Name: "token", Abi: []byte(tokenABI), Bytecode: "6000"})
+	require.NoError(t, err)
+	decls := declarations(t, src)
+	for _, decl := range []string{"TokenABI", "TokenBytecode", "Token", "NewToken", "DeployToken", "Token.Transfer",
+		"Token.SimulateTransfer", "Token.Holders", "Token.SimulateHolders", "TokenTransfer", "Token.WatchTransfer"} {
+		assert.Contains(t, decls, decl)
+	}
+	assert.Contains(t, string(src), "func (c *Token) Holders(ctx context.Context, opts *bind.TransactOpts, arg0 uint8) "+
+		"(ret0 []struct {\n\tHolder crypto.Address\n\tField1 uint64\n}, txe *exec.TxExecution, err error)")
+	// Only the hash of the indexed string is logged
+	assert.Contains(t, string(src), "Memo  []byte")
+
+	// Contracts that still need libraries linking cannot be deployed
+	src, err = Generate("tokens", Contract{Name: "token", Abi: []byte(tokenABI), Bytecode: "60__Lib__"})
+	require.NoError(t, err)
+	decls = declarations(t, src)
+	assert.NotContains(t, decls, "DeployToken")
+	assert.NotContains(t, decls, "TokenBytecode")
+
+	_, err = Generate("tokens", Contract{Name: "token", Abi: []byte(`[{"type":"function","name":"f",` +
+		`"inputs":[{"name":"","type":"uint7"}]}]`)})
+	assert.Error(t, err)
+}
+
+func TestReadContracts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "abigen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	compilers, err := compile.NewCompilers("", nil)
+	require.NoError(t, err)
+
+	file := filepath.Join(dir, "Token.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(tokenABI), 0644))
+	contracts, err := ReadContracts(file, compilers, nil)
+	require.NoError(t, err)
+	require.Len(t, contracts, 1)
+	assert.Equal(t, "Token", contracts[0].Name)
+	assert.Equal(t, "", contracts[0].Bytecode)
+
+	file = filepath.Join(dir, "Token.bin")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{"Abi":`+tokenABI+`,"Evm":{"Bytecode":{"Object":"6000"}}}`),
+		0644))
+	contracts, err = ReadContracts(file, compilers, nil)
+	require.NoError(t, err)
+	require.Len(t, contracts, 1)
+	assert.Equal(t, "6000", contracts[0].Bytecode)
+	assert.False(t, contracts[0].Unlinked())
+}
+
+// The names of the top level declarations in src, with methods named by their receiver
+func declarations(t *testing.T, src []byte) []string {
+	f, err := parser.ParseFile(token.NewFileSet(), "binding.go", src, 0)
+	require.NoError(t, err)
+	var names []string
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if decl.Recv != nil {
+				name = decl.Recv.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name + "." + name
+			}
+			names = append(names, name)
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names = append(names, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,191 @@
+// Package bind is what the Go bindings to contracts generated by burrow abigen use to deploy, call and watch the
+// contracts they are bound to through the Transact and ExecutionEvents gRPC services of a Burrow node
+package bind
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc"
+)
+
+// Used for transactions whose TransactOpts give no GasLimit
+const DefaultGasLimit = 1111111111
+
+// How transactions are sent to a contract
+type TransactOpts struct {
+	// The account sending transactions, which the node must hold the key of to sign them
+	Input crypto.Address
+	// Native tokens sent with each transaction
+	Amount uint64
+	// Fee offered to validators for each transaction
+	Fee uint64
+	// Gas each transaction may use, DefaultGasLimit if zero
+	GasLimit uint64
+}
+
+func (opts *TransactOpts) callTx(address *crypto.Address, data []byte) *payload.CallTx {
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGasLimit
+	}
+	return &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: opts.Input,
+			Amount:  opts.Amount,
+		},
+		Address:  address,
+		GasLimit: gasLimit,
+		Fee:      opts.Fee,
+		Data:     data,
+	}
+}
+
+// A contract at Address on the chain of the node served by the Transact and Events clients
+type Contract struct {
+	Address  crypto.Address
+	Spec     *abi.AbiSpec
+	Transact rpctransact.TransactClient
+	Events   rpcevents.ExecutionEventsClient
+}
+
+func NewContract(address crypto.Address, spec *abi.AbiSpec, transact rpctransact.TransactClient,
+	events rpcevents.ExecutionEventsClient) *Contract {
+	return &Contract{
+		Address:  address,
+		Spec:     spec,
+		Transact: transact,
+		Events:   events,
+	}
+}
+
+// Reads the ABI a binding is generated with, which is known to be valid
+func MustReadAbiSpec(abiJSON string) *abi.AbiSpec {
+	spec, err := abi.ReadAbiSpec([]byte(abiJSON))
+	if err != nil {
+		panic(fmt.Errorf("could not read ABI of bound contract: %v", err))
+	}
+	return spec
+}
+
+// Deploy creates a contract from bytecode, passing args to its constructor, and returns the address it is created at
+func Deploy(ctx context.Context, transact rpctransact.TransactClient, opts *TransactOpts, spec *abi.AbiSpec,
+	bytecode []byte, args ...interface{}) (crypto.Address, *exec.TxExecution, error) {
+	data, err := abi.Pack(spec.Constructor.Inputs, args...)
+	if err != nil {
+		return crypto.ZeroAddress, nil, fmt.Errorf("could not pack constructor arguments: %v", err)
+	}
+	code := make([]byte, 0, len(bytecode)+len(data))
+	code = append(append(code, bytecode...), data...)
+	txe, err := transact.CallTxSync(ctx, opts.callTx(nil, code))
+	if err != nil {
+		return crypto.ZeroAddress, nil, err
+	}
+	if txe.Exception != nil {
+		return crypto.ZeroAddress, txe, fmt.Errorf("could not deploy contract: %v", txe.Exception)
+	}
+	return txe.Receipt.ContractAddress, txe, nil
+}
+
+// Call sends a transaction calling function with args and unpacks what it returns into rets
+func (c *Contract) Call(ctx context.Context, opts *TransactOpts, function string, args []interface{},
+	rets ...interface{}) (*exec.TxExecution, error) {
+	return c.call(ctx, c.Transact.CallTxSync, opts, function, args, rets)
+}
+
+// Simulate runs function with args against the current state of the chain, without committing a transaction, and
+// unpacks what it returns into rets
+func (c *Contract) Simulate(ctx context.Context, opts *TransactOpts, function string, args []interface{},
+	rets ...interface{}) (*exec.TxExecution, error) {
+	return c.call(ctx, c.Transact.CallTxSim, opts, function, args, rets)
+}
+
+func (c *Contract) call(ctx context.Context,
+	send func(context.Context, *payload.CallTx, ...grpc.CallOption) (*exec.TxExecution, error),
+	opts *TransactOpts, function string, args, rets []interface{}) (*exec.TxExecution, error) {
+	fs, ok := c.Spec.Functions[function]
+	if !ok {
+		return nil, fmt.Errorf("contract has no function %s", function)
+	}
+	data, err := c.Spec.Pack(function, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack arguments of %s: %v", function, err)
+	}
+	txe, err := send(ctx, opts.callTx(&c.Address, data))
+	if err != nil {
+		return nil, err
+	}
+	if txe.Exception != nil {
+		return txe, fmt.Errorf("call to %s failed: %v", function, txe.Exception)
+	}
+	if len(rets) > 0 {
+		err = abi.Unpack(fs.Outputs, txe.Result.Return, rets...)
+		if err != nil {
+			return txe, fmt.Errorf("could not unpack return of %s: %v", function, err)
+		}
+	}
+	return txe, nil
+}
+
+// Watch passes the events called name that the contract logs in the blocks of blockRange to handler until the end of
+// the range, an error, or ctx is done. A nil blockRange watches from the latest block onwards.
+func (c *Contract) Watch(ctx context.Context, blockRange *rpcevents.BlockRange, name string,
+	handler func(*exec.Event) error) error {
+	ev, ok := c.Spec.Events[name]
+	if !ok {
+		return fmt.Errorf("contract has no event %s", name)
+	}
+	if blockRange == nil {
+		blockRange = rpcevents.NewBlockRange(rpcevents.LatestBound(), rpcevents.StreamBound())
+	}
+	qry := query.NewBuilder().
+		AndEquals(event.EventTypeKey, exec.TypeLog.String()).
+		AndEquals(event.AddressKey, c.Address)
+	if !ev.Anonymous {
+		qry = qry.AndEquals(exec.LogNKey(0), hex.EncodeUpperToString(ev.EventID.Bytes()))
+	}
+	stream, err := c.Events.GetEvents(ctx, &rpcevents.BlocksRequest{
+		BlockRange: blockRange,
+		Query:      qry.String(),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, ev := range response.Events {
+			err = handler(ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// UnpackEvent unpacks the arguments of the event called name from the log of ev into args
+func (c *Contract) UnpackEvent(name string, ev *exec.Event, args ...interface{}) error {
+	spec, ok := c.Spec.Events[name]
+	if !ok {
+		return fmt.Errorf("contract has no event %s", name)
+	}
+	if ev.Log == nil {
+		return fmt.Errorf("event is not a log so cannot be a %s event", name)
+	}
+	return abi.UnpackEvent(&spec, ev.Log.Topics, ev.Log.Data, args...)
+}
@@ -0,0 +1,81 @@
+package bind
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/test"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const storedABI = `[
+	{"type":"function","name":"get","inputs":[],"outputs":[{"name":"value","type":"uint256"}]},
+	{"type":"event","name":"Stored","inputs":[{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+func TestContract(t *testing.T) {
+	chain, err := test.NewChain(spec.FullAccount(test.TesterName), logging.NewNoopLogger())
+	require.NoError(t, err)
+	defer chain.Shutdown()
+	conn, err := grpc.Dial(chain.Address(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	transact := rpctransact.NewTransactClient(conn)
+	events := rpcevents.NewExecutionEventsClient(conn)
+
+	ctx := context.Background()
+	opts := &TransactOpts{Input: chain.Tester, Amount: 1, Fee: 1}
+	spec := MustReadAbiSpec(storedABI)
+	address, txe, err := Deploy(ctx, transact, opts, spec, storedBytecode(spec))
+	require.NoError(t, err)
+	assert.Equal(t, txe.Receipt.ContractAddress, address)
+	contract := NewContract(address, spec, transact, events)
+
+	value := new(big.Int)
+	txe, err = contract.Call(ctx, opts, "get", nil, value)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), value.Int64())
+	height := txe.Height
+
+	value = new(big.Int)
+	_, err = contract.Simulate(ctx, opts, "get", nil, value)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), value.Int64())
+
+	_, err = contract.Call(ctx, opts, "set", nil)
+	assert.Error(t, err, "should not call function the contract does not have")
+
+	// Only the call was committed so only it logged an event
+	var logged []*exec.Event
+	err = contract.Watch(ctx, rpcevents.NewBlockRange(rpcevents.AbsoluteBound(0), rpcevents.LatestBound()),
+		"Stored", func(ev *exec.Event) error {
+			logged = append(logged, ev)
+			return nil
+		})
+	require.NoError(t, err)
+	require.Len(t, logged, 1)
+	assert.Equal(t, height, logged[0].Header.Height)
+	var stored uint64
+	require.NoError(t, contract.UnpackEvent("Stored", logged[0], &stored))
+	assert.Equal(t, uint64(42), stored)
+}
+
+// Code for a contract that, whatever it is called with, logs Stored(42) and returns 42
+func storedBytecode(spec *abi.AbiSpec) []byte {
+	topic := spec.Events["Stored"].EventID
+	runtime := MustSplice(PUSH1, 42, PUSH1, 0, MSTORE,
+		PUSH32, topic.Bytes(), PUSH1, 32, PUSH1, 0, LOG1,
+		PUSH1, 32, PUSH1, 0, RETURN)
+	return MustSplice(PUSH1, len(runtime), DUP1, PUSH1, 11, PUSH1, 0, CODECOPY, PUSH1, 0, RETURN, runtime)
+}
@@ -0,0 +1,90 @@
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Imports a contract already compiled by Truffle or Hardhat from the JSON artifact they write for it
+type Artifact struct{}
+
+// The fields of Truffle and Hardhat artifacts we use
+type artifact struct {
+	ContractName string
+	Abi          json.RawMessage
+	// Creation code as 0x prefixed hex with placeholders for libraries
+	Bytecode string
+	// Runtime code in the same form
+	DeployedBytecode string
+	// Hardhat only, Truffle leaves us to find the placeholders
+	LinkReferences         json.RawMessage
+	DeployedLinkReferences json.RawMessage
+	// Truffle only
+	Metadata string
+	Devdoc   json.RawMessage
+	Userdoc  json.RawMessage
+}
+
+// Truffle marks where a library's address goes with its name padded out with underscores
+var trufflePlaceholderRegex = regexp.MustCompile(fmt.Sprintf(`__[[:word:]]{%d}`, crypto.AddressHexLength-2))
+
+func (Artifact) Compile(file string, optimize bool, libraries map[string]string) (*Response, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	art := new(artifact)
+	err = json.Unmarshal(bs, art)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s as a Truffle or Hardhat artifact: %v", file, err)
+	}
+	if art.ContractName == "" || len(art.Abi) == 0 {
+		return nil, fmt.Errorf("%s is not a Truffle or Hardhat artifact, it has no contractName or abi", file)
+	}
+
+	contract := SolidityOutputContract{
+		Abi:      art.Abi,
+		Devdoc:   art.Devdoc,
+		Userdoc:  art.Userdoc,
+		Metadata: art.Metadata,
+	}
+	contract.Evm.Bytecode.Object = strings.TrimPrefix(art.Bytecode, "0x")
+	contract.Evm.Bytecode.LinkReferences = art.LinkReferences
+	contract.Evm.DeployedBytecode.Object = strings.TrimPrefix(art.DeployedBytecode, "0x")
+	contract.Evm.DeployedBytecode.LinkReferences = art.DeployedLinkReferences
+	if len(art.LinkReferences) == 0 {
+		contract.Evm.Bytecode.LinkReferences, err = truffleLinkReferences(contract.Evm.Bytecode.Object)
+		if err != nil {
+			return nil, err
+		}
+		contract.Evm.DeployedBytecode.LinkReferences, err = truffleLinkReferences(contract.Evm.DeployedBytecode.Object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Response{
+		Objects: []ResponseItem{{
+			Filename:   file,
+			Objectname: art.ContractName,
+			Binary:     contract,
+		}},
+		Version: metadataVersion(art.Metadata),
+	}, nil
+}
+
+// Link references in the form output by solc for the placeholders in Truffle bytecode
+func truffleLinkReferences(bin string) (json.RawMessage, error) {
+	type relocation struct{ Start, Length int }
+	links := make(map[string][]relocation)
+	for _, loc := range trufflePlaceholderRegex.FindAllStringIndex(bin, -1) {
+		name := strings.Trim(bin[loc[0]:loc[1]], "_")
+		links[name] = append(links[name], relocation{Start: loc[0] / 2, Length: crypto.AddressLength})
+	}
+	return json.Marshal(map[string]map[string][]relocation{"": links})
+}
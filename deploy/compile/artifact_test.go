@@ -0,0 +1,69 @@
+package compile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const library = "29BFD0C2BAA5A7D7D9F3D2B5F3C3BE04FE7AE6C9"
+
+func TestArtifact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifacts")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	truffle := filepath.Join(dir, "Storage.json")
+	require.NoError(t, ioutil.WriteFile(truffle, []byte(`{
+	  "contractName": "Storage",
+	  "abi": [{"type": "function", "name": "get", "inputs": [], "outputs": [{"name": "", "type": "uint256"}]}],
+	  "metadata": "{\"compiler\":{\"version\":\"0.5.17\"}}",
+	  "bytecode": "0x6080__SafeMath______________________________6080",
+	  "deployedBytecode": "0x6080__SafeMath______________________________",
+	  "sourcePath": "contracts/Storage.sol"
+	}`), 0644))
+	resp, err := Artifact{}.Compile(truffle, false, nil)
+	require.NoError(t, err)
+	require.Len(t, resp.Objects, 1)
+	item := resp.Objects[0]
+	assert.Equal(t, truffle, item.Filename)
+	assert.Equal(t, "Storage", item.Objectname)
+	assert.Equal(t, "0.5.17", resp.Version)
+	linked, err := LinkContract(item.Binary, map[string]string{"SafeMath": library})
+	require.NoError(t, err)
+	assert.Equal(t, "6080"+library+"6080", linked.Binary)
+	deployed, err := LinkDeployedContract(item.Binary, map[string]string{"SafeMath": library})
+	require.NoError(t, err)
+	assert.Equal(t, "6080"+library, deployed)
+
+	hardhat := filepath.Join(dir, "Token.json")
+	require.NoError(t, ioutil.WriteFile(hardhat, []byte(`{
+	  "_format": "hh-sol-artifact-1",
+	  "contractName": "Token",
+	  "sourceName": "contracts/Token.sol",
+	  "abi": [],
+	  "bytecode": "0x60__$4a4d8e5e4d6d7cb3ee9dc24e8e0ebc9e1d$__",
+	  "deployedBytecode": "0x60__$4a4d8e5e4d6d7cb3ee9dc24e8e0ebc9e1d$__60",
+	  "linkReferences": {"contracts/Math.sol": {"Math": [{"start": 1, "length": 20}]}},
+	  "deployedLinkReferences": {"contracts/Math.sol": {"Math": [{"start": 1, "length": 20}]}}
+	}`), 0644))
+	resp, err = Compilers{".json": Artifact{}}.Compile(hardhat, false, nil)
+	require.NoError(t, err)
+	linked, err = LinkContract(resp.Objects[0].Binary, map[string]string{"Math": library})
+	require.NoError(t, err)
+	assert.Equal(t, "60"+library, linked.Binary)
+	deployed, err = LinkDeployedContract(resp.Objects[0].Binary, map[string]string{"Math": library})
+	require.NoError(t, err)
+	assert.Equal(t, "60"+library+"60", deployed)
+
+	notArtifact := filepath.Join(dir, "package.json")
+	require.NoError(t, ioutil.WriteFile(notArtifact, []byte(`{"name": "contracts"}`), 0644))
+	_, err = Artifact{}.Compile(notArtifact, false, nil)
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), notArtifact))
+}
@@ -0,0 +1,111 @@
+package compile
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// A Compiler produces the contracts defined in a file, whether by compiling its source or importing the output of
+// another toolchain
+type Compiler interface {
+	Compile(file string, optimize bool, libraries map[string]string) (*Response, error)
+}
+
+// Compilers chooses a Compiler by the extension of the file to compile
+type Compilers map[string]Compiler
+
+var _ Compiler = Compilers{}
+
+// Returns the compilers for each kind of file deploy understands with any compiler versions pinned by versions (by
+// compiler name, e.g. solc or vyper) resolved from cacheDir. Unpinned compilers are run from the PATH.
+func NewCompilers(cacheDir string, versions map[string]string) (Compilers, error) {
+	for name := range versions {
+		if name != "solc" && name != "vyper" {
+			return nil, fmt.Errorf("cannot pin version of unknown compiler %s, expected solc or vyper", name)
+		}
+	}
+	solc, err := FindCompiler(cacheDir, "solc", versions["solc"])
+	if err != nil {
+		return nil, err
+	}
+	vyper, err := FindCompiler(cacheDir, "vyper", versions["vyper"])
+	if err != nil {
+		return nil, err
+	}
+	return Compilers{
+		".sol":  Solc{Path: solc},
+		".vy":   Vyper{Path: vyper},
+		".json": Artifact{},
+	}, nil
+}
+
+func (cs Compilers) Compile(file string, optimize bool, libraries map[string]string) (*Response, error) {
+	compiler, ok := cs[filepath.Ext(file)]
+	if !ok {
+		var exts []string
+		for ext := range cs {
+			exts = append(exts, ext)
+		}
+		sort.Strings(exts)
+		return nil, fmt.Errorf("no compiler for %s, can only compile %s files", file, strings.Join(exts, ", "))
+	}
+	return compiler.Compile(file, optimize, libraries)
+}
+
+// Whether there is a compiler for file
+func (cs Compilers) Compiles(file string) bool {
+	_, ok := cs[filepath.Ext(file)]
+	return ok
+}
+
+// Returns the name of the compiler for file whose version can be pinned, or an empty string if there is none
+func CompilerName(file string) string {
+	switch filepath.Ext(file) {
+	case ".sol":
+		return "solc"
+	case ".vy":
+		return "vyper"
+	}
+	return ""
+}
+
+// Finds version of the compiler called name in cacheDir, where it should be named as by solc-bin, e.g.
+// solc-linux-amd64-v0.5.17+commit.d19bba13, or simply as name-v<version>. If no version is given then name is
+// returned to run the compiler from the PATH.
+func FindCompiler(cacheDir, name, version string) (string, error) {
+	if version == "" {
+		return name, nil
+	}
+	candidates := []string{
+		filepath.Join(cacheDir, fmt.Sprintf("%s-v%s", name, version)),
+		filepath.Join(cacheDir, fmt.Sprintf("%s-%s", name, version)),
+	}
+	builds, err := filepath.Glob(filepath.Join(cacheDir, fmt.Sprintf("%s-*v%s+commit.*", name, version)))
+	if err != nil {
+		return "", err
+	}
+	for _, path := range append(candidates, builds...) {
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+		output, err := exec.Command(path, "--version").CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("could not run %s to check its version: %v", path, err)
+		}
+		// Make sure 0.5.1 is not taken for 0.5.17
+		versionRegex := regexp.MustCompile(`(^|[^0-9.])` + regexp.QuoteMeta(version) + `($|[^0-9.])`)
+		if !versionRegex.Match(output) {
+			return "", fmt.Errorf("%s is not %s version %s, it reports: %s", path, name, version,
+				strings.TrimSpace(string(output)))
+		}
+		return path, nil
+	}
+	return "", fmt.Errorf("%s version %s is pinned but is not in the compiler cache %s, download it there as %s",
+		name, version, cacheDir, candidates[0])
+}
@@ -0,0 +1,88 @@
+package compile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCompiler(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "compiler-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	path, err := FindCompiler(cacheDir, "solc", "")
+	require.NoError(t, err)
+	assert.Equal(t, "solc", path, "unpinned compilers come from the PATH")
+
+	_, err = FindCompiler(cacheDir, "solc", "0.5.17")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "download it there as "+filepath.Join(cacheDir, "solc-v0.5.17"))
+
+	solc := fakeCompiler(t, cacheDir, "solc-v0.5.17", "Version: 0.5.17+commit.d19bba13.Linux.g++", "")
+	path, err = FindCompiler(cacheDir, "solc", "0.5.17")
+	require.NoError(t, err)
+	assert.Equal(t, solc, path)
+
+	solcBin := fakeCompiler(t, cacheDir, "solc-linux-amd64-v0.4.26+commit.4563c3fc", "Version: 0.4.26+commit.4563c3fc", "")
+	path, err = FindCompiler(cacheDir, "solc", "0.4.26")
+	require.NoError(t, err)
+	assert.Equal(t, solcBin, path)
+
+	// Misnamed
+	fakeCompiler(t, cacheDir, "vyper-0.3.1", "0.3.10+commit.91361694", "")
+	_, err = FindCompiler(cacheDir, "vyper", "0.3.1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not vyper version 0.3.1")
+}
+
+func TestCompilers(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "compiler-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	_, err = NewCompilers(cacheDir, map[string]string{"solc": "0.5.17"})
+	require.Error(t, err, "pinned version missing from cache")
+	_, err = NewCompilers(cacheDir, map[string]string{"lll": "0.1.0"})
+	require.Error(t, err)
+
+	// Vyper's output differs a little from solc's
+	vyper := fakeCompiler(t, cacheDir, "vyper-v0.3.1", "0.3.1+commit.0463ea4c", `{"contracts": {"token.vy": {"token": {
+	  "abi": [], "evm": {"bytecode": {"object": "0x6003"}, "deployedBytecode": {"object": "0x6004"}}}}},
+	  "errors": [{"type": "Warning", "message": "this is only a test"}]}`)
+	cs, err := NewCompilers(cacheDir, map[string]string{"vyper": "0.3.1"})
+	require.NoError(t, err)
+	assert.Equal(t, Vyper{Path: vyper}, cs[".vy"])
+	assert.Equal(t, Solc{Path: "solc"}, cs[".sol"])
+	assert.True(t, cs.Compiles("contracts/token.vy"))
+	assert.False(t, cs.Compiles("token.bin"))
+
+	source := filepath.Join(cacheDir, "token.vy")
+	require.NoError(t, ioutil.WriteFile(source, []byte("# @version 0.3.1\n"), 0644))
+	resp, err := cs.Compile(source, false, nil)
+	require.NoError(t, err)
+	require.Len(t, resp.Objects, 1)
+	assert.Equal(t, "token", resp.Objects[0].Objectname)
+	assert.Equal(t, "6003", resp.Objects[0].Binary.Evm.Bytecode.Object)
+	assert.Equal(t, "6004", resp.Objects[0].Binary.Evm.DeployedBytecode.Object)
+	assert.Equal(t, "0.3.1", resp.Version)
+	assert.Equal(t, "this is only a test", resp.Warning)
+
+	_, err = cs.Compile("token.bin", false, nil)
+	require.Error(t, err)
+	assert.Equal(t, "no compiler for token.bin, can only compile .json, .sol, .vy files", err.Error())
+}
+
+// Writes a script to dir that prints version when passed --version and output otherwise
+func fakeCompiler(t *testing.T, dir, name, version, output string) string {
+	path := filepath.Join(dir, name)
+	script := fmt.Sprintf("#!/bin/sh\nif [ \"$1\" = --version ]; then\n  echo '%s'\nelse\n  cat > /dev/null\n  echo '%s'\nfi\n",
+		version, output)
+	require.NoError(t, ioutil.WriteFile(path, []byte(script), 0755))
+	return path
+}
@@ -48,15 +48,21 @@ type SolidityOutput struct {
 type SolidityOutputContract struct {
 	Abi json.RawMessage
 	Evm struct {
-		Bytecode struct {
-			Object         string
-			Opcodes        string
-			LinkReferences json.RawMessage
-		}
+		Bytecode SolidityOutputBytecode
+		// The runtime code left on chain by the creation code in Bytecode
+		DeployedBytecode SolidityOutputBytecode
 	}
 	Devdoc   json.RawMessage
 	Userdoc  json.RawMessage
 	Metadata string
+	// Where state variables are kept in storage (only output by solc 0.5.13 onwards)
+	StorageLayout json.RawMessage `json:",omitempty"`
+}
+
+type SolidityOutputBytecode struct {
+	Object         string
+	Opcodes        string
+	LinkReferences json.RawMessage
 }
 
 type Response struct {
@@ -94,55 +100,74 @@ func LinkFile(file string, libraries map[string]string) (*BinaryResponse, error)
 }
 
 func LinkContract(contract SolidityOutputContract, libraries map[string]string) (*BinaryResponse, error) {
-	bin := contract.Evm.Bytecode.Object
+	bin, err := linkBytecode(contract.Evm.Bytecode, libraries)
+	if err != nil {
+		return &BinaryResponse{}, err
+	}
+	return &BinaryResponse{
+		Binary: bin,
+		Abi:    contract.Abi,
+		Error:  "",
+	}, nil
+}
+
+// Links libraries into the runtime code of contract, which is what a deployed contract has for its code on chain
+func LinkDeployedContract(contract SolidityOutputContract, libraries map[string]string) (string, error) {
+	return linkBytecode(contract.Evm.DeployedBytecode, libraries)
+}
+
+func linkBytecode(bytecode SolidityOutputBytecode, libraries map[string]string) (string, error) {
+	bin := bytecode.Object
 	if !strings.Contains(bin, "_") {
-		return &BinaryResponse{
-			Binary: bin,
-			Abi:    contract.Abi,
-			Error:  "",
-		}, nil
+		return bin, nil
 	}
 	var links map[string]map[string][]struct{ Start, Length int }
-	err := json.Unmarshal(contract.Evm.Bytecode.LinkReferences, &links)
+	err := json.Unmarshal(bytecode.LinkReferences, &links)
 	if err != nil {
-		return &BinaryResponse{}, err
+		return "", err
 	}
 	for _, f := range links {
 		for name, relos := range f {
 			addr, ok := libraries[name]
 			if !ok {
-				return &BinaryResponse{}, fmt.Errorf("library %s is not defined", name)
+				return "", fmt.Errorf("library %s is not defined", name)
 			}
 			for _, relo := range relos {
 				if relo.Length != crypto.AddressLength {
-					return &BinaryResponse{}, fmt.Errorf("linkReference should be %d bytes long, not %d", crypto.AddressLength, relo.Length)
+					return "", fmt.Errorf("linkReference should be %d bytes long, not %d", crypto.AddressLength, relo.Length)
 				}
 				if len(addr) != crypto.AddressHexLength {
-					return &BinaryResponse{}, fmt.Errorf("address %s should be %d character long, not %d", addr, crypto.AddressHexLength, len(addr))
+					return "", fmt.Errorf("address %s should be %d character long, not %d", addr, crypto.AddressHexLength, len(addr))
 				}
 				start := relo.Start * 2
 				end := relo.Start*2 + crypto.AddressHexLength
 				if bin[start+1] != '_' || bin[end-1] != '_' {
-					return &BinaryResponse{}, fmt.Errorf("relocation dummy not found at %d in %s ", relo.Start, bin)
+					return "", fmt.Errorf("relocation dummy not found at %d in %s ", relo.Start, bin)
 				}
 				bin = bin[:start] + addr + bin[end:]
 			}
 		}
 	}
-
-	return &BinaryResponse{
-		Binary: bin,
-		Abi:    contract.Abi,
-		Error:  "",
-	}, nil
+	return bin, nil
 }
 
+// Compiles Solidity with solc found on the PATH
 func Compile(file string, optimize bool, libraries map[string]string) (*Response, error) {
+	return Solc{}.Compile(file, optimize, libraries)
+}
+
+// Compiles Solidity with solc
+type Solc struct {
+	// Path to the solc binary, if empty solc is run from the PATH
+	Path string
+}
+
+func (solc Solc) Compile(file string, optimize bool, libraries map[string]string) (*Response, error) {
 	input := SolidityInput{Language: "Solidity", Sources: make(map[string]SolidityInputSource)}
 
 	input.Sources[file] = SolidityInputSource{Urls: []string{file}}
 	input.Settings.Optimizer.Enabled = optimize
-	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.bytecode.linkReferences", "metadata", "bin", "devdoc"}
+	input.Settings.OutputSelection.File.OutputType = []string{"abi", "evm.bytecode.linkReferences", "evm.deployedBytecode", "metadata", "bin", "devdoc", "storageLayout"}
 	input.Settings.Libraries = make(map[string]map[string]string)
 	input.Settings.Libraries[""] = make(map[string]string)
 
@@ -158,7 +183,11 @@ func Compile(file string, optimize bool, libraries map[string]string) (*Response
 	}
 
 	log.WithField("Command: ", string(command)).Debug("Command Input")
-	result, err := runSolidity(string(command))
+	path := solc.Path
+	if path == "" {
+		path = "solc"
+	}
+	result, err := runStandardJSON(path, string(command), "--allow-paths", "/")
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +199,32 @@ func Compile(file string, optimize bool, libraries map[string]string) (*Response
 		return nil, err
 	}
 
+	resp := newResponse(output)
+	for _, item := range resp.Objects {
+		if resp.Version = metadataVersion(item.Binary.Metadata); resp.Version != "" {
+			break
+		}
+	}
+	PrintResponse(*resp, false)
+
+	return resp, nil
+}
+
+// Reads the version of the compiler that wrote solc metadata, without the commit and platform that follow it
+func metadataVersion(metadata string) string {
+	var meta struct {
+		Compiler struct {
+			Version string
+		}
+	}
+	if json.Unmarshal([]byte(metadata), &meta) != nil {
+		return ""
+	}
+	return strings.SplitN(meta.Compiler.Version, "+", 2)[0]
+}
+
+// Collects the contracts and messages from the standard JSON output of a compiler
+func newResponse(output SolidityOutput) *Response {
 	respItemArray := make([]ResponseItem, 0)
 
 	for f, s := range output.Contracts {
@@ -186,10 +241,14 @@ func Compile(file string, optimize bool, libraries map[string]string) (*Response
 	warnings := ""
 	errors := ""
 	for _, msg := range output.Errors {
+		message := msg.FormattedMessage
+		if message == "" {
+			message = msg.Message
+		}
 		if msg.Type == "Warning" {
-			warnings += msg.FormattedMessage
+			warnings += message
 		} else {
-			errors += msg.FormattedMessage
+			errors += message
 		}
 	}
 
@@ -201,15 +260,11 @@ func Compile(file string, optimize bool, libraries map[string]string) (*Response
 		}).Debug("Response formulated")
 	}
 
-	resp := Response{
+	return &Response{
 		Objects: respItemArray,
 		Warning: warnings,
 		Error:   errors,
 	}
-
-	PrintResponse(resp, false)
-
-	return &resp, nil
 }
 
 func objectName(contract string) string {
@@ -220,9 +275,10 @@ func objectName(contract string) string {
 	return parts[len(parts)-1]
 }
 
-func runSolidity(jsonCmd string) (string, error) {
+// Runs a compiler taking standard JSON input such as solc or vyper
+func runStandardJSON(compiler, jsonCmd string, args ...string) (string, error) {
 	buf := bytes.NewBufferString(jsonCmd)
-	shellCmd := exec.Command("solc", "--standard-json", "--allow-paths", "/")
+	shellCmd := exec.Command(compiler, append([]string{"--standard-json"}, args...)...)
 	shellCmd.Stdin = buf
 	output, err := shellCmd.CombinedOutput()
 	s := string(output)
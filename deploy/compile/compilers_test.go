@@ -115,8 +115,11 @@ func TestLocalSingle(t *testing.T) {
 	for i := range resp.Objects {
 		resp.Objects[i].Binary.Metadata = ""
 		resp.Objects[i].Binary.Devdoc = nil
+		resp.Objects[i].Binary.StorageLayout = nil
 		resp.Objects[i].Binary.Evm.Bytecode.Opcodes = ""
+		resp.Objects[i].Binary.Evm.DeployedBytecode = SolidityOutputBytecode{}
 	}
+	resp.Version = ""
 	assert.Equal(t, expectedResponse, resp)
 }
 
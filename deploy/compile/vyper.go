@@ -0,0 +1,81 @@
+package compile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Compiles Vyper with vyper (0.3 onwards for its standard JSON interface)
+type Vyper struct {
+	// Path to the vyper binary, if empty vyper is run from the PATH
+	Path string
+}
+
+type VyperInput struct {
+	Language string                         `json:"language"`
+	Sources  map[string]SolidityInputSource `json:"sources"`
+	Settings struct {
+		OutputSelection map[string][]string `json:"outputSelection"`
+	} `json:"settings"`
+}
+
+func (vyper Vyper) Compile(file string, optimize bool, libraries map[string]string) (*Response, error) {
+	if len(libraries) > 0 {
+		return nil, fmt.Errorf("cannot link libraries into Vyper contract %s", file)
+	}
+	source, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	// Unlike solc vyper will not read sources itself
+	input := VyperInput{Language: "Vyper", Sources: map[string]SolidityInputSource{
+		file: {Content: string(source)},
+	}}
+	input.Settings.OutputSelection = map[string][]string{"*": {"abi", "evm.bytecode", "evm.deployedBytecode", "devdoc", "userdoc"}}
+
+	command, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithField("Command: ", string(command)).Debug("Command Input")
+	path := vyper.Path
+	if path == "" {
+		path = "vyper"
+	}
+	result, err := runStandardJSON(path, string(command))
+	if err != nil {
+		return nil, fmt.Errorf("could not run %s: %v: %s", path, err, result)
+	}
+	log.WithField("Command Result: ", result).Debug("Command Output")
+
+	output := SolidityOutput{}
+	err = json.Unmarshal([]byte(result), &output)
+	if err != nil {
+		return nil, err
+	}
+	// Unlike solc vyper prefixes bytecode with 0x
+	for _, contracts := range output.Contracts {
+		for name, contract := range contracts {
+			contract.Evm.Bytecode.Object = strings.TrimPrefix(contract.Evm.Bytecode.Object, "0x")
+			contract.Evm.DeployedBytecode.Object = strings.TrimPrefix(contract.Evm.DeployedBytecode.Object, "0x")
+			contracts[name] = contract
+		}
+	}
+
+	resp := newResponse(output)
+	// Vyper does not output metadata so we ask for its version
+	version, err := exec.Command(path, "--version").Output()
+	if err != nil {
+		return nil, fmt.Errorf("could not get version of %s: %v", path, err)
+	}
+	resp.Version = strings.SplitN(strings.TrimSpace(string(version)), "+", 2)[0]
+	PrintResponse(*resp, false)
+
+	return resp, nil
+}
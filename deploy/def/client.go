@@ -5,11 +5,15 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"sync"
 
 	"reflect"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/genesis/spec"
@@ -20,6 +24,7 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcevents"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/sirupsen/logrus"
@@ -28,17 +33,41 @@ import (
 
 type Client struct {
 	MempoolSigning bool
+	// TLS used to connect to the chain and keys GRPC servers, plaintext if nil
+	ChainTLS *tlsconfig.ClientConfig
+	KeysTLS  *tlsconfig.ClientConfig
 	// Memoised clients and info
 	chainID               string
 	transactClient        rpctransact.TransactClient
 	queryClient           rpcquery.QueryClient
 	executionEventsClient rpcevents.ExecutionEventsClient
 	keyClient             keys.KeyClient
+	// Set when transactions are broadcast without waiting for the previous one to execute, see Pipeline
+	pipeline *pipeline
+	// Set to collect the hashes of executed transactions, see RecordTxHashes
+	txHashes *[]binary.HexBytes
+}
+
+type pipeline struct {
+	sync.Mutex
+	// Called as each transaction is accepted into the mempool
+	accepted func()
+	// The last sequence number used by accounts with transactions accepted but not yet executed
+	inFlight map[crypto.Address]*inFlight
+}
+
+type inFlight struct {
+	sequence uint64
+	pending  int
 }
 
 // Connect GRPC clients using ChainURL
 func (c *Client) Dial(chainAddress, keysClientAddress string) error {
-	conn, err := grpc.Dial(chainAddress, grpc.WithInsecure())
+	transportOpt, err := c.ChainTLS.GRPCDialOption()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(chainAddress, transportOpt)
 	if err != nil {
 		return err
 	}
@@ -50,7 +79,7 @@ func (c *Client) Dial(chainAddress, keysClientAddress string) error {
 		c.MempoolSigning = true
 	} else {
 		logrus.Info("Using keys server at: %s", keysClientAddress)
-		c.keyClient, err = keys.NewRemoteKeyClient(keysClientAddress, logging.NewNoopLogger())
+		c.keyClient, err = keys.NewRemoteKeyClient(keysClientAddress, c.KeysTLS, logging.NewNoopLogger())
 	}
 
 	if err != nil {
@@ -64,6 +93,10 @@ func (c *Client) Dial(chainAddress, keysClientAddress string) error {
 	return nil
 }
 
+func (c *Client) ChainID() string {
+	return c.chainID
+}
+
 func (c *Client) Transact() rpctransact.TransactClient {
 	return c.transactClient
 }
@@ -92,12 +125,45 @@ func (c *Client) GetValidatorSet() (*rpcquery.ValidatorSet, error) {
 	return c.queryClient.GetValidatorSet(context.Background(), &rpcquery.GetValidatorSetParam{IncludeHistory: true})
 }
 
+// Pipeline makes SignAndBroadcast submit each transaction to the mempool and wait for its execution separately so that
+// the transactions of concurrently running jobs may be in flight together. Sequence numbers left for the client to
+// choose are then assigned as transactions are submitted, following on from any transactions in flight from the
+// same account. accepted is called each time a transaction enters the mempool. Passing nil restores synchronous
+// broadcast.
+func (c *Client) Pipeline(accepted func()) {
+	if accepted == nil {
+		c.pipeline = nil
+		return
+	}
+	c.pipeline = &pipeline{
+		accepted: accepted,
+		inFlight: make(map[crypto.Address]*inFlight),
+	}
+}
+
+// RecordTxHashes appends the hash of each transaction SignAndBroadcast sees executed to txHashes, or stops recording
+// if txHashes is nil
+func (c *Client) RecordTxHashes(txHashes *[]binary.HexBytes) {
+	c.txHashes = txHashes
+}
+
 func (c *Client) SignAndBroadcast(tx payload.Payload) (*exec.TxExecution, error) {
-	txEnv, err := c.SignTx(tx)
-	if err != nil {
-		return nil, err
+	var txe *exec.TxExecution
+	var err error
+	if c.pipeline != nil {
+		txe, err = c.pipelineSignAndBroadcast(tx)
+	} else {
+		var txEnv *txs.Envelope
+		txEnv, err = c.SignTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		txe, err = c.BroadcastEnvelope(txEnv)
+	}
+	if err == nil && c.txHashes != nil {
+		*c.txHashes = append(*c.txHashes, txe.TxHash)
 	}
-	return c.BroadcastEnvelope(txEnv)
+	return txe, err
 }
 
 func (c *Client) SignTx(tx payload.Payload) (*txs.Envelope, error) {
@@ -122,6 +188,91 @@ func (c *Client) SignTx(tx payload.Payload) (*txs.Envelope, error) {
 	return txEnv, nil
 }
 
+func (c *Client) pipelineSignAndBroadcast(tx payload.Payload) (*exec.TxExecution, error) {
+	pl := c.pipeline
+	receipt, err := c.submit(tx)
+	if err != nil {
+		return nil, err
+	}
+	defer pl.executed(tx.GetInputs())
+	pl.accepted()
+
+	ctx, cancel := context.WithTimeout(context.Background(), execution.BlockingTimeout)
+	defer cancel()
+	txe, err := c.executionEventsClient.GetTx(ctx, &rpcevents.GetTxRequest{TxHash: receipt.TxHash, Wait: true})
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution of transaction %v: %v", receipt.TxHash, err)
+	}
+	// As for BroadcastTxSync
+	if txe.Exception != nil && txe.Exception.ErrorCode() != errors.ErrorCodeExecutionReverted {
+		return nil, errors.Wrap(txe.Exception, "exception during transaction execution")
+	}
+	return txe, nil
+}
+
+// Assigns sequence numbers, signs, and pushes tx to the mempool holding the pipeline lock so that transactions from
+// the same account arrive in the order of their sequence numbers
+func (c *Client) submit(tx payload.Payload) (*txs.Receipt, error) {
+	pl := c.pipeline
+	pl.Lock()
+	defer pl.Unlock()
+	inputs := tx.GetInputs()
+	if !c.MempoolSigning {
+		for _, input := range inputs {
+			// Sequence was given explicitly
+			if input.Sequence != 0 {
+				continue
+			}
+			if flight, ok := pl.inFlight[input.Address]; ok {
+				input.Sequence = flight.sequence + 1
+				continue
+			}
+			acc, err := c.GetAccount(input.Address)
+			if err != nil {
+				return nil, err
+			}
+			input.Sequence = acc.GetSequence() + 1
+		}
+	}
+	txEnv, err := c.SignTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := c.transactClient.BroadcastTxAsync(context.Background(),
+		&rpctransact.TxEnvelopeParam{Envelope: txEnv})
+	if err != nil {
+		return nil, err
+	}
+	if !c.MempoolSigning {
+		for _, input := range inputs {
+			flight, ok := pl.inFlight[input.Address]
+			if !ok {
+				flight = new(inFlight)
+				pl.inFlight[input.Address] = flight
+			}
+			flight.sequence = input.Sequence
+			flight.pending++
+		}
+	}
+	return receipt, nil
+}
+
+// Once an account has nothing in flight its sequence number is read from the chain again
+func (pl *pipeline) executed(inputs []*payload.TxInput) {
+	pl.Lock()
+	defer pl.Unlock()
+	for _, input := range inputs {
+		flight, ok := pl.inFlight[input.Address]
+		if !ok {
+			continue
+		}
+		flight.pending--
+		if flight.pending == 0 {
+			delete(pl.inFlight, input.Address)
+		}
+	}
+}
+
 // Creates a keypair using attached keys service
 func (c *Client) CreateKey(keyName, curveTypeString string) (crypto.PublicKey, error) {
 	if c.keyClient == nil {
@@ -472,6 +623,10 @@ func (c *Client) GetSequence(sequence string, inputAddress crypto.Address) (uint
 			// Perform mempool signing
 			return 0, nil
 		}
+		if c.pipeline != nil {
+			// Assigned when the transaction is submitted
+			return 0, nil
+		}
 		// Get from chain
 		acc, err := c.queryClient.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: inputAddress})
 		if err != nil {
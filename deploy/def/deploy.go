@@ -1,6 +1,8 @@
 package def
 
 import (
+	"time"
+
 	"github.com/go-ozzo/ozzo-validation"
 	"github.com/hyperledger/burrow/deploy/def/rule"
 )
@@ -9,6 +11,8 @@ type Packages struct {
 	Address       string   `mapstructure:"," json:"," yaml:"," toml:","`
 	BinPath       string   `mapstructure:"," json:"," yaml:"," toml:","`
 	ChainURL      string   `mapstructure:"," json:"," yaml:"," toml:","`
+	CompilerCache string   `mapstructure:"," json:"," yaml:"," toml:","`
+	Concurrency   int      `mapstructure:"," json:"," yaml:"," toml:","`
 	CurrentOutput string   `mapstructure:"," json:"," yaml:"," toml:","`
 	Debug         bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	DefaultAmount string   `mapstructure:"," json:"," yaml:"," toml:","`
@@ -16,12 +20,17 @@ type Packages struct {
 	DefaultGas    string   `mapstructure:"," json:"," yaml:"," toml:","`
 	DefaultOutput string   `mapstructure:"," json:"," yaml:"," toml:","`
 	DefaultSets   []string `mapstructure:"," json:"," yaml:"," toml:","`
+	Force         []string `mapstructure:"," json:"," yaml:"," toml:","`
 	Path          string   `mapstructure:"," json:"," yaml:"," toml:","`
 	Signer        string   `mapstructure:"," json:"," yaml:"," toml:","`
 	Verbose       bool     `mapstructure:"," json:"," yaml:"," toml:","`
 	YAMLPath      string   `mapstructure:"," json:"," yaml:"," toml:","`
+	// Do not keep a state file of the jobs done, for chains that do not outlive the run
+	NoState bool `mapstructure:"," json:"," yaml:"," toml:","`
 
 	Package *Package
+	// Called, if set, as each job finishes with how long it took and the error it failed with if it did
+	JobDone func(job *Job, elapsed time.Duration, err error) `mapstructure:"-" json:"-" yaml:"-" toml:"-"`
 	Client
 }
 
@@ -41,7 +50,9 @@ func (do *Packages) Dial() error {
 
 type Package struct {
 	Account string
-	Jobs    []*Job
+	// Versions of compilers by name (solc or vyper) to take from the compiler cache rather than the PATH
+	Compilers map[string]string `mapstructure:"compilers,omitempty" json:"compilers,omitempty" yaml:"compilers,omitempty" toml:"compilers"`
+	Jobs      []*Job
 }
 
 func (pkg *Package) Validate() error {
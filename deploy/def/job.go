@@ -21,6 +21,12 @@ type Job struct {
 	Result interface{} `json:"-" yaml:"-" toml:"-"`
 	// For multiple values
 	Variables []*abi.Variable `json:"-" yaml:"-" toml:"-"`
+	// Run the job only if a condition holds
+	If *Condition `mapstructure:"if,omitempty" json:"if,omitempty" yaml:"if,omitempty" toml:"if"`
+	// Run the job once for each element of a list, or of a placeholder for a comma-separated or JSON list, which
+	// the job can refer to as $<job name>.item (and its position as $<job name>.index). The result of the job is
+	// the list of results of each run.
+	ForEach interface{} `mapstructure:"for-each,omitempty" json:"for-each,omitempty" yaml:"for-each,omitempty" toml:"for-each"`
 	// Sets/Resets the primary account to use
 	Account *Account `mapstructure:"account,omitempty" json:"account,omitempty" yaml:"account,omitempty" toml:"account"`
 	// Set an arbitrary value
@@ -31,6 +37,10 @@ type Job struct {
 	UpdateAccount *UpdateAccount `mapstructure:"update-account,omitempty" json:"update-account,omitempty" yaml:"update-account,omitempty" toml:"update-account"`
 	// Contract compile and send to the chain functions
 	Deploy *Deploy `mapstructure:"deploy,omitempty" json:"deploy,omitempty" yaml:"deploy,omitempty" toml:"deploy"`
+	// Deploy a contract behind an upgradeable proxy
+	ProxyDeploy *ProxyDeploy `mapstructure:"proxy-deploy,omitempty" json:"proxy-deploy,omitempty" yaml:"proxy-deploy,omitempty" toml:"proxy-deploy"`
+	// Point a proxy at a new implementation
+	Upgrade *Upgrade `mapstructure:"upgrade,omitempty" json:"upgrade,omitempty" yaml:"upgrade,omitempty" toml:"upgrade"`
 	// Contract compile/build
 	Build *Build `mapstructure:"build,omitempty" json:"build,omitempty" yaml:"build,omitempty" toml:"build"`
 	// Send tokens from one account to another
@@ -56,12 +66,19 @@ type Job struct {
 	QueryVals *QueryVals `mapstructure:"query-vals,omitempty" json:"query-vals,omitempty" yaml:"query-vals,omitempty" toml:"query-vals"`
 	// Makes and assertion (useful for testing purposes)
 	Assert *Assert `mapstructure:"assert,omitempty" json:"assert,omitempty" yaml:"assert,omitempty" toml:"assert"`
+	// Waits for an event matching a query
+	WaitForEvent *WaitForEvent `mapstructure:"wait-for-event,omitempty" json:"wait-for-event,omitempty" yaml:"wait-for-event,omitempty" toml:"wait-for-event"`
 }
 
 type Payload interface {
 	validation.Validatable
 }
 
+// Qualifies the payload of a job rather than being one, so is not validation.Validatable lest it be taken for a Payload
+type ConditionValidatable interface {
+	ValidateCondition() error
+}
+
 func (job *Job) Validate() error {
 	payloadField, err := job.PayloadField()
 	if err != nil {
@@ -72,10 +89,30 @@ func (job *Job) Validate() error {
 			Error("must contain word characters; alphanumeric plus underscores/hyphens")),
 		validation.Field(&job.Result, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
 		validation.Field(&job.Variables, rule.New(rule.IsOmitted, "internally reserved and should be removed")),
+		validation.Field(&job.If, validation.By(validCondition)),
+		validation.Field(&job.ForEach, validation.By(isList)),
 		validation.Field(payloadField.Addr().Interface()),
 	)
 }
 
+func validCondition(value interface{}) error {
+	cond, ok := value.(ConditionValidatable)
+	if !ok || reflect.ValueOf(cond).IsNil() {
+		return nil
+	}
+	return cond.ValidateCondition()
+}
+
+// A for-each is either a list or a string holding one
+func isList(value interface{}) error {
+	switch value.(type) {
+	case nil, string, []interface{}, []string:
+		return nil
+	default:
+		return fmt.Errorf("must be a list or a placeholder for one")
+	}
+}
+
 var payloadType = reflect.TypeOf((*Payload)(nil)).Elem()
 
 func (job *Job) Payload() (Payload, error) {
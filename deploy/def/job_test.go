@@ -41,3 +41,33 @@ func TestJob_Validate(t *testing.T) {
 	err = job.Validate()
 	require.Error(t, err)
 }
+
+func TestJob_ValidateControlFlow(t *testing.T) {
+	job := &Job{
+		Name:    "grant",
+		If:      &Condition{Account: "$account", Field: "permissions.roles", Relation: "excludes", Value: "admin"},
+		ForEach: []interface{}{"$alice", "$bob"},
+		Permission: &Permission{
+			Action: "add_role",
+			Target: "$grant.item",
+			Role:   "admin",
+		},
+	}
+	require.NoError(t, job.Validate())
+	payload, err := job.Payload()
+	require.NoError(t, err)
+	assert.Equal(t, job.Permission, payload, "condition should not be taken for the payload")
+
+	job.If.Key = "$roles"
+	assert.Error(t, job.Validate(), "condition should not have both key and account")
+	job.If = &Condition{Key: "$roles", Relation: "has"}
+	assert.Error(t, job.Validate())
+	job.If = nil
+	job.ForEach = 3
+	assert.Error(t, job.Validate())
+
+	job = &Job{Name: "wait", WaitForEvent: &WaitForEvent{Query: "EventType = 'LogEvent'", Timeout: "soon"}}
+	assert.Error(t, job.Validate())
+	job.WaitForEvent.Timeout = "10s"
+	assert.NoError(t, job.Validate())
+}
@@ -1,7 +1,9 @@
 package def
 
 import (
+	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
@@ -263,6 +265,12 @@ type Deploy struct {
 	// (Optional, advanced only) sequence to use when burrow keys signs the transaction (do not use unless you
 	// know what you're doing)
 	Sequence string `mapstructure:"sequence" json:"sequence" yaml:"sequence" toml:"sequence"`
+	// (Optional) publish the source hash, compiler version, ABI, and metadata of the deployed contract to the name
+	// registry under contract/<address> so that anyone can check its code against the source with burrow verify
+	Publish bool `mapstructure:"publish" json:"publish" yaml:"publish" toml:"publish"`
+	// (Optional) number of blocks the published entry should last for, which the source account pays for by the
+	// byte (defaults to 100000)
+	PublishBlocks string `mapstructure:"publish-blocks" json:"publish-blocks" yaml:"publish-blocks" toml:"publish-blocks"`
 	// (Optional) todo
 	Variables []*abi.Variable
 }
@@ -274,6 +282,7 @@ func (job *Deploy) Validate() error {
 		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
 		validation.Field(&job.Sequence, rule.Uint64OrPlaceholder),
+		validation.Field(&job.PublishBlocks, rule.Uint64OrPlaceholder),
 	)
 }
 
@@ -320,6 +329,98 @@ func (job *Call) Validate() error {
 	)
 }
 
+// Deploys an implementation contract behind a proxy that delegates every call to it, so that the implementation can
+// later be swapped with an upgrade job while the proxy keeps its address and storage
+type ProxyDeploy struct {
+	// (Optional, if account job or global account set) address of the account from which to send (the
+	// public key for the account must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) the filepath to the implementation contract file, as for the deploy job
+	Contract string `mapstructure:"contract" json:"contract" yaml:"contract" toml:"contract"`
+	// (Optional) the name of the implementation contract within the file, as for the deploy job but "all" is not
+	// allowed
+	Instance string `mapstructure:"instance" json:"instance" yaml:"instance" toml:"instance"`
+	// (Optional) the file path for the linkReferences for contract
+	Libraries string `mapstructure:"libraries" json:"libraries" yaml:"libraries" toml:"libraries"`
+	// (Optional) constructor arguments for the implementation. Note the constructor only initialises the
+	// implementation's own storage, use init-function to initialise the proxy.
+	Data interface{} `mapstructure:"data" json:"data" yaml:"data" toml:"data"`
+	// (Optional) address of a proxy deployed earlier to point at the implementation rather than deploying a new one
+	Proxy string `mapstructure:"proxy" json:"proxy" yaml:"proxy" toml:"proxy"`
+	// (Optional) address of the account allowed to upgrade a new proxy, defaults to source
+	Admin string `mapstructure:"admin" json:"admin" yaml:"admin" toml:"admin"`
+	// (Optional) function of the implementation to call through the proxy once it points at the implementation
+	InitFunction string `mapstructure:"init-function" json:"init-function" yaml:"init-function" toml:"init-function"`
+	// (Optional) arguments for init-function
+	InitData interface{} `mapstructure:"init-data" json:"init-data" yaml:"init-data" toml:"init-data"`
+	// (Optional) when reusing a proxy do not check the storage layout of the implementation is compatible with the
+	// one it replaces
+	UnsafeSkipStorageCheck bool `mapstructure:"unsafe-skip-storage-check" json:"unsafe-skip-storage-check" yaml:"unsafe-skip-storage-check" toml:"unsafe-skip-storage-check"`
+	// (Optional) amount of tokens to send to the implementation and proxy when they are deployed
+	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional) validators' fee
+	Fee string `mapstructure:"fee" json:"fee" yaml:"fee" toml:"fee"`
+	// (Optional) amount of gas which should be sent along with each transaction
+	Gas string `mapstructure:"gas" json:"gas" yaml:"gas" toml:"gas"`
+}
+
+func (job *ProxyDeploy) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Contract, validation.Required),
+		validation.Field(&job.Instance, validation.NotIn("all").Error("cannot deploy all contracts behind a proxy")),
+		validation.Field(&job.Proxy, rule.AddressOrPlaceholder),
+		validation.Field(&job.Admin, rule.AddressOrPlaceholder),
+		validation.Field(&job.Amount, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
+	)
+}
+
+// Points a proxy deployed by a proxy-deploy job at a new implementation
+type Upgrade struct {
+	// (Optional, if account job or global account set) address of the proxy's admin from which to send (the
+	// public key for the account must be available to burrow keys)
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Required) address of the proxy to upgrade
+	Proxy string `mapstructure:"proxy" json:"proxy" yaml:"proxy" toml:"proxy"`
+	// (Required unless implementation is given) the filepath to the new implementation contract file to deploy
+	Contract string `mapstructure:"contract" json:"contract" yaml:"contract" toml:"contract"`
+	// (Optional) the name of the implementation contract within the file
+	Instance string `mapstructure:"instance" json:"instance" yaml:"instance" toml:"instance"`
+	// (Optional) the file path for the linkReferences for contract
+	Libraries string `mapstructure:"libraries" json:"libraries" yaml:"libraries" toml:"libraries"`
+	// (Optional) constructor arguments for the new implementation
+	Data interface{} `mapstructure:"data" json:"data" yaml:"data" toml:"data"`
+	// (Required unless contract is given) address of an implementation already deployed with a deploy job
+	Implementation string `mapstructure:"implementation" json:"implementation" yaml:"implementation" toml:"implementation"`
+	// (Optional) function of the new implementation to call through the proxy once upgraded, e.g. to migrate state
+	InitFunction string `mapstructure:"init-function" json:"init-function" yaml:"init-function" toml:"init-function"`
+	// (Optional) arguments for init-function
+	InitData interface{} `mapstructure:"init-data" json:"init-data" yaml:"init-data" toml:"init-data"`
+	// (Optional) do not check the storage layout of the new implementation is compatible with the one it replaces
+	UnsafeSkipStorageCheck bool `mapstructure:"unsafe-skip-storage-check" json:"unsafe-skip-storage-check" yaml:"unsafe-skip-storage-check" toml:"unsafe-skip-storage-check"`
+	// (Optional) amount of tokens to send to the new implementation when it is deployed
+	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
+	// (Optional) validators' fee
+	Fee string `mapstructure:"fee" json:"fee" yaml:"fee" toml:"fee"`
+	// (Optional) amount of gas which should be sent along with each transaction
+	Gas string `mapstructure:"gas" json:"gas" yaml:"gas" toml:"gas"`
+}
+
+func (job *Upgrade) Validate() error {
+	if (job.Contract == "") == (job.Implementation == "") {
+		return fmt.Errorf("upgrade needs exactly one of contract or implementation")
+	}
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Proxy, validation.Required, rule.AddressOrPlaceholder),
+		validation.Field(&job.Instance, validation.NotIn("all").Error("cannot upgrade to all contracts")),
+		validation.Field(&job.Implementation, rule.AddressOrPlaceholder),
+		validation.Field(&job.Amount, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Fee, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Gas, rule.Uint64OrPlaceholder),
+	)
+}
+
 // ------------------------------------------------------------------------
 // State Jobs
 // ------------------------------------------------------------------------
@@ -330,11 +431,25 @@ type DumpState struct {
 	ToFile         bool   `mapstructure:"to-file" json:"to-file" yaml:"to-file" toml:"to-file"`
 	IPFSHost       string `mapstructure:"ipfs-host" json:"ipfs-host" yaml:"ipfs-host" toml:"ipfs-host"`
 	FilePath       string `mapstructure:"file" json:"file" yaml:"file" toml:"file"`
+	// (Optional) addresses of the accounts (with their code and storage) to dump, by default every account is dumped
+	Accounts []string `mapstructure:"accounts" json:"accounts" yaml:"accounts" toml:"accounts"`
+	// (Optional) whether to dump the name registry
+	WithNames bool `mapstructure:"include-names" json:"include-names" yaml:"include-names" toml:"include-names"`
 }
 
 func (job *DumpState) Validate() error {
-	// TODO: write validation logic
-	return nil
+	if job.ToIPFS {
+		return fmt.Errorf("dump-state to IPFS is not supported, provide a file")
+	}
+	for _, account := range job.Accounts {
+		err := rule.AddressOrPlaceholder.Validate(account)
+		if err != nil {
+			return fmt.Errorf("dump-state account %s: %v", account, err)
+		}
+	}
+	return validation.ValidateStruct(job,
+		validation.Field(&job.FilePath, validation.Required),
+	)
 }
 
 type RestoreState struct {
@@ -342,11 +457,22 @@ type RestoreState struct {
 	FromFile bool   `mapstructure:"from-file" json:"from-file" yaml:"from-file" toml:"from-file"`
 	IPFSHost string `mapstructure:"ipfs-host" json:"ipfs-host" yaml:"ipfs-host" toml:"ipfs-host"`
 	FilePath string `mapstructure:"file" json:"file" yaml:"file" toml:"file"`
+	// (Optional, if account job or global account set) address of the account with Root permission from which to
+	// send the GovTxs that restore accounts and the NameTxs that restore names
+	Source string `mapstructure:"source" json:"source" yaml:"source" toml:"source"`
+	// (Optional) amount to pay for each restored name, defaults to the default amount
+	Amount string `mapstructure:"amount" json:"amount" yaml:"amount" toml:"amount"`
 }
 
 func (job *RestoreState) Validate() error {
-	// TODO: write validation logic
-	return nil
+	if job.FromIPFS {
+		return fmt.Errorf("restore-state from IPFS is not supported, provide a file")
+	}
+	return validation.ValidateStruct(job,
+		validation.Field(&job.FilePath, validation.Required),
+		validation.Field(&job.Source, rule.AddressOrPlaceholder),
+		validation.Field(&job.Amount, rule.Uint64OrPlaceholder),
+	)
 }
 
 // ------------------------------------------------------------------------
@@ -426,11 +552,12 @@ type Assert struct {
 	// (Required) key which should be used for the assertion. This is usually known as the "expected"
 	// value in most testing suites
 	Key string `mapstructure:"key" json:"key" yaml:"key" toml:"key"`
-	// (Required) must be of the set ["eq", "ne", "ge", "gt", "le", "lt", "==", "!=", ">=", ">", "<=", "<"]
-	// establishes the relation to be tested by the assertion. If a strings key:value pair is being used
-	// only the equals or not-equals relations may be used as the key:value will try to be converted to
+	// (Required) must be of the set ["eq", "ne", "ge", "gt", "le", "lt", "==", "!=", ">=", ">", "<=", "<",
+	// "contains", "excludes"] establishes the relation to be tested by the assertion. If a strings key:value pair is
+	// being used only the equals or not-equals relations may be used as the key:value will try to be converted to
 	// ints for the remainder of the relations. if strings are passed to them then `monax pkgs do` will return an
-	// error
+	// error. contains and excludes test whether the key, as a comma-separated list like the roles of an account,
+	// has the value as one of its elements
 	Relation string `mapstructure:"relation" json:"relation" yaml:"relation" toml:"relation"`
 	// (Required) value which should be used for the assertion. This is usually known as the "given"
 	// value in most testing suites. Generally it will be a variable expansion from one of the query
@@ -443,3 +570,61 @@ func (job *Assert) Validate() error {
 		validation.Field(&job.Relation, validation.Required, rule.Relation),
 	)
 }
+
+// ------------------------------------------------------------------------
+// Control Flow
+// ------------------------------------------------------------------------
+
+// Condition on which a job is run, given by the if of the job, which holds when the relation between key and value
+// does as for an assert job
+type Condition struct {
+	// (Required unless account is given) key to compare, usually a placeholder for the result of an earlier job
+	Key string `mapstructure:"key" json:"key" yaml:"key" toml:"key"`
+	// (Optional) address of an account to query field of, as a query-account job does, for the key
+	Account string `mapstructure:"account" json:"account" yaml:"account" toml:"account"`
+	// (Required if account is given) field of the account to query
+	Field string `mapstructure:"field" json:"field" yaml:"field" toml:"field"`
+	// (Required) relation between key and value as for an assert job
+	Relation string `mapstructure:"relation" json:"relation" yaml:"relation" toml:"relation"`
+	// (Optional) value to compare the key with
+	Value string `mapstructure:"val" json:"val" yaml:"val" toml:"val"`
+}
+
+func (cond *Condition) ValidateCondition() error {
+	if (cond.Key == "") == (cond.Account == "") {
+		return fmt.Errorf("if needs exactly one of key or account")
+	}
+	if (cond.Account == "") != (cond.Field == "") {
+		return fmt.Errorf("if needs a field when, and only when, it queries an account")
+	}
+	return validation.ValidateStruct(cond,
+		validation.Field(&cond.Account, rule.AddressOrPlaceholder),
+		validation.Field(&cond.Relation, validation.Required, rule.Relation),
+	)
+}
+
+// Waits for an event matching a query, for instance one logged by a contract as the result of a transaction sent
+// by someone other than this deployment
+type WaitForEvent struct {
+	// (Required) query the event should match, in the query language of the ExecutionEvents service, for example
+	// "EventType = 'LogEvent' AND Address = '$mycontract'"
+	Query string `mapstructure:"query" json:"query" yaml:"query" toml:"query"`
+	// (Optional) height of the block to look for the event from, by default the latest block
+	From string `mapstructure:"from" json:"from" yaml:"from" toml:"from"`
+	// (Optional) how long to wait for the event before failing, as a duration like 30s or 5m (defaults to 1m)
+	Timeout string `mapstructure:"timeout" json:"timeout" yaml:"timeout" toml:"timeout"`
+}
+
+func (job *WaitForEvent) Validate() error {
+	return validation.ValidateStruct(job,
+		validation.Field(&job.Query, validation.Required),
+		validation.Field(&job.From, rule.Uint64OrPlaceholder),
+		validation.Field(&job.Timeout, validation.By(func(value interface{}) error {
+			if job.Timeout == "" {
+				return nil
+			}
+			_, err := time.ParseDuration(job.Timeout)
+			return err
+		})),
+	)
+}
@@ -100,7 +100,8 @@ var (
 
 	AddressOrPlaceholder = Or(Placeholder, Address)
 
-	Relation = validation.In("eq", "ne", "ge", "gt", "le", "lt", "==", "!=", ">=", ">", "<=", "<")
+	Relation = validation.In("eq", "ne", "ge", "gt", "le", "lt", "==", "!=", ">=", ">", "<=", "<", "contains",
+		"excludes")
 
 	PermissionOrPlaceholder = Or(Placeholder, Permission)
 
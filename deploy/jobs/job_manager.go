@@ -2,9 +2,10 @@ package jobs
 
 import (
 	"fmt"
-	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/hyperledger/burrow/binary"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
@@ -17,10 +18,12 @@ type trackJob struct {
 	compilerResp *compilers.Response
 	err          error
 	done         chan struct{}
+	// The name of the for-each job this job is an iteration of, if any
+	forEach string
 }
 
-func compile(contract string, track *trackJob) {
-	(*track).compilerResp, (*track).err = compilers.Compile(contract, false, nil)
+func compile(compiler compilers.Compiler, contract string, track *trackJob) {
+	(*track).compilerResp, (*track).err = compiler.Compile(contract, false, nil)
 	close(track.done)
 }
 
@@ -55,6 +58,11 @@ func RunJobs(do *def.Packages) error {
 		return fmt.Errorf("error validating Burrow deploy file at %s: %v", do.YAMLPath, err)
 	}
 
+	backends, err := compilers.NewCompilers(do.CompilerCache, do.Package.Compilers)
+	if err != nil {
+		return err
+	}
+
 	intermediateJobs := make([]*trackJob, 0, len(do.Package.Jobs))
 
 	for _, job := range do.Package.Jobs {
@@ -70,123 +78,220 @@ func RunJobs(do *def.Packages) error {
 		switch payload.(type) {
 		case *def.Build:
 			track.done = make(chan struct{})
-			go compile(job.Build.Contract, &track)
-		case *def.Deploy:
-			if filepath.Ext(job.Deploy.Contract) == ".sol" {
+			go compile(backends, job.Build.Contract, &track)
+		case *def.Deploy, *def.ProxyDeploy, *def.Upgrade:
+			if contract := contractFile(payload); backends.Compiles(contract) {
 				track.done = make(chan struct{})
-				go compile(job.Deploy.Contract, &track)
+				go compile(backends, contract, &track)
 			}
 		}
 	}
 
-	for _, m := range intermediateJobs {
-		job := m.job
-
-		err = util.PreProcessFields(m.payload, do)
+	// Transacting jobs done by a previous run against the same chain are skipped
+	var state *DeployState
+	if needed && !do.NoState {
+		state, err = loadDeployState(deployStatePath(do.YAMLPath), do)
 		if err != nil {
 			return err
 		}
-		// Revalidate with possible replacements
-		err = m.payload.Validate()
+	}
+
+	if do.Concurrency > 1 {
+		err = runConcurrently(intermediateJobs, do, state)
 		if err != nil {
-			return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+			return err
 		}
-
-		if m.done != nil {
-			<-m.done
-			if m.err != nil {
-				return m.err
+	} else {
+		for _, m := range intermediateJobs {
+			err = runJob(m, do, state)
+			if err != nil {
+				return err
 			}
 		}
+	}
 
-		switch m.payload.(type) {
-		// Meta Job
-		case *def.Meta:
-			announce(job.Name, "Meta")
-			do.CurrentOutput = fmt.Sprintf("%s.output.json", job.Name)
-			job.Result, err = MetaJob(job.Meta, do)
-
-		// Governance
-		case *def.UpdateAccount:
-			announce(job.Name, "UpdateAccount")
-			job.Result, job.Variables, err = UpdateAccountJob(job.UpdateAccount, do)
-
-		// Util jobs
-		case *def.Account:
-			announce(job.Name, "Account")
-			job.Result, err = SetAccountJob(job.Account, do)
-		case *def.Set:
-			announce(job.Name, "Set")
-			job.Result, err = SetValJob(job.Set, do)
-
-		// Transaction jobs
-		case *def.Send:
-			announce(job.Name, "Sent")
-			job.Result, err = SendJob(job.Send, do)
-		case *def.RegisterName:
-			announce(job.Name, "RegisterName")
-			job.Result, err = RegisterNameJob(job.RegisterName, do)
-		case *def.Permission:
-			announce(job.Name, "Permission")
-			job.Result, err = PermissionJob(job.Permission, do)
-
-		// Contracts jobs
-		case *def.Deploy:
-			announce(job.Name, "Deploy")
-			job.Result, err = DeployJob(job.Deploy, do, m.compilerResp)
-		case *def.Call:
-			announce(job.Name, "Call")
-			job.Result, job.Variables, err = CallJob(job.Call, do)
-		case *def.Build:
-			announce(job.Name, "Build")
-			job.Result, err = BuildJob(job.Build, do, m.compilerResp)
-
-		// State jobs
-		case *def.RestoreState:
-			announce(job.Name, "RestoreState")
-			job.Result, err = RestoreStateJob(job.RestoreState, do)
-		case *def.DumpState:
-			announce(job.Name, "DumpState")
-			job.Result, err = DumpStateJob(job.DumpState, do)
-
-		// Test jobs
-		case *def.QueryAccount:
-			announce(job.Name, "QueryAccount")
-			job.Result, err = QueryAccountJob(job.QueryAccount, do)
-		case *def.QueryContract:
-			announce(job.Name, "QueryContract")
-			job.Result, job.Variables, err = QueryContractJob(job.QueryContract, do)
-		case *def.QueryName:
-			announce(job.Name, "QueryName")
-			job.Result, err = QueryNameJob(job.QueryName, do)
-		case *def.QueryVals:
-			announce(job.Name, "QueryVals")
-			job.Result, err = QueryValsJob(job.QueryVals, do)
-		case *def.Assert:
-			announce(job.Name, "Assert")
-			job.Result, err = AssertJob(job.Assert, do)
+	postProcess(do)
+	return nil
+}
 
-		default:
-			log.Error("")
-			return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
-				job)
-		}
+func runJob(m *trackJob, do *def.Packages, state *DeployState) (err error) {
+	job := m.job
+	if do.JobDone != nil {
+		jobDone, start := do.JobDone, time.Now()
+		defer func() {
+			jobDone(job, time.Since(start), err)
+		}()
+	}
 
-		if len(job.Variables) != 0 {
-			for _, theJob := range job.Variables {
-				log.WithField("=>", fmt.Sprintf("%s,%s", theJob.Name, theJob.Value)).Info("Job Vars")
-			}
+	if job.ForEach != nil {
+		return runForEach(m, do, state)
+	}
+	run, err := conditionHolds(job, do)
+	if err != nil {
+		return err
+	}
+	if !run {
+		log.WithField("=>", job.Name).Warn("Skipping job whose condition does not hold")
+		job.Result = ""
+		return nil
+	}
+	return runPayload(m, do, state)
+}
+
+// Runs the payload of a job, skipping it if it transacts and state shows it was done by a previous run
+func runPayload(m *trackJob, do *def.Packages, state *DeployState) (err error) {
+	job := m.job
+	err = util.PreProcessFields(m.payload, do)
+	if err != nil {
+		return err
+	}
+	// Revalidate with possible replacements
+	err = m.payload.Validate()
+	if err != nil {
+		return fmt.Errorf("error validating job %s after pre-processing variables: %v", job.Name, err)
+	}
+
+	if m.done != nil {
+		<-m.done
+		if m.err != nil {
+			return m.err
 		}
+	}
 
+	var inputs binary.HexBytes
+	var txHashes []binary.HexBytes
+	resumable := state != nil && kindOf(m.payload).transacts()
+	if resumable {
+		inputs, err = inputsHash(m, do)
+		if err != nil {
+			return err
+		}
+		done, err := state.done(m, do, inputs)
 		if err != nil {
 			return err
 		}
+		if done {
+			log.WithField("=>", job.Name).Warn("Skipping job done by previous run")
+			return nil
+		}
+		// Give the job its own client so we know which transactions are its own
+		jobDo := *do
+		do = &jobDo
+		do.RecordTxHashes(&txHashes)
 	}
 
-	postProcess(do)
+	switch m.payload.(type) {
+	// Meta Job
+	case *def.Meta:
+		announce(job.Name, "Meta")
+		do.CurrentOutput = fmt.Sprintf("%s.output.json", job.Name)
+		job.Result, err = MetaJob(job.Meta, do)
+
+	// Governance
+	case *def.UpdateAccount:
+		announce(job.Name, "UpdateAccount")
+		job.Result, job.Variables, err = UpdateAccountJob(job.UpdateAccount, do)
+
+	// Util jobs
+	case *def.Account:
+		announce(job.Name, "Account")
+		job.Result, err = SetAccountJob(job.Account, do)
+	case *def.Set:
+		announce(job.Name, "Set")
+		job.Result, err = SetValJob(job.Set, do)
+
+	// Transaction jobs
+	case *def.Send:
+		announce(job.Name, "Sent")
+		job.Result, err = SendJob(job.Send, do)
+	case *def.RegisterName:
+		announce(job.Name, "RegisterName")
+		job.Result, err = RegisterNameJob(job.RegisterName, do)
+	case *def.Permission:
+		announce(job.Name, "Permission")
+		job.Result, err = PermissionJob(job.Permission, do)
+
+	// Contracts jobs
+	case *def.Deploy:
+		announce(job.Name, "Deploy")
+		job.Result, err = DeployJob(job.Deploy, do, m.compilerResp)
+	case *def.ProxyDeploy:
+		announce(job.Name, "ProxyDeploy")
+		job.Result, job.Variables, err = ProxyDeployJob(job.ProxyDeploy, do, m.compilerResp)
+	case *def.Upgrade:
+		announce(job.Name, "Upgrade")
+		job.Result, err = UpgradeJob(job.Upgrade, do, m.compilerResp)
+	case *def.Call:
+		announce(job.Name, "Call")
+		job.Result, job.Variables, err = CallJob(job.Call, do)
+	case *def.Build:
+		announce(job.Name, "Build")
+		job.Result, err = BuildJob(job.Build, do, m.compilerResp)
+
+	// State jobs
+	case *def.RestoreState:
+		announce(job.Name, "RestoreState")
+		job.Result, err = RestoreStateJob(job.RestoreState, do)
+	case *def.DumpState:
+		announce(job.Name, "DumpState")
+		job.Result, err = DumpStateJob(job.DumpState, do)
+
+	// Test jobs
+	case *def.QueryAccount:
+		announce(job.Name, "QueryAccount")
+		job.Result, err = QueryAccountJob(job.QueryAccount, do)
+	case *def.QueryContract:
+		announce(job.Name, "QueryContract")
+		job.Result, job.Variables, err = QueryContractJob(job.QueryContract, do)
+	case *def.QueryName:
+		announce(job.Name, "QueryName")
+		job.Result, err = QueryNameJob(job.QueryName, do)
+	case *def.QueryVals:
+		announce(job.Name, "QueryVals")
+		job.Result, err = QueryValsJob(job.QueryVals, do)
+	case *def.Assert:
+		announce(job.Name, "Assert")
+		job.Result, err = AssertJob(job.Assert, do)
+
+	// Control flow jobs
+	case *def.WaitForEvent:
+		announce(job.Name, "WaitForEvent")
+		job.Result, job.Variables, err = WaitForEventJob(job.WaitForEvent, do)
+
+	default:
+		log.Error("")
+		return fmt.Errorf("the Job specified in deploy.yaml and parsed as '%v' is not recognised as a valid job",
+			job)
+	}
+
+	if len(job.Variables) != 0 {
+		for _, theJob := range job.Variables {
+			log.WithField("=>", fmt.Sprintf("%s,%s", theJob.Name, theJob.Value)).Info("Job Vars")
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+	if resumable {
+		return state.record(job, inputs, txHashes)
+	}
 	return nil
 }
 
+// The contract file a job deploys, if any
+func contractFile(payload def.Payload) string {
+	switch p := payload.(type) {
+	case *def.Deploy:
+		return p.Contract
+	case *def.ProxyDeploy:
+		return p.Contract
+	case *def.Upgrade:
+		return p.Contract
+	}
+	return ""
+}
+
 func announce(job, typ string) {
 	log.Warn("*****Executing Job*****\n")
 	log.WithField("=>", job).Warn("Job Name")
@@ -266,6 +371,9 @@ func burrowConnectionNeeded(do *def.Packages) (error, bool) {
 		if err != nil {
 			return fmt.Errorf("could not get Job payload: %v", payload), false
 		}
+		if job.If != nil && job.If.Account != "" {
+			return nil, true
+		}
 		switch payload.(type) {
 		case *def.Meta:
 			// A meta jobs will call runJobs again, so it does not need a connection for itself
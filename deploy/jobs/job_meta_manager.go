@@ -23,11 +23,15 @@ func MetaJob(meta *def.Meta, do *def.Packages) (string, error) {
 	newDo := new(def.Packages)
 	newDo.Address = do.Address
 	newDo.ChainURL = do.ChainURL
+	newDo.CompilerCache = do.CompilerCache
+	newDo.Concurrency = do.Concurrency
 	newDo.CurrentOutput = do.CurrentOutput
 	newDo.DefaultAmount = do.DefaultAmount
 	newDo.DefaultFee = do.DefaultFee
 	newDo.DefaultGas = do.DefaultGas
 	newDo.DefaultSets = do.DefaultSets
+	newDo.Force = do.Force
+	newDo.NoState = do.NoState
 	newDo.Signer = do.Signer
 	newDo.MempoolSigning = do.MempoolSigning
 
@@ -69,6 +73,12 @@ func MetaJob(meta *def.Meta, do *def.Packages) (string, error) {
 		if job.Deploy != nil {
 			job.Deploy.Contract = filepath.Join(newDo.Path, job.Deploy.Contract)
 		}
+		if job.ProxyDeploy != nil {
+			job.ProxyDeploy.Contract = filepath.Join(newDo.Path, job.ProxyDeploy.Contract)
+		}
+		if job.Upgrade != nil && job.Upgrade.Contract != "" {
+			job.Upgrade.Contract = filepath.Join(newDo.Path, job.Upgrade.Contract)
+		}
 	}
 
 	err = RunJobs(newDo)
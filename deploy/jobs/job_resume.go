@@ -0,0 +1,209 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	log "github.com/sirupsen/logrus"
+)
+
+// A DeployState records what each transacting job did so that rerunning a deployment skips the jobs that have already
+// been done rather than redeploying contracts and resending transactions
+type DeployState struct {
+	// The chain the jobs were run against, a state for another chain is ignored
+	ChainID string
+	Jobs    map[string]*JobState
+	// Where the state is saved
+	path string
+	sync.Mutex
+}
+
+type JobState struct {
+	// Hash of the job after placeholders are replaced along with anything else that determines what it does
+	InputsHash binary.HexBytes
+	Result     interface{}
+	Variables  []*abi.Variable `json:",omitempty"`
+	// Transactions executed by the job
+	TxHashes []binary.HexBytes `json:",omitempty"`
+}
+
+// The state of a deployment from path if it exists and is for the connected chain, otherwise a new state to be saved at
+// path
+func loadDeployState(path string, do *def.Packages) (*DeployState, error) {
+	state := &DeployState{
+		ChainID: do.ChainID(),
+		Jobs:    make(map[string]*JobState),
+		path:    path,
+	}
+	bs, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	saved := new(DeployState)
+	err = json.Unmarshal(bs, saved)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deploy state from %s: %v", path, err)
+	}
+	if saved.ChainID != state.ChainID {
+		log.WithField("=>", path).Warnf("Ignoring deploy state for chain %s since connected to %s",
+			saved.ChainID, state.ChainID)
+		return state, nil
+	}
+	if saved.Jobs != nil {
+		state.Jobs = saved.Jobs
+	}
+	return state, nil
+}
+
+// Whether a job with inputsHash can be skipped because it has been done already with the same inputs and its effects
+// are still on chain. If so the job's result is restored from state.
+func (state *DeployState) done(m *trackJob, do *def.Packages, inputsHash binary.HexBytes) (bool, error) {
+	job := m.job
+	for _, name := range do.Force {
+		if name == job.Name || m.forEach != "" && name == m.forEach {
+			return false, nil
+		}
+	}
+	state.Lock()
+	jobState, ok := state.Jobs[job.Name]
+	state.Unlock()
+	if !ok {
+		return false, nil
+	}
+	if !bytes.Equal(inputsHash, jobState.InputsHash) {
+		log.WithField("=>", job.Name).Info("Inputs changed since last run")
+		return false, nil
+	}
+	for _, txHash := range jobState.TxHashes {
+		_, err := do.Events().GetTx(context.Background(), &rpcevents.GetTxRequest{TxHash: txHash})
+		if err != nil {
+			log.WithField("=>", txHash).Warnf("Could not find transaction from previous run of %s: %v", job.Name, err)
+			return false, nil
+		}
+	}
+	if contractFile(m.payload) != "" {
+		result, _ := jobState.Result.(string)
+		address, err := crypto.AddressFromHexString(result)
+		if err != nil {
+			return false, nil
+		}
+		acc, err := do.GetAccount(address)
+		if err != nil || acc == nil || len(acc.Code) == 0 {
+			log.WithField("=>", address).Warnf("Contract deployed by previous run of %s is gone", job.Name)
+			return false, nil
+		}
+	}
+	job.Result = jobState.Result
+	job.Variables = jobState.Variables
+	return true, nil
+}
+
+// Records job as done and saves the state so it survives a later job failing
+func (state *DeployState) record(job *def.Job, inputsHash binary.HexBytes, txHashes []binary.HexBytes) error {
+	state.Lock()
+	defer state.Unlock()
+	state.Jobs[job.Name] = &JobState{
+		InputsHash: inputsHash,
+		Result:     job.Result,
+		Variables:  job.Variables,
+		TxHashes:   txHashes,
+	}
+	bs, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write then rename so an interrupted run cannot leave the state truncated
+	tmp := state.path + ".tmp"
+	err = ioutil.WriteFile(tmp, bs, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, state.path)
+}
+
+// Hashes what determines the effect of a job before it runs: the job itself with placeholders in its fields replaced,
+// the results of jobs referred to by placeholders it still contains, the defaults it may use, and any contract code it
+// deploys
+func inputsHash(m *trackJob, do *def.Packages) (binary.HexBytes, error) {
+	hasher := sha256.New()
+	enc := json.NewEncoder(hasher)
+	err := enc.Encode(m.payload)
+	if err != nil {
+		return nil, err
+	}
+	references, err := placeholderJobNames(m.payload)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range references {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, job := range do.Package.Jobs {
+			if job.Name == name && job != m.job {
+				// Results restored from state have been through JSON so hash them as JSON values
+				result, err := jsonValue(job.Result)
+				if err != nil {
+					return nil, err
+				}
+				err = enc.Encode([]interface{}{name, result, job.Variables})
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	err = enc.Encode([]string{do.Package.Account, do.DefaultAmount, do.DefaultFee, do.DefaultGas})
+	if err != nil {
+		return nil, err
+	}
+	if contract := contractFile(m.payload); contract != "" {
+		if m.compilerResp != nil {
+			err = enc.Encode(m.compilerResp.Objects)
+			if err != nil {
+				return nil, err
+			}
+		} else if contractPath, err := findContractFile(contract, do.BinPath); err == nil {
+			bs, err := ioutil.ReadFile(contractPath)
+			if err != nil {
+				return nil, err
+			}
+			hasher.Write(bs)
+		}
+	}
+	return hasher.Sum(nil), nil
+}
+
+func jsonValue(value interface{}) (interface{}, error) {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var jv interface{}
+	err = json.Unmarshal(bs, &jv)
+	return jv, err
+}
+
+// Where the state for the deploy file at yamlPath is kept
+func deployStatePath(yamlPath string) string {
+	return strings.TrimSuffix(yamlPath, filepath.Ext(yamlPath)) + ".state.json"
+}
@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deploy-state")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := deployStatePath(filepath.Join(dir, "deploy.yaml"))
+	assert.Equal(t, filepath.Join(dir, "deploy.state.json"), path)
+
+	const address = "29BFD0C2BAA5A7D7D9F3D2B5F3C3BE04FE7AE6C9"
+	jobs := []*def.Job{
+		{Name: "amount", Set: &def.Set{Value: "3"}, Result: "3"},
+		{Name: "pay", Send: &def.Send{Destination: address, Amount: "$amount"}},
+	}
+	do := &def.Packages{Package: &def.Package{Account: address, Jobs: jobs}}
+	tracks := trackJobs(t, jobs...)
+	pay := tracks[1]
+	hash, err := inputsHash(pay, do)
+	require.NoError(t, err)
+
+	state, err := loadDeployState(path, do)
+	require.NoError(t, err)
+	done, err := state.done(pay, do, hash)
+	require.NoError(t, err)
+	assert.False(t, done, "nothing done yet")
+
+	jobs[1].Result = "DEADBEEF"
+	require.NoError(t, state.record(jobs[1], hash, nil))
+	jobs[1].Result = nil
+
+	// As on rerun
+	state, err = loadDeployState(path, do)
+	require.NoError(t, err)
+	done, err = state.done(pay, do, hash)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, "DEADBEEF", jobs[1].Result)
+
+	// Unless forced
+	do.Force = []string{"pay"}
+	done, err = state.done(pay, do, hash)
+	require.NoError(t, err)
+	assert.False(t, done)
+	do.Force = nil
+
+	// Forcing a for-each job forces each of its iterations
+	payEach := *tracks[1].job
+	payEach.ForEach = address
+	iteration, err := iterationOf(&trackJob{job: &payEach, payload: payEach.Send}, 0)
+	require.NoError(t, err)
+	require.NoError(t, state.record(iteration.job, hash, nil))
+	done, err = state.done(iteration, do, hash)
+	require.NoError(t, err)
+	assert.True(t, done)
+	do.Force = []string{"pay"}
+	done, err = state.done(iteration, do, hash)
+	require.NoError(t, err)
+	assert.False(t, done)
+	do.Force = nil
+
+	// Or a job it refers to has a different result
+	jobs[0].Result = "4"
+	changed, err := inputsHash(pay, do)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+	done, err = state.done(pay, do, changed)
+	require.NoError(t, err)
+	assert.False(t, done)
+
+	// Or a default it may use changes
+	jobs[0].Result = "3"
+	do.Package.Account = "0000000000000000000000000000000000000000"
+	changed, err = inputsHash(pay, do)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+}
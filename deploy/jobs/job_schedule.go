@@ -0,0 +1,246 @@
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/def/rule"
+)
+
+// How a job interacts with the chain and the jobs around it determines what it must wait for when jobs run
+// concurrently
+type jobKind int
+
+const (
+	// Does not touch the chain, e.g. set and build
+	localJob jobKind = iota
+	// Reads chain state so waits for every earlier transaction to be executed
+	queryJob
+	// Sends a single transaction
+	transactJob
+	// May send any number of transactions
+	multiTransactJob
+	// Changes state shared with every later job so runs alone
+	barrierJob
+)
+
+func (kind jobKind) transacts() bool {
+	return kind == transactJob || kind == multiTransactJob
+}
+
+type scheduledJob struct {
+	*trackJob
+	kind jobKind
+	// Whether the job reads chain state, either as its payload or for its condition, so must wait for every earlier
+	// transaction to be executed whatever its kind
+	queriesChain bool
+	// Indices of the jobs that must complete before this one starts
+	dependencies []int
+	// Closed when this transacting job may start broadcasting
+	turn chan struct{}
+	// Closed when the job has completed successfully
+	finished chan struct{}
+}
+
+func kindOf(payload def.Payload) jobKind {
+	switch p := payload.(type) {
+	case *def.Set, *def.Build:
+		return localJob
+	case *def.QueryAccount, *def.QueryContract, *def.QueryName, *def.QueryVals, *def.Assert, *def.DumpState,
+		*def.WaitForEvent:
+		return queryJob
+	case *def.Send, *def.Call, *def.Permission, *def.UpdateAccount:
+		return transactJob
+	case *def.Deploy:
+		// Publishing the source sends a name transaction after the deploy
+		if p.Instance == "all" || p.Publish {
+			return multiTransactJob
+		}
+		return transactJob
+	case *def.RegisterName:
+		if p.DataFile != "" {
+			return multiTransactJob
+		}
+		return transactJob
+	case *def.RestoreState, *def.ProxyDeploy, *def.Upgrade:
+		return multiTransactJob
+	default:
+		// Account and meta jobs along with anything we do not know better about
+		return barrierJob
+	}
+}
+
+// The kind of the job tracked by track, which may run many times for a for-each
+func kindOfJob(track *trackJob) jobKind {
+	kind := kindOf(track.payload)
+	if track.job.ForEach != nil && kind == transactJob {
+		return multiTransactJob
+	}
+	return kind
+}
+
+// Whether the job tracked by track reads chain state, which a condition on an account does whatever the job's kind
+func queriesChain(track *trackJob, kind jobKind) bool {
+	return kind == queryJob || track.job.If != nil && track.job.If.Account != ""
+}
+
+// Works out which earlier jobs each job must wait for. A job waits for any job whose result it refers to with a
+// placeholder, for every earlier transacting job if it queries the chain, for every earlier job if it is a barrier,
+// and for every barrier before it.
+func scheduleJobs(tracks []*trackJob) ([]*scheduledJob, error) {
+	jobs := make([]*scheduledJob, len(tracks))
+	for i, track := range tracks {
+		kind := kindOfJob(track)
+		job := &scheduledJob{
+			trackJob:     track,
+			kind:         kind,
+			queriesChain: queriesChain(track, kind),
+			finished:     make(chan struct{}),
+		}
+		references, err := placeholderJobNames(track.payload, track.job.If, track.job.ForEach)
+		if err != nil {
+			return nil, fmt.Errorf("could not find dependencies of job %s: %v", track.job.Name, err)
+		}
+		for j, earlier := range jobs[:i] {
+			switch {
+			case references[earlier.job.Name]:
+			case job.kind == barrierJob || earlier.kind == barrierJob:
+			case job.queriesChain && earlier.kind.transacts():
+			default:
+				continue
+			}
+			job.dependencies = append(job.dependencies, j)
+		}
+		jobs[i] = job
+	}
+	return jobs, nil
+}
+
+// The names of the jobs referred to by placeholders anywhere in values, such as the payload of a job
+func placeholderJobNames(values ...interface{}) (map[string]bool, error) {
+	names := make(map[string]bool)
+	for _, value := range values {
+		bs, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		for _, pm := range rule.MatchPlaceholders(string(bs)) {
+			// Reserved as in util.PreProcess
+			if strings.Contains(pm.JobName, "block") {
+				continue
+			}
+			names[pm.JobName] = true
+		}
+	}
+	return names, nil
+}
+
+// Transacting jobs take turns to broadcast in the order they are declared so that sequence numbers, and so contract
+// addresses, do not depend on timing. A job sending a single transaction passes on its turn as soon as that
+// transaction is in the mempool, others when they complete.
+type turns struct {
+	sync.Mutex
+	queue   []*scheduledJob
+	current int
+}
+
+func newTurns(jobs []*scheduledJob) *turns {
+	ts := new(turns)
+	for _, job := range jobs {
+		if job.kind.transacts() {
+			job.turn = make(chan struct{})
+			ts.queue = append(ts.queue, job)
+		}
+	}
+	if len(ts.queue) > 0 {
+		close(ts.queue[0].turn)
+	}
+	return ts
+}
+
+// Passes the turn on from job if it holds it
+func (ts *turns) release(job *scheduledJob) {
+	ts.Lock()
+	defer ts.Unlock()
+	if ts.current < len(ts.queue) && ts.queue[ts.current] == job {
+		ts.advance()
+	}
+}
+
+// Called as each transaction enters the mempool, only the job holding the turn can have sent it
+func (ts *turns) accepted() {
+	ts.Lock()
+	defer ts.Unlock()
+	if ts.current < len(ts.queue) && ts.queue[ts.current].kind == transactJob {
+		ts.advance()
+	}
+}
+
+func (ts *turns) advance() {
+	ts.current++
+	if ts.current < len(ts.queue) {
+		close(ts.queue[ts.current].turn)
+	}
+}
+
+// Runs up to do.Concurrency jobs at once, each as soon as the jobs it depends on have completed. Results are recorded
+// against each job as when running sequentially so output does not depend on the order in which jobs finish.
+func runConcurrently(tracks []*trackJob, do *def.Packages, state *DeployState) error {
+	jobs, err := scheduleJobs(tracks)
+	if err != nil {
+		return err
+	}
+	ts := newTurns(jobs)
+	do.Pipeline(ts.accepted)
+	defer do.Pipeline(nil)
+
+	slots := make(chan struct{}, do.Concurrency)
+	// Closed on the first failure after which no further jobs are started
+	abort := make(chan struct{})
+	var once sync.Once
+	fail := func(jobErr error) {
+		once.Do(func() {
+			err = jobErr
+			close(abort)
+		})
+	}
+	wg := new(sync.WaitGroup)
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job *scheduledJob) {
+			defer wg.Done()
+			for _, dependency := range job.dependencies {
+				select {
+				case <-jobs[dependency].finished:
+				case <-abort:
+					return
+				}
+			}
+			if job.turn != nil {
+				defer ts.release(job)
+				select {
+				case <-job.turn:
+				case <-abort:
+					return
+				}
+			}
+			select {
+			case slots <- struct{}{}:
+			case <-abort:
+				return
+			}
+			defer func() { <-slots }()
+			err := runJob(job.trackJob, do, state)
+			if err != nil {
+				fail(err)
+				return
+			}
+			close(job.finished)
+		}(job)
+	}
+	wg.Wait()
+	return err
+}
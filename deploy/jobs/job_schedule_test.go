@@ -0,0 +1,116 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleJobs(t *testing.T) {
+	const address = "29BFD0C2BAA5A7D7D9F3D2B5F3C3BE04FE7AE6C9"
+	tracks := trackJobs(t,
+		&def.Job{Name: "account", Account: &def.Account{Address: address}},
+		&def.Job{Name: "greeting", Set: &def.Set{Value: "hello"}},
+		&def.Job{Name: "pay", Send: &def.Send{Destination: address, Amount: "1"}},
+		&def.Job{Name: "name", RegisterName: &def.RegisterName{Name: "$greeting", Data: "${pay}"}},
+		&def.Job{Name: "height", Set: &def.Set{Value: "$block"}},
+		&def.Job{Name: "balance", QueryAccount: &def.QueryAccount{Account: address, Field: "balance"}},
+		&def.Job{Name: "farewell", Set: &def.Set{Value: "$greeting.value"}},
+	)
+	jobs, err := scheduleJobs(tracks)
+	require.NoError(t, err)
+
+	var dependencies [][]int
+	var kinds []jobKind
+	for _, job := range jobs {
+		dependencies = append(dependencies, job.dependencies)
+		kinds = append(kinds, job.kind)
+	}
+	assert.Equal(t, []jobKind{barrierJob, localJob, transactJob, transactJob, localJob, queryJob, localJob}, kinds)
+	assert.Equal(t, [][]int{
+		nil,
+		{0},
+		{0},
+		{0, 1, 2},
+		{0},
+		{0, 2, 3},
+		{0, 1},
+	}, dependencies)
+}
+
+func TestKindOf(t *testing.T) {
+	assert.Equal(t, transactJob, kindOf(&def.Deploy{Contract: "storage.sol"}))
+	assert.Equal(t, multiTransactJob, kindOf(&def.Deploy{Contract: "storage.sol", Instance: "all"}))
+	assert.Equal(t, multiTransactJob, kindOf(&def.Deploy{Contract: "storage.sol", Publish: true}))
+}
+
+func TestTurns(t *testing.T) {
+	const address = "29BFD0C2BAA5A7D7D9F3D2B5F3C3BE04FE7AE6C9"
+	jobs, err := scheduleJobs(trackJobs(t,
+		&def.Job{Name: "first", Send: &def.Send{Destination: address}},
+		&def.Job{Name: "restore", RestoreState: &def.RestoreState{FilePath: "state.json"}},
+		&def.Job{Name: "local", Set: &def.Set{Value: "1"}},
+		&def.Job{Name: "last", Send: &def.Send{Destination: address}},
+	))
+	require.NoError(t, err)
+	ts := newTurns(jobs)
+	assert.True(t, closed(jobs[0].turn))
+	assert.False(t, closed(jobs[1].turn))
+	assert.Nil(t, jobs[2].turn)
+
+	// A single transaction passes on the turn when accepted
+	ts.accepted()
+	assert.True(t, closed(jobs[1].turn))
+	// But a job sending many holds it until complete
+	ts.accepted()
+	assert.False(t, closed(jobs[3].turn))
+	// Only the holder can release
+	ts.release(jobs[3])
+	assert.False(t, closed(jobs[3].turn))
+	ts.release(jobs[1])
+	assert.True(t, closed(jobs[3].turn))
+	ts.release(jobs[3])
+	ts.accepted()
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var jobs []*def.Job
+	jobs = append(jobs, &def.Job{Name: "a0", Set: &def.Set{Value: "0"}})
+	for _, name := range []string{"a1", "a2", "a3", "a4", "a5", "a6", "a7"} {
+		jobs = append(jobs, &def.Job{Name: name, Set: &def.Set{Value: "$" + jobs[len(jobs)-1].Name + "+"}})
+	}
+	jobs = append(jobs, &def.Job{Name: "b", Set: &def.Set{Value: "${a7}|${a3}"}})
+	do := &def.Packages{Package: &def.Package{Jobs: jobs}, Concurrency: 4}
+	require.NoError(t, runConcurrently(trackJobs(t, jobs...), do, nil))
+	assert.Equal(t, "0+++++++|0+++", jobs[len(jobs)-1].Result)
+
+	// A failure stops jobs that depend on it
+	jobs = []*def.Job{
+		{Name: "bad", Assert: &def.Assert{Key: "1", Relation: "eq", Value: "2"}},
+		{Name: "after", Set: &def.Set{Value: "$bad"}},
+	}
+	do = &def.Packages{Package: &def.Package{Jobs: jobs}, Concurrency: 4}
+	require.Error(t, runConcurrently(trackJobs(t, jobs...), do, nil))
+	assert.Nil(t, jobs[1].Result)
+}
+
+func trackJobs(t *testing.T, jobs ...*def.Job) []*trackJob {
+	tracks := make([]*trackJob, len(jobs))
+	for i, job := range jobs {
+		payload, err := job.Payload()
+		require.NoError(t, err)
+		tracks[i] = &trackJob{job: job, payload: payload}
+	}
+	return tracks
+}
+
+func closed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
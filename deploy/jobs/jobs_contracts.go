@@ -7,17 +7,23 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/hyperledger/burrow/crypto"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/deploy/verify"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/txs/payload"
 	log "github.com/sirupsen/logrus"
 )
 
+// How many blocks the source published for a contract lasts unless the deploy job says otherwise
+const defaultPublishBlocks = "100000"
+
 func BuildJob(build *def.Build, do *def.Packages, resp *compilers.Response) (result string, err error) {
 	// assemble contract
 	contractPath, err := findContractFile(build.Contract, do.BinPath)
@@ -44,7 +50,7 @@ func BuildJob(build *def.Build, do *def.Packages, resp *compilers.Response) (res
 		binPath = do.BinPath
 	}
 	if _, err := os.Stat(binPath); os.IsNotExist(err) {
-		if err := os.Mkdir(binPath, 0775); err != nil {
+		if err := os.MkdirAll(binPath, 0775); err != nil {
 			return "", err
 		}
 	}
@@ -114,6 +120,10 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 
 	// compile
 	if filepath.Ext(deploy.Contract) == ".bin" {
+		if deploy.Publish {
+			return "", fmt.Errorf("cannot publish the source of binary contract %s, deploy it from source to do so",
+				deploy.Contract)
+		}
 		log.Info("Binary file detected. Using binary deploy sequence.")
 		log.WithField("=>", contractPath).Info("Binary path")
 
@@ -147,6 +157,19 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 		if err != nil {
 			return "", fmt.Errorf("Error finalizing contract deploy from path %s: %v", contractPath, err)
 		}
+		// saving binary at bin/address as for compiled contracts
+		b, err := ioutil.ReadFile(contractPath)
+		if err != nil {
+			return "", err
+		}
+		if err := os.MkdirAll(do.BinPath, 0775); err != nil {
+			return "", err
+		}
+		addressBin := filepath.Join(do.BinPath, result.String())
+		log.WithField("=>", addressBin).Debug("Saving Binary")
+		if err := ioutil.WriteFile(addressBin, b, 0664); err != nil {
+			return "", err
+		}
 		return result.String(), err
 	} else {
 		contractPath = deploy.Contract
@@ -170,7 +193,7 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 			log.WithField("=>", string(response.Binary.Abi)).Info("Abi")
 			log.WithField("=>", response.Binary.Evm.Bytecode.Object).Info("Bin")
 			if response.Binary.Evm.Bytecode.Object != "" {
-				result, err = deployContract(deploy, do, response, libs)
+				result, err = deployContract(deploy, do, response, resp.Version, libs)
 				if err != nil {
 					return "", err
 				}
@@ -182,7 +205,7 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 				if response.Binary.Evm.Bytecode.Object == "" {
 					continue
 				}
-				result, err = deployContract(deploy, do, response, libs)
+				result, err = deployContract(deploy, do, response, resp.Version, libs)
 				if err != nil {
 					return "", err
 				}
@@ -203,7 +226,7 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 				if matchInstanceName(response.Objectname, deploy.Instance) {
 					log.WithField("=>", string(response.Binary.Abi)).Info("Abi")
 					log.WithField("=>", response.Binary.Evm.Bytecode.Object).Info("Bin")
-					result, err = deployContract(deploy, do, response, libs)
+					result, err = deployContract(deploy, do, response, resp.Version, libs)
 					if err != nil {
 						return "", err
 					}
@@ -239,7 +262,8 @@ func findContractFile(contract, binPath string) (string, error) {
 }
 
 // TODO [rj] refactor to remove [contractPath] from functions signature => only used in a single error throw.
-func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse compilers.ResponseItem, libs map[string]string) (string, error) {
+func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse compilers.ResponseItem, version string,
+	libs map[string]string) (string, error) {
 	log.WithField("=>", string(compilersResponse.Binary.Abi)).Debug("Specification (From Compilers)")
 
 	linked, err := compilers.LinkContract(compilersResponse.Binary, libs)
@@ -250,7 +274,7 @@ func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse comp
 
 	// Save
 	if _, err := os.Stat(do.BinPath); os.IsNotExist(err) {
-		if err := os.Mkdir(do.BinPath, 0775); err != nil {
+		if err := os.MkdirAll(do.BinPath, 0775); err != nil {
 			return "", err
 		}
 	}
@@ -301,6 +325,12 @@ func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse comp
 		if err := ioutil.WriteFile(contractName, b, 0664); err != nil {
 			return "", err
 		}
+		if deploy.Publish {
+			err = publishSource(deploy, do, *contractAddress, compilersResponse, version, libs)
+			if err != nil {
+				return "", err
+			}
+		}
 		return contractAddress.String(), nil
 	} else {
 		// we shouldn't reach this point because we should have an error before this.
@@ -308,6 +338,43 @@ func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse comp
 	}
 }
 
+// Publishes the source of the contract deployed at address to the name registry
+func publishSource(deploy *def.Deploy, do *def.Packages, address crypto.Address, contract compilers.ResponseItem,
+	version string, libs map[string]string) error {
+	src, err := verify.NewSource(deploy.Contract, contract, version, false, libs)
+	if err != nil {
+		return err
+	}
+	data, err := src.Encode()
+	if err != nil {
+		return err
+	}
+	blocks, err := do.ParseUint64(useDefault(deploy.PublishBlocks, defaultPublishBlocks))
+	if err != nil {
+		return err
+	}
+	fee, err := do.ParseUint64(deploy.Fee)
+	if err != nil {
+		return err
+	}
+	name := verify.Name(address)
+	log.WithFields(log.Fields{
+		"name":   name,
+		"blocks": blocks,
+	}).Warn("Publishing Contract Source")
+	_, err = registerNameTx(&def.RegisterName{
+		Source: deploy.Source,
+		Name:   name,
+		Data:   data,
+		Amount: strconv.FormatUint(names.NameCostForExpiryIn(name, data, blocks)+fee, 10),
+		Fee:    deploy.Fee,
+	}, do)
+	if err != nil {
+		return fmt.Errorf("could not publish source of %s: %v", contract.Objectname, err)
+	}
+	return nil
+}
+
 func deployTx(do *def.Packages, deploy *def.Deploy, contractName, contractCode string) (*payload.CallTx, error) {
 	// Deploy contract
 	log.WithFields(log.Fields{
@@ -0,0 +1,196 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	log "github.com/sirupsen/logrus"
+)
+
+// How long a wait-for-event job waits when given no timeout
+const DefaultEventTimeout = time.Minute
+
+// Whether the condition of a job, if it has one, holds
+func conditionHolds(job *def.Job, do *def.Packages) (bool, error) {
+	if job.If == nil {
+		return true, nil
+	}
+	// Copied since a job run for each of a list evaluates its condition once for each
+	cond := *job.If
+	err := util.PreProcessFields(&cond, do)
+	if err != nil {
+		return false, err
+	}
+	if cond.Account != "" {
+		cond.Key, err = util.AccountsInfo(cond.Account, cond.Field, do)
+		if err != nil {
+			return false, fmt.Errorf("could not query account for condition of job %s: %v", job.Name, err)
+		}
+	}
+	holds, err := relate(cond.Relation, cond.Key, cond.Value)
+	if err != nil {
+		return false, fmt.Errorf("could not evaluate condition of job %s: %v", job.Name, err)
+	}
+	log.WithField("=>", fmt.Sprintf("%s %s %s", cond.Key, cond.Relation, cond.Value)).Infof("Condition %v", holds)
+	return holds, nil
+}
+
+// The items of a for-each, which is either a list of values or a string holding one, with placeholders replaced
+func forEachItems(forEach interface{}, do *def.Packages) ([]string, error) {
+	switch fe := forEach.(type) {
+	case string:
+		list, err := util.PreProcess(fe, do)
+		if err != nil {
+			return nil, err
+		}
+		return splitList(list), nil
+	case []interface{}:
+		items := make([]string, len(fe))
+		for i, value := range fe {
+			item, err := util.PreProcess(fmt.Sprintf("%v", value), do)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	case []string:
+		return forEachItems(stringsToInterfaces(fe), do)
+	default:
+		return nil, fmt.Errorf("for-each must be a list or a placeholder for one")
+	}
+}
+
+func stringsToInterfaces(strs []string) []interface{} {
+	values := make([]interface{}, len(strs))
+	for i, str := range strs {
+		values[i] = str
+	}
+	return values
+}
+
+// Runs a job once for each item of its for-each that meets its condition, the result of the job being the list of
+// results of each run
+func runForEach(m *trackJob, do *def.Packages, state *DeployState) error {
+	job := m.job
+	items, err := forEachItems(job.ForEach, do)
+	if err != nil {
+		return fmt.Errorf("could not get items of for-each of job %s: %v", job.Name, err)
+	}
+	results := make([]interface{}, 0, len(items))
+	for i, item := range items {
+		// Placeholders for the item refer to the job itself, whose variables change as it runs
+		job.Variables = []*abi.Variable{
+			{Name: "item", Value: item},
+			{Name: "index", Value: strconv.Itoa(i)},
+		}
+		run, err := conditionHolds(job, do)
+		if err != nil {
+			return err
+		}
+		if !run {
+			log.WithField("=>", item).Warn("Skipping item for which condition does not hold")
+			continue
+		}
+		iteration, err := iterationOf(m, i)
+		if err != nil {
+			return err
+		}
+		err = runPayload(iteration, do, state)
+		if err != nil {
+			return fmt.Errorf("job %s failed for item %s: %v", job.Name, item, err)
+		}
+		results = append(results, iteration.job.Result)
+	}
+	job.Result = results
+	job.Variables = nil
+	return nil
+}
+
+// A copy of the job tracked by m to run for the item at index of its for-each, which is named by index so that it is
+// recorded in the deploy state as a job of its own, though forcing m forces it too. Its payload is copied too since
+// placeholders in the payload are replaced before it runs.
+func iterationOf(m *trackJob, index int) (*trackJob, error) {
+	job := *m.job
+	job.Name = fmt.Sprintf("%s[%d]", m.job.Name, index)
+	job.If = nil
+	job.ForEach = nil
+	job.Result = nil
+	job.Variables = nil
+	field, err := job.PayloadField()
+	if err != nil {
+		return nil, err
+	}
+	payload := reflect.New(field.Type().Elem())
+	payload.Elem().Set(field.Elem())
+	field.Set(payload)
+
+	iteration := *m
+	iteration.job = &job
+	iteration.forEach = m.job.Name
+	iteration.payload = payload.Interface().(def.Payload)
+	return &iteration, nil
+}
+
+func WaitForEventJob(wait *def.WaitForEvent, do *def.Packages) (string, []*abi.Variable, error) {
+	timeout := DefaultEventTimeout
+	if wait.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(wait.Timeout)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	start := rpcevents.LatestBound()
+	if wait.From != "" {
+		height, err := strconv.ParseUint(wait.From, 10, 64)
+		if err != nil {
+			return "", nil, err
+		}
+		start = rpcevents.AbsoluteBound(height)
+	}
+	log.WithFields(log.Fields{
+		"query":   wait.Query,
+		"timeout": timeout,
+	}).Info("Waiting for event")
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	stream, err := do.Events().GetEvents(ctx, &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(start, rpcevents.StreamBound()),
+		Query:      wait.Query,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	for {
+		response, err := stream.Recv()
+		if ctx.Err() != nil {
+			return "", nil, fmt.Errorf("no event matching %s within %v", wait.Query, timeout)
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		if len(response.Events) == 0 {
+			continue
+		}
+		ev := response.Events[0]
+		variables := []*abi.Variable{
+			{Name: "height", Value: strconv.FormatUint(ev.Header.Height, 10)},
+			{Name: "txHash", Value: ev.Header.TxHash.String()},
+			{Name: "eventType", Value: ev.Header.EventType.String()},
+		}
+		if ev.Log != nil {
+			variables = append(variables, &abi.Variable{Name: "address", Value: ev.Log.Address.String()})
+		}
+		log.WithField("=>", ev.Header.TxHash).Warn("Event Found")
+		return ev.Header.TxHash.String(), variables, nil
+	}
+}
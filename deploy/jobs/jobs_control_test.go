@@ -0,0 +1,83 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelate(t *testing.T) {
+	for _, c := range []struct {
+		relation, key, value string
+		holds                bool
+	}{
+		{"eq", "a", "a", true},
+		{"!=", "a", "a", false},
+		{"gt", "10", "9", true},
+		{"<=", "10", "9", false},
+		{"contains", "admin, root", "root", true},
+		{"contains", `["admin","root"]`, "root", true},
+		{"excludes", "admin,root", "root", false},
+		{"excludes", "", "root", true},
+	} {
+		holds, err := relate(c.relation, c.key, c.value)
+		require.NoError(t, err)
+		assert.Equal(t, c.holds, holds, "%s %s %s", c.key, c.relation, c.value)
+	}
+	_, err := relate("gt", "a", "1")
+	assert.Error(t, err)
+	_, err = relate("is", "a", "a")
+	assert.Error(t, err)
+}
+
+func TestConditionsAndForEach(t *testing.T) {
+	jobs := []*def.Job{
+		{Name: "list", Set: &def.Set{Value: "a,b,c"}},
+		{Name: "never", If: &def.Condition{Key: "$list", Relation: "excludes", Value: "b"}, Set: &def.Set{Value: "1"}},
+		{Name: "each", ForEach: "$list", If: &def.Condition{Key: "$each.item", Relation: "ne", Value: "b"},
+			Set: &def.Set{Value: "$each.item$each.index"}},
+		{Name: "literal", ForEach: []interface{}{"$never", 2}, Set: &def.Set{Value: "<$literal.item>"}},
+	}
+	do := &def.Packages{Package: &def.Package{Jobs: jobs}}
+	for _, track := range trackJobs(t, jobs...) {
+		require.NoError(t, runJob(track, do, nil))
+	}
+	assert.Equal(t, "", jobs[1].Result)
+	assert.Equal(t, []interface{}{"a0", "c2"}, jobs[2].Result)
+	assert.Equal(t, "$each.item$each.index", jobs[2].Set.Value, "each run should have a copy of the payload")
+	assert.Equal(t, []interface{}{"<>", "<2>"}, jobs[3].Result)
+
+	jobs = []*def.Job{
+		{Name: "bad", If: &def.Condition{Key: "a", Relation: "gt", Value: "1"}, Set: &def.Set{Value: "1"}},
+	}
+	do = &def.Packages{Package: &def.Package{Jobs: jobs}}
+	assert.Error(t, runJob(trackJobs(t, jobs...)[0], do, nil))
+}
+
+func TestScheduleControlFlow(t *testing.T) {
+	const address = "29BFD0C2BAA5A7D7D9F3D2B5F3C3BE04FE7AE6C9"
+	jobs, err := scheduleJobs(trackJobs(t,
+		&def.Job{Name: "payees", Set: &def.Set{Value: address}},
+		&def.Job{Name: "limit", Set: &def.Set{Value: "10"}},
+		&def.Job{Name: "pay", ForEach: "$payees", If: &def.Condition{Key: "$limit", Relation: "gt", Value: "1"},
+			Send: &def.Send{Destination: "$pay.item", Amount: "1"}},
+		&def.Job{Name: "roles", If: &def.Condition{Account: address, Field: "permissions.roles", Relation: "excludes",
+			Value: "admin"}, Set: &def.Set{Value: "none"}},
+		&def.Job{Name: "grant", ForEach: "$payees", If: &def.Condition{Account: "$grant.item", Field: "balance",
+			Relation: "gt", Value: "0"}, Permission: &def.Permission{Action: "setBase", Target: "$grant.item",
+			Permission: "call", Value: "true"}},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, multiTransactJob, jobs[2].kind)
+	assert.False(t, jobs[2].queriesChain)
+	assert.Equal(t, []int{0, 1}, jobs[2].dependencies)
+	assert.Equal(t, localJob, jobs[3].kind)
+	assert.True(t, jobs[3].queriesChain)
+	assert.Equal(t, []int{2}, jobs[3].dependencies)
+	// A transacting job whose condition reads an account waits for earlier transactions to execute too
+	assert.Equal(t, multiTransactJob, jobs[4].kind)
+	assert.True(t, jobs[4].queriesChain)
+	assert.Equal(t, []int{0, 2}, jobs[4].dependencies)
+}
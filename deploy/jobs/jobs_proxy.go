@@ -0,0 +1,480 @@
+package jobs
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	compilers "github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	log "github.com/sirupsen/logrus"
+)
+
+// The proxy keeps the address of its implementation and its admin in the storage slots standardised by EIP-1967, which
+// are chosen so as not to collide with the state variables of any implementation
+var (
+	proxyImplementationSlot = eip1967Slot("eip1967.proxy.implementation")
+	proxyAdminSlot          = eip1967Slot("eip1967.proxy.admin")
+	upgradeToID             = abi.GetFunctionID("upgradeTo(address)")
+	changeAdminID           = abi.GetFunctionID("changeAdmin(address)")
+)
+
+func eip1967Slot(name string) binary.Word256 {
+	hash := sha3.NewKeccak256()
+	hash.Write([]byte(name))
+	slot := new(big.Int).SetBytes(hash.Sum(nil))
+	return binary.LeftPadWord256(slot.Sub(slot, big.NewInt(1)).Bytes())
+}
+
+// Code of the proxy: when called by the admin with upgradeTo(address) or changeAdmin(address) it updates the
+// corresponding slot, otherwise it delegates the call to its implementation and returns or reverts with its output.
+// Note this means the admin cannot call functions of the implementation with either of those signatures.
+var proxyRuntimeCode = bc.MustSplice(
+	// 0: jump to admin functions when called by the admin
+	asm.CALLER, asm.PUSH32, proxyAdminSlot, asm.SLOAD, asm.EQ, asm.PUSH2, 0, 106, asm.JUMPI,
+	// 40: delegate with the call data copied to memory
+	asm.JUMPDEST, asm.CALLDATASIZE, asm.PUSH1, 0, asm.DUP1, asm.CALLDATACOPY,
+	asm.PUSH1, 0, asm.DUP1, asm.CALLDATASIZE, asm.PUSH1, 0,
+	asm.PUSH32, proxyImplementationSlot, asm.SLOAD, asm.GAS, asm.DELEGATECALL,
+	asm.RETURNDATASIZE, asm.PUSH1, 0, asm.DUP1, asm.RETURNDATACOPY,
+	asm.PUSH2, 0, 101, asm.JUMPI,
+	asm.RETURNDATASIZE, asm.PUSH1, 0, asm.REVERT,
+	// 101: success
+	asm.JUMPDEST, asm.RETURNDATASIZE, asm.PUSH1, 0, asm.RETURN,
+	// 106: admin functions by selector, anything else is delegated
+	asm.JUMPDEST, asm.PUSH1, 0, asm.CALLDATALOAD, asm.PUSH1, 0xe0, asm.SHR,
+	asm.DUP1, asm.PUSH4, upgradeToID, asm.EQ, asm.PUSH2, 0, 138, asm.JUMPI,
+	asm.PUSH4, changeAdminID, asm.EQ, asm.PUSH2, 0, 177, asm.JUMPI,
+	asm.PUSH2, 0, 40, asm.JUMP,
+	// 138: upgradeTo(address)
+	asm.JUMPDEST, asm.PUSH1, 4, asm.CALLDATALOAD, asm.PUSH32, proxyImplementationSlot, asm.SSTORE, asm.STOP,
+	// 177: changeAdmin(address)
+	asm.JUMPDEST, asm.PUSH1, 4, asm.CALLDATALOAD, asm.PUSH32, proxyAdminSlot, asm.SSTORE, asm.STOP,
+)
+
+// Code to create a proxy for implementation administered by admin
+func proxyCode(implementation, admin crypto.Address) []byte {
+	const initLength = 95
+	runtimeLength := len(proxyRuntimeCode)
+	argsOffset := initLength + runtimeLength
+	return bc.MustSplice(
+		// Copy the implementation and admin appended to the code into memory and store them
+		asm.PUSH1, 64, asm.PUSH2, argsOffset>>8, argsOffset&0xff, asm.PUSH1, 0, asm.CODECOPY,
+		asm.PUSH1, 0, asm.MLOAD, asm.PUSH32, proxyImplementationSlot, asm.SSTORE,
+		asm.PUSH1, 32, asm.MLOAD, asm.PUSH32, proxyAdminSlot, asm.SSTORE,
+		// Return the runtime code
+		asm.PUSH2, runtimeLength>>8, runtimeLength&0xff, asm.DUP1, asm.PUSH2, 0, initLength, asm.PUSH1, 0,
+		asm.CODECOPY, asm.PUSH1, 0, asm.RETURN,
+		proxyRuntimeCode,
+		implementation.Word256(), admin.Word256(),
+	)
+}
+
+func ProxyDeployJob(proxy *def.ProxyDeploy, do *def.Packages, resp *compilers.Response) (string, []*abi.Variable, error) {
+	// Use defaults
+	proxy.Source = useDefault(proxy.Source, do.Package.Account)
+	proxy.Admin = useDefault(proxy.Admin, proxy.Source)
+	proxy.Amount = useDefault(proxy.Amount, do.DefaultAmount)
+	proxy.Fee = useDefault(proxy.Fee, do.DefaultFee)
+	proxy.Gas = useDefault(proxy.Gas, do.DefaultGas)
+
+	implementation, contract, err := deployImplementation(&def.Deploy{
+		Source:    proxy.Source,
+		Contract:  proxy.Contract,
+		Instance:  proxy.Instance,
+		Libraries: proxy.Libraries,
+		Data:      proxy.Data,
+		Amount:    proxy.Amount,
+		Fee:       proxy.Fee,
+		Gas:       proxy.Gas,
+	}, do, resp)
+	if err != nil {
+		return "", nil, err
+	}
+
+	arg := &def.CallArg{
+		Input:  proxy.Source,
+		Amount: proxy.Amount,
+		Fee:    proxy.Fee,
+		Gas:    proxy.Gas,
+	}
+	var address crypto.Address
+	if proxy.Proxy == "" {
+		admin, err := crypto.AddressFromHexString(proxy.Admin)
+		if err != nil {
+			return "", nil, fmt.Errorf("proxy admin %s is not an address: %v", proxy.Admin, err)
+		}
+		log.WithField("implementation", implementation).Warn("Deploying Proxy")
+		arg.Data = hex.EncodeToString(proxyCode(implementation, admin))
+		tx, err := do.Call(arg)
+		if err != nil {
+			return "", nil, err
+		}
+		deployed, err := deployFinalize(do, tx)
+		if err != nil {
+			return "", nil, fmt.Errorf("error finalizing proxy deploy: %v", err)
+		}
+		address = *deployed
+	} else {
+		address, err = crypto.AddressFromHexString(proxy.Proxy)
+		if err != nil {
+			return "", nil, err
+		}
+		err = upgradeProxy(do, arg, address, implementation, contract, proxy.UnsafeSkipStorageCheck)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	err = initProxy(do, arg, address, contract, proxy.InitFunction, proxy.InitData)
+	if err != nil {
+		return "", nil, err
+	}
+
+	admin, err := proxySlot(do, address, proxyAdminSlot)
+	if err != nil {
+		return "", nil, err
+	}
+	return address.String(), []*abi.Variable{
+		{Name: "implementation", Value: implementation.String()},
+		{Name: "admin", Value: admin.String()},
+	}, nil
+}
+
+func UpgradeJob(upgrade *def.Upgrade, do *def.Packages, resp *compilers.Response) (string, error) {
+	// Use defaults
+	upgrade.Source = useDefault(upgrade.Source, do.Package.Account)
+	upgrade.Amount = useDefault(upgrade.Amount, do.DefaultAmount)
+	upgrade.Fee = useDefault(upgrade.Fee, do.DefaultFee)
+	upgrade.Gas = useDefault(upgrade.Gas, do.DefaultGas)
+
+	address, err := crypto.AddressFromHexString(upgrade.Proxy)
+	if err != nil {
+		return "", err
+	}
+
+	var implementation crypto.Address
+	var contract *compilers.SolidityOutputContract
+	if upgrade.Contract != "" {
+		implementation, contract, err = deployImplementation(&def.Deploy{
+			Source:    upgrade.Source,
+			Contract:  upgrade.Contract,
+			Instance:  upgrade.Instance,
+			Libraries: upgrade.Libraries,
+			Data:      upgrade.Data,
+			Amount:    upgrade.Amount,
+			Fee:       upgrade.Fee,
+			Gas:       upgrade.Gas,
+		}, do, resp)
+	} else {
+		implementation, err = crypto.AddressFromHexString(upgrade.Implementation)
+		if err != nil {
+			return "", err
+		}
+		contract, err = readContractOutput(do.BinPath, implementation)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	arg := &def.CallArg{
+		Input:  upgrade.Source,
+		Amount: upgrade.Amount,
+		Fee:    upgrade.Fee,
+		Gas:    upgrade.Gas,
+	}
+	err = upgradeProxy(do, arg, address, implementation, contract, upgrade.UnsafeSkipStorageCheck)
+	if err != nil {
+		return "", err
+	}
+	err = initProxy(do, arg, address, contract, upgrade.InitFunction, upgrade.InitData)
+	if err != nil {
+		return "", err
+	}
+	return implementation.String(), nil
+}
+
+// Deploys an implementation returning its address along with its compiler output
+func deployImplementation(deploy *def.Deploy, do *def.Packages,
+	resp *compilers.Response) (crypto.Address, *compilers.SolidityOutputContract, error) {
+
+	result, err := DeployJob(deploy, do, resp)
+	if err != nil {
+		return crypto.ZeroAddress, nil, err
+	}
+	implementation, err := crypto.AddressFromHexString(result)
+	if err != nil {
+		return crypto.ZeroAddress, nil, fmt.Errorf("no implementation contract %s deployed from %s",
+			deploy.Instance, deploy.Contract)
+	}
+	contract, err := readContractOutput(do.BinPath, implementation)
+	if err != nil {
+		return crypto.ZeroAddress, nil, err
+	}
+	return implementation, contract, nil
+}
+
+// Points proxy at implementation after checking that we are its admin and that implementation lays out storage in
+// the same way as the implementation it replaces
+func upgradeProxy(do *def.Packages, arg *def.CallArg, proxy, implementation crypto.Address,
+	contract *compilers.SolidityOutputContract, skipStorageCheck bool) error {
+
+	admin, err := proxySlot(do, proxy, proxyAdminSlot)
+	if err != nil {
+		return err
+	}
+	if admin == crypto.ZeroAddress {
+		return fmt.Errorf("%v is not a proxy deployed by a proxy-deploy job", proxy)
+	}
+	if source, err := crypto.AddressFromHexString(arg.Input); err != nil || source != admin {
+		return fmt.Errorf("only the admin %v of proxy %v can upgrade it, not %s", admin, proxy, arg.Input)
+	}
+	current, err := proxySlot(do, proxy, proxyImplementationSlot)
+	if err != nil {
+		return err
+	}
+
+	if skipStorageCheck {
+		log.WithField("=>", proxy).Warn("Not checking storage layout of new implementation")
+	} else {
+		previous, err := readContractOutput(do.BinPath, current)
+		if err != nil {
+			return fmt.Errorf("cannot check storage layout of current implementation %v of proxy %v "+
+				"(set unsafe-skip-storage-check to upgrade regardless): %v", current, proxy, err)
+		}
+		err = checkStorageLayout(previous.StorageLayout, contract.StorageLayout)
+		if err != nil {
+			return fmt.Errorf("storage layout of %v is incompatible with that of current implementation %v of "+
+				"proxy %v (set unsafe-skip-storage-check to upgrade regardless): %v", implementation, current, proxy, err)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"proxy":          proxy,
+		"implementation": implementation,
+	}).Warn("Upgrading Proxy")
+	upgradeArg := *arg
+	upgradeArg.Address = proxy.String()
+	upgradeArg.Data = hex.EncodeToString(bc.MustSplice(upgradeToID, implementation.Word256()))
+	tx, err := do.Call(&upgradeArg)
+	if err != nil {
+		return err
+	}
+	txe, err := do.SignAndBroadcast(tx)
+	if err != nil {
+		return util.ChainErrorHandler(do, err)
+	}
+	err = util.ReadTxSignAndBroadcast(txe, err)
+	if err != nil {
+		return err
+	}
+	upgraded, err := proxySlot(do, proxy, proxyImplementationSlot)
+	if err != nil {
+		return err
+	}
+	if upgraded != implementation {
+		return fmt.Errorf("proxy %v still points at %v after upgrading to %v", proxy, upgraded, implementation)
+	}
+	return nil
+}
+
+// Calls function of the implementation through the proxy, if given, and saves the implementation's ABI against the
+// proxy so later jobs can call it
+func initProxy(do *def.Packages, arg *def.CallArg, proxy crypto.Address, contract *compilers.SolidityOutputContract,
+	function string, data interface{}) error {
+
+	err := saveContractOutput(do.BinPath, proxy, contract)
+	if err != nil {
+		return err
+	}
+	if function == "" {
+		return nil
+	}
+	function, args, err := util.PreProcessInputData(function, data, do, false)
+	if err != nil {
+		return err
+	}
+	packedBytes, err := abi.ReadAbiFormulateCall(contract.Abi, function, args)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"proxy":    proxy,
+		"function": function,
+	}).Warn("Initialising Proxy")
+	initArg := *arg
+	initArg.Address = proxy.String()
+	initArg.Data = hex.EncodeToString(packedBytes)
+	tx, err := do.Call(&initArg)
+	if err != nil {
+		return err
+	}
+	txe, err := do.SignAndBroadcast(tx)
+	if err != nil {
+		return util.ChainErrorHandler(do, err)
+	}
+	return util.ReadTxSignAndBroadcast(txe, err)
+}
+
+// The address held in one of the proxy's slots
+func proxySlot(do *def.Packages, proxy crypto.Address, slot binary.Word256) (crypto.Address, error) {
+	stream, err := do.Query().ListStorage(context.Background(), &rpcquery.ListStorageParam{
+		Address:   proxy,
+		KeyPrefix: slot.Bytes(),
+		Limit:     1,
+	})
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	item, err := stream.Recv()
+	if err == io.EOF {
+		return crypto.ZeroAddress, nil
+	}
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	return crypto.AddressFromWord256(binary.LeftPadWord256(item.Value)), nil
+}
+
+// Reads the compiler output saved against the address of a contract when it was deployed
+func readContractOutput(binPath string, address crypto.Address) (*compilers.SolidityOutputContract, error) {
+	bs, err := ioutil.ReadFile(filepath.Join(binPath, address.String()))
+	if err != nil {
+		return nil, fmt.Errorf("could not find compiler output for contract %v: %v", address, err)
+	}
+	contract := new(compilers.SolidityOutputContract)
+	err = json.Unmarshal(bs, contract)
+	if err != nil {
+		return nil, fmt.Errorf("could not read compiler output for contract %v: %v", address, err)
+	}
+	return contract, nil
+}
+
+func saveContractOutput(binPath string, address crypto.Address, contract *compilers.SolidityOutputContract) error {
+	bs, err := json.Marshal(contract)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(binPath, address.String()), bs, 0664)
+}
+
+// The storage layout output by solc
+type storageLayout struct {
+	Storage []storageVariable
+	Types   map[string]*storageType
+}
+
+type storageVariable struct {
+	Label  string
+	Offset int
+	Slot   string
+	Type   string
+}
+
+type storageType struct {
+	Label         string
+	Encoding      string
+	NumberOfBytes string
+	// Mappings
+	Key   string
+	Value string
+	// Arrays
+	Base string
+	// Structs
+	Members []storageVariable
+}
+
+// Checks that every state variable of previous is kept at the same place with the same type by next, which may add
+// further variables after them
+func checkStorageLayout(previous, next json.RawMessage) error {
+	if len(previous) == 0 || len(next) == 0 {
+		return fmt.Errorf("storage layout is missing from compiler output, it requires solc 0.5.13 or later")
+	}
+	before, after := new(storageLayout), new(storageLayout)
+	err := json.Unmarshal(previous, before)
+	if err != nil {
+		return fmt.Errorf("could not read storage layout: %v", err)
+	}
+	err = json.Unmarshal(next, after)
+	if err != nil {
+		return fmt.Errorf("could not read storage layout: %v", err)
+	}
+	lc := &layoutComparison{before: before.Types, after: after.Types, compared: make(map[[2]string]bool)}
+	return lc.variables(before.Storage, after.Storage, "")
+}
+
+type layoutComparison struct {
+	before   map[string]*storageType
+	after    map[string]*storageType
+	compared map[[2]string]bool
+}
+
+// Compares variables declared at the top level or, with prefix, as members of a struct
+func (lc *layoutComparison) variables(before, after []storageVariable, prefix string) error {
+	for i, b := range before {
+		if i >= len(after) {
+			return fmt.Errorf("%s %s%s is no longer declared", lc.label(lc.before, b.Type), prefix, b.Label)
+		}
+		a := after[i]
+		if a.Label != b.Label || a.Slot != b.Slot || a.Offset != b.Offset {
+			return fmt.Errorf("%s %s%s at slot %s offset %d is replaced by %s %s%s at slot %s offset %d",
+				lc.label(lc.before, b.Type), prefix, b.Label, b.Slot, b.Offset,
+				lc.label(lc.after, a.Type), prefix, a.Label, a.Slot, a.Offset)
+		}
+		err := lc.types(b.Type, a.Type, prefix+b.Label)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Compares the types of variable along with any types they are made up of
+func (lc *layoutComparison) types(before, after, variable string) error {
+	pair := [2]string{before, after}
+	if lc.compared[pair] {
+		return nil
+	}
+	lc.compared[pair] = true
+	b, a := lc.before[before], lc.after[after]
+	if b == nil || a == nil {
+		if before != after {
+			return fmt.Errorf("%s has changed type from %s to %s", variable, before, after)
+		}
+		return nil
+	}
+	if a.Label != b.Label || a.Encoding != b.Encoding || a.NumberOfBytes != b.NumberOfBytes {
+		return fmt.Errorf("%s has changed type from %s to %s", variable, b.Label, a.Label)
+	}
+	for _, err := range []error{
+		lc.types(b.Key, a.Key, variable),
+		lc.types(b.Value, a.Value, variable),
+		lc.types(b.Base, a.Base, variable),
+		lc.variables(b.Members, a.Members, variable+"."),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lc *layoutComparison) label(types map[string]*storageType, id string) string {
+	if t := types[id]; t != nil {
+		return t.Label
+	}
+	return id
+}
@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const layoutTypes = `"types":{
+  "t_uint256":{"encoding":"inplace","label":"uint256","numberOfBytes":"32"},
+  "t_int256":{"encoding":"inplace","label":"int256","numberOfBytes":"32"},
+  "t_address":{"encoding":"inplace","label":"address","numberOfBytes":"20"},
+  "t_bool":{"encoding":"inplace","label":"bool","numberOfBytes":"1"},
+  "t_mapping(t_address,t_struct(Account)12_storage)":{"encoding":"mapping","key":"t_address",
+    "label":"mapping(address => struct C.Account)","numberOfBytes":"32","value":"t_struct(Account)12_storage"},
+  "t_mapping(t_address,t_struct(Account)15_storage)":{"encoding":"mapping","key":"t_address",
+    "label":"mapping(address => struct C.Account)","numberOfBytes":"32","value":"t_struct(Account)15_storage"},
+  "t_mapping(t_address,t_struct(Account)20_storage)":{"encoding":"mapping","key":"t_address",
+    "label":"mapping(address => struct C.Account)","numberOfBytes":"32","value":"t_struct(Account)20_storage"},
+  "t_struct(Account)20_storage":{"encoding":"inplace","label":"struct C.Account","numberOfBytes":"32","members":[
+    {"label":"balance","offset":0,"slot":"0","type":"t_uint256"}]},
+  "t_struct(Account)12_storage":{"encoding":"inplace","label":"struct C.Account","numberOfBytes":"32","members":[
+    {"label":"balance","offset":0,"slot":"0","type":"t_uint256"}]},
+  "t_struct(Account)15_storage":{"encoding":"inplace","label":"struct C.Account","numberOfBytes":"32","members":[
+    {"label":"balance","offset":0,"slot":"0","type":"t_int256"}]}
+}`
+
+func TestCheckStorageLayout(t *testing.T) {
+	previous := layout(`
+	  {"label":"owner","offset":0,"slot":"0","type":"t_address"},
+	  {"label":"paused","offset":20,"slot":"0","type":"t_bool"},
+	  {"label":"accounts","offset":0,"slot":"1","type":"t_mapping(t_address,t_struct(Account)12_storage)"}`)
+
+	// Appending variables is fine, as are different AST ids in type identifiers
+	require.NoError(t, checkStorageLayout(previous, layout(`
+	  {"label":"owner","offset":0,"slot":"0","type":"t_address"},
+	  {"label":"paused","offset":20,"slot":"0","type":"t_bool"},
+	  {"label":"accounts","offset":0,"slot":"1","type":"t_mapping(t_address,t_struct(Account)20_storage)"},
+	  {"label":"total","offset":0,"slot":"2","type":"t_uint256"}`)))
+
+	for next, message := range map[string]string{
+		`{"label":"owner","offset":0,"slot":"0","type":"t_address"},
+		 {"label":"paused","offset":20,"slot":"0","type":"t_bool"}`: "mapping(address => struct C.Account) accounts is no longer declared",
+		`{"label":"owner","offset":0,"slot":"0","type":"t_address"},
+		 {"label":"total","offset":0,"slot":"1","type":"t_uint256"},
+		 {"label":"paused","offset":0,"slot":"2","type":"t_bool"}`: "bool paused at slot 0 offset 20 is replaced by uint256 total at slot 1 offset 0",
+		`{"label":"admin","offset":0,"slot":"0","type":"t_address"}`: "address owner at slot 0 offset 0 is replaced by address admin at slot 0 offset 0",
+		`{"label":"owner","offset":0,"slot":"0","type":"t_uint256"}`: "owner has changed type from address to uint256",
+		`{"label":"owner","offset":0,"slot":"0","type":"t_address"},
+		 {"label":"paused","offset":20,"slot":"0","type":"t_bool"},
+		 {"label":"accounts","offset":0,"slot":"1","type":"t_mapping(t_address,t_struct(Account)15_storage)"}`: "accounts.balance has changed type from uint256 to int256",
+	} {
+		err := checkStorageLayout(previous, layout(next))
+		if assert.Error(t, err) {
+			assert.Equal(t, message, err.Error())
+		}
+	}
+
+	assert.Error(t, checkStorageLayout(nil, previous), "layout missing for older compilers")
+}
+
+func layout(storage string) json.RawMessage {
+	return json.RawMessage(`{"storage":[` + storage + `],` + layoutTypes + `}`)
+}
@@ -1,17 +1,364 @@
 package jobs
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs/payload"
+	log "github.com/sirupsen/logrus"
 )
 
+// Storage is restored in batches to keep each GovTx a reasonable size
+const storageItemsPerGovTx = 256
+
+// Number of accounts, storage items, or names requested at a time when dumping state, which needs to be within the
+// MaxResults limit of the node
+const listPageSize = 100
+
+// A StateSnapshot is a portable record of accounts, their code and storage, and names written by dump-state and
+// replayed into a chain by restore-state
+type StateSnapshot struct {
+	ChainID    string
+	Height     uint64
+	Accounts   []*AccountSnapshot
+	Names      []*names.Entry         `json:",omitempty"`
+	Validators []*validator.Validator `json:",omitempty"`
+}
+
+type AccountSnapshot struct {
+	Address crypto.Address
+	// Absent for contracts and accounts whose key is not yet known
+	PublicKey   *crypto.PublicKey `json:",omitempty"`
+	Balance     uint64
+	Code        acm.Bytecode        `json:",omitempty"`
+	Permissions []string            `json:",omitempty"`
+	Roles       []string            `json:",omitempty"`
+	Storage     []*spec.StorageItem `json:",omitempty"`
+}
+
 func DumpStateJob(dump *def.DumpState, do *def.Packages) (string, error) {
-	var result string
+	status, err := do.Status()
+	if err != nil {
+		return "", err
+	}
+	snapshot := &StateSnapshot{
+		ChainID: status.ChainID,
+		Height:  status.GetSyncInfo().GetLatestBlockHeight(),
+	}
 
-	return result, nil
+	var accounts []*acm.ConcreteAccount
+	if len(dump.Accounts) == 0 {
+		accounts, err = listAccounts(do)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		for _, accountString := range dump.Accounts {
+			accountString, err = util.PreProcess(accountString, do)
+			if err != nil {
+				return "", err
+			}
+			address, err := crypto.AddressFromHexString(accountString)
+			if err != nil {
+				return "", fmt.Errorf("could not parse address of account to dump: %v", err)
+			}
+			account, err := do.GetAccount(address)
+			if err != nil {
+				return "", err
+			}
+			if account == nil {
+				return "", fmt.Errorf("account %v to dump does not exist", address)
+			}
+			accounts = append(accounts, account)
+		}
+	}
+
+	for _, account := range accounts {
+		storage, err := listStorage(do, account.Address)
+		if err != nil {
+			return "", err
+		}
+		snapshot.Accounts = append(snapshot.Accounts, SnapshotAccount(account, storage))
+	}
+
+	if dump.WithNames {
+		snapshot.Names, err = listNames(do)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if dump.WithValidators {
+		validators, err := do.GetValidatorSet()
+		if err != nil {
+			return "", err
+		}
+		snapshot.Validators = validators.Set
+	}
+
+	bs, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	err = ioutil.WriteFile(dump.FilePath, bs, 0644)
+	if err != nil {
+		return "", err
+	}
+
+	log.WithFields(log.Fields{
+		"file":     dump.FilePath,
+		"accounts": len(snapshot.Accounts),
+		"names":    len(snapshot.Names),
+		"height":   snapshot.Height,
+	}).Warn("Dumped state")
+
+	return dump.FilePath, nil
 }
 
 func RestoreStateJob(restore *def.RestoreState, do *def.Packages) (string, error) {
-	var result string
+	restore.Source = useDefault(restore.Source, do.Package.Account)
+	restore.Amount = useDefault(restore.Amount, do.DefaultAmount)
+
+	bs, err := ioutil.ReadFile(restore.FilePath)
+	if err != nil {
+		return "", err
+	}
+	snapshot := new(StateSnapshot)
+	err = json.Unmarshal(bs, snapshot)
+	if err != nil {
+		return "", fmt.Errorf("could not read state snapshot from %s: %v", restore.FilePath, err)
+	}
+
+	for _, update := range snapshot.AccountUpdates() {
+		err = govern(do, restore.Source, update)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for _, entry := range snapshot.Names {
+		_, err = registerNameTx(&def.RegisterName{
+			Source: restore.Source,
+			Name:   entry.Name,
+			Data:   entry.Data,
+			Amount: restore.Amount,
+		}, do)
+		if err != nil {
+			return "", fmt.Errorf("could not restore name %s: %v", entry.Name, err)
+		}
+	}
 
+	result := fmt.Sprintf("restored %d accounts, %d names, and %d validators from %s at height %d",
+		len(snapshot.Accounts), len(snapshot.Names), len(snapshot.Validators), snapshot.ChainID, snapshot.Height)
+	log.Warn(result)
 	return result, nil
 }
+
+// SnapshotAccount records account and its storage
+func SnapshotAccount(account *acm.ConcreteAccount, storage []*spec.StorageItem) *AccountSnapshot {
+	snapshot := &AccountSnapshot{
+		Address:     account.Address,
+		Balance:     account.Balance,
+		Code:        account.Code,
+		Permissions: permission.BasePermissionsToStringList(account.Permissions.Base),
+		Roles:       account.Permissions.Roles,
+		Storage:     storage,
+	}
+	// A rekeyed account's key cannot be given to GovTx since it does not match the address
+	if account.PublicKey.IsSet() && account.PublicKey.Address() == account.Address {
+		publicKey := account.PublicKey
+		snapshot.PublicKey = &publicKey
+	}
+	return snapshot
+}
+
+// AccountUpdates returns the GovTx account updates that restore the snapshot, an account with more storage than fits
+// in one update is followed by further updates carrying the remainder of its storage
+func (snapshot *StateSnapshot) AccountUpdates() []*spec.TemplateAccount {
+	var updates []*spec.TemplateAccount
+	for _, account := range snapshot.Accounts {
+		address := account.Address
+		update := &spec.TemplateAccount{
+			Address:     &address,
+			PublicKey:   account.PublicKey,
+			Amounts:     balance.New().Native(account.Balance),
+			Permissions: account.Permissions,
+			Roles:       account.Roles,
+		}
+		if len(account.Code) > 0 {
+			code := account.Code
+			update.Code = &code
+		}
+		storage := account.Storage
+		for {
+			n := len(storage)
+			if n > storageItemsPerGovTx {
+				n = storageItemsPerGovTx
+			}
+			update.Storage = storage[:n]
+			updates = append(updates, update)
+			storage = storage[n:]
+			if len(storage) == 0 {
+				break
+			}
+			update = &spec.TemplateAccount{Address: &address}
+		}
+	}
+	for _, val := range snapshot.Validators {
+		publicKey := val.PublicKey
+		updates = append(updates, &spec.TemplateAccount{
+			PublicKey: &publicKey,
+			Amounts:   balance.New().Power(val.Power),
+		})
+	}
+	return updates
+}
+
+func govern(do *def.Packages, source string, update *spec.TemplateAccount) error {
+	input, err := do.TxInput(source, "", "")
+	if err != nil {
+		return err
+	}
+	txe, err := do.SignAndBroadcast(&payload.GovTx{
+		Inputs:         []*payload.TxInput{input},
+		AccountUpdates: []*spec.TemplateAccount{update},
+	})
+	if err != nil {
+		return util.ChainErrorHandler(do, err)
+	}
+	return util.ReadTxSignAndBroadcast(txe, err)
+}
+
+func listAccounts(do *def.Packages) ([]*acm.ConcreteAccount, error) {
+	var accounts []*acm.ConcreteAccount
+	var start crypto.Address
+	err := listInPages(func() (more bool, err error) {
+		stream, err := do.Query().ListAccounts(context.Background(),
+			&rpcquery.ListAccountsParam{Start: start, Limit: listPageSize})
+		if err != nil {
+			return false, err
+		}
+		count := 0
+		for {
+			account, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return false, err
+			}
+			count++
+			start = account.Address
+			// The global permissions belong to the chain being restored into
+			if account.Address != acm.GlobalPermissionsAddress {
+				accounts = append(accounts, account)
+			}
+		}
+		if count < listPageSize {
+			return false, nil
+		}
+		start, more = nextAddress(start)
+		return more, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func listStorage(do *def.Packages, address crypto.Address) ([]*spec.StorageItem, error) {
+	var storage []*spec.StorageItem
+	var start binary.HexBytes
+	err := listInPages(func() (more bool, err error) {
+		stream, err := do.Query().ListStorage(context.Background(),
+			&rpcquery.ListStorageParam{Address: address, Start: start, Limit: listPageSize})
+		if err != nil {
+			return false, err
+		}
+		count := 0
+		for {
+			item, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return false, err
+			}
+			count++
+			storage = append(storage, &spec.StorageItem{Key: item.Key, Value: item.Value})
+			// Appending a zero byte gives the first key after this one
+			start = append(item.Key[:len(item.Key):len(item.Key)], 0)
+		}
+		return count == listPageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return storage, nil
+}
+
+func listNames(do *def.Packages) ([]*names.Entry, error) {
+	var entries []*names.Entry
+	var start string
+	err := listInPages(func() (more bool, err error) {
+		stream, err := do.Query().ListNames(context.Background(),
+			&rpcquery.ListNamesParam{Start: start, Limit: listPageSize})
+		if err != nil {
+			return false, err
+		}
+		count := 0
+		for {
+			entry, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return false, err
+			}
+			count++
+			entries = append(entries, entry)
+			start = entry.Name + "\x00"
+		}
+		return count == listPageSize, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Calls listPage, which lists the page following the one it last listed, for as long as it reports there may be more.
+// Paging keeps each call within the result limits a node may impose on listings.
+func listInPages(listPage func() (more bool, err error)) error {
+	for {
+		more, err := listPage()
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+// The address following address in address order, if there is one
+func nextAddress(address crypto.Address) (crypto.Address, bool) {
+	for i := len(address) - 1; i >= 0; i-- {
+		address[i]++
+		if address[i] != 0 {
+			return address, true
+		}
+	}
+	return address, false
+}
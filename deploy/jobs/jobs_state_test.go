@@ -0,0 +1,88 @@
+package jobs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateSnapshotAccountUpdates(t *testing.T) {
+	user := acm.GeneratePrivateAccountFromSecret("user")
+	userAccount := acm.AsConcreteAccount(acm.ConcreteAccount{
+		Address:     user.Address(),
+		PublicKey:   user.PublicKey(),
+		Balance:     1337,
+		Permissions: permission.NewAccountPermissions(permission.Send, permission.Call),
+	}.Account())
+	contract := acm.GeneratePrivateAccountFromSecret("contract")
+	contractAccount := &acm.ConcreteAccount{
+		Address: contract.Address(),
+		Code:    acm.Bytecode{0x60, 0x01},
+	}
+	var storage []*spec.StorageItem
+	for i := 0; i < storageItemsPerGovTx+3; i++ {
+		storage = append(storage, &spec.StorageItem{
+			Key:   binary.Int64ToWord256(int64(i)).Bytes(),
+			Value: binary.Int64ToWord256(int64(i * i)).Bytes(),
+		})
+	}
+
+	snapshot := &StateSnapshot{
+		ChainID: "dumped",
+		Accounts: []*AccountSnapshot{
+			SnapshotAccount(userAccount, nil),
+			SnapshotAccount(contractAccount, storage),
+		},
+	}
+	// Survives being written to file
+	bs, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	snapshot = new(StateSnapshot)
+	require.NoError(t, json.Unmarshal(bs, snapshot))
+
+	updates := snapshot.AccountUpdates()
+	require.Len(t, updates, 3)
+
+	assert.Equal(t, user.Address(), *updates[0].Address)
+	require.NotNil(t, updates[0].PublicKey)
+	assert.Equal(t, user.PublicKey(), *updates[0].PublicKey)
+	assert.Equal(t, uint64(1337), updates[0].Balances().GetNative(0))
+	assert.Equal(t, []string{"send", "call"}, updates[0].Permissions)
+	assert.Nil(t, updates[0].Code)
+
+	assert.Equal(t, contract.Address(), *updates[1].Address)
+	assert.Nil(t, updates[1].PublicKey)
+	require.NotNil(t, updates[1].Code)
+	assert.Equal(t, contractAccount.Code, *updates[1].Code)
+	assert.Len(t, updates[1].Storage, storageItemsPerGovTx)
+
+	// The rest of the contract's storage follows alone
+	assert.Equal(t, contract.Address(), *updates[2].Address)
+	assert.Nil(t, updates[2].Code)
+	assert.False(t, updates[2].Balances().HasNative())
+	assert.Equal(t, storage[storageItemsPerGovTx:], updates[2].Storage)
+}
+
+func TestNextAddress(t *testing.T) {
+	next, ok := nextAddress(crypto.Address{})
+	assert.True(t, ok)
+	assert.Equal(t, crypto.Address{19: 1}, next)
+
+	next, ok = nextAddress(crypto.Address{18: 1, 19: 0xff})
+	assert.True(t, ok)
+	assert.Equal(t, crypto.Address{18: 2}, next)
+
+	var last crypto.Address
+	for i := range last {
+		last[i] = 0xff
+	}
+	_, ok = nextAddress(last)
+	assert.False(t, ok)
+}
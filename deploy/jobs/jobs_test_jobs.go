@@ -2,8 +2,10 @@ package jobs
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
@@ -123,8 +125,6 @@ func QueryValsJob(query *def.QueryVals, do *def.Packages) (interface{}, error) {
 }
 
 func AssertJob(assertion *def.Assert, do *def.Packages) (string, error) {
-	var result string
-
 	// Switch on relation
 	log.WithFields(log.Fields{
 		"key":      assertion.Key,
@@ -132,69 +132,76 @@ func AssertJob(assertion *def.Assert, do *def.Packages) (string, error) {
 		"value":    assertion.Value,
 	}).Info("Assertion =>")
 
-	switch assertion.Relation {
+	holds, err := relate(assertion.Relation, assertion.Key, assertion.Value)
+	if err != nil {
+		return "", err
+	}
+	if holds {
+		return assertPass(assertion.Relation, assertion.Key, assertion.Value)
+	}
+	return assertFail(assertion.Relation, assertion.Key, assertion.Value)
+}
+
+// Whether key is in relation to value, as integers for orderings and as a list for contains and excludes
+func relate(relation, key, value string) (bool, error) {
+	switch relation {
 	case "==", "eq":
-		/*log.Debug("Compare", strings.Compare(assertion.Key, assertion.Value))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.ValidString(assertion.Value))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Key))
-		log.Debug("UTF8?: ", utf8.RuneCountInString(assertion.Value))*/
-		if assertion.Key == assertion.Value {
-			return assertPass("==", assertion.Key, assertion.Value)
-		} else {
-			return assertFail("==", assertion.Key, assertion.Value)
-		}
+		return key == value, nil
 	case "!=", "ne":
-		if assertion.Key != assertion.Value {
-			return assertPass("!=", assertion.Key, assertion.Value)
-		} else {
-			return assertFail("!=", assertion.Key, assertion.Value)
-		}
+		return key != value, nil
+	case "contains":
+		return listContains(key, value), nil
+	case "excludes":
+		return !listContains(key, value), nil
+	case ">", "gt", ">=", "ge", "<", "lt", "<=", "le":
+	default:
+		return false, fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", relation)
+	}
+
+	k, v, err := bulkConvert(key, value)
+	if err != nil {
+		return false, fmt.Errorf("The Key of your assertion cannot be converted into an integer.\nFor string conversions please use the equal or not equal relations.")
+	}
+	switch relation {
 	case ">", "gt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k > v {
-			return assertPass(">", assertion.Key, assertion.Value)
-		} else {
-			return assertFail(">", assertion.Key, assertion.Value)
-		}
+		return k > v, nil
 	case ">=", "ge":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k >= v {
-			return assertPass(">=", assertion.Key, assertion.Value)
-		} else {
-			return assertFail(">=", assertion.Key, assertion.Value)
-		}
+		return k >= v, nil
 	case "<", "lt":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k < v {
-			return assertPass("<", assertion.Key, assertion.Value)
-		} else {
-			return assertFail("<", assertion.Key, assertion.Value)
-		}
-	case "<=", "le":
-		k, v, err := bulkConvert(assertion.Key, assertion.Value)
-		if err != nil {
-			return convFail()
-		}
-		if k <= v {
-			return assertPass("<=", assertion.Key, assertion.Value)
-		} else {
-			return assertFail("<=", assertion.Key, assertion.Value)
-		}
+		return k < v, nil
 	default:
-		return "", fmt.Errorf("Error: Bad assert relation: \"%s\" is not a valid relation. See documentation for more information.", assertion.Relation)
+		return k <= v, nil
 	}
+}
 
-	return result, nil
+func listContains(list, value string) bool {
+	for _, element := range splitList(list) {
+		if element == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Splits a JSON list, like the result of a query-vals job, or otherwise a comma-separated list, like the roles given
+// by a query-account job, into its elements
+func splitList(list string) []string {
+	var elements []interface{}
+	if strings.HasPrefix(strings.TrimSpace(list), "[") && json.Unmarshal([]byte(list), &elements) == nil {
+		strs := make([]string, len(elements))
+		for i, element := range elements {
+			strs[i] = fmt.Sprintf("%v", element)
+		}
+		return strs
+	}
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	strs := strings.Split(list, ",")
+	for i, str := range strs {
+		strs[i] = strings.TrimSpace(str)
+	}
+	return strs
 }
 
 func bulkConvert(key, value string) (int, int, error) {
@@ -218,7 +225,3 @@ func assertFail(typ, key, val string) (string, error) {
 	log.WithField("=>", fmt.Sprintf("%s %s %s", key, typ, val)).Warn("Assertion Failed")
 	return "failed", fmt.Errorf("assertion failed")
 }
-
-func convFail() (string, error) {
-	return "", fmt.Errorf("The Key of your assertion cannot be converted into an integer.\nFor string conversions please use the equal or not equal relations.")
-}
@@ -29,7 +29,7 @@ func DefaultKeysURL() string {
 // for passing data
 func InitKeyClient(keysUrl string) (*LocalKeyClient, error) {
 	aliveCh := make(chan struct{})
-	localKeyClient, err := keys.NewRemoteKeyClient(keysUrl, logging.NewNoopLogger())
+	localKeyClient, err := keys.NewRemoteKeyClient(keysUrl, nil, logging.NewNoopLogger())
 	if err != nil {
 		return nil, err
 	}
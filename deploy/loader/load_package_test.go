@@ -27,6 +27,21 @@ func TestUnmarshal(t *testing.T) {
     data: $val2
     amount: $to_save
     fee: $MinersFee
+`)
+	testUnmarshal(t, `compilers:
+  solc: 0.5.17
+  vyper: 0.3.1
+
+jobs:
+
+- name: greeting
+  set:
+    val: hello
+
+- name: pay
+  send:
+    destination: $recipient
+    amount: 42
 `)
 	testUnmarshal(t, `jobs:
 
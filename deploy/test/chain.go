@@ -0,0 +1,207 @@
+package test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/consensus/tendermint/abci"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/keys/mock"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/txs"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+)
+
+// Name of the genesis account, with every permission, that tests are run from
+const TesterName = "tester"
+
+// A chain held in memory for the length of a test run. There is no consensus, each transaction is committed in a
+// block of its own as soon as it is broadcast, and the chain serves the same GRPC services as a node so deploy jobs
+// can be run against it unchanged.
+type Chain struct {
+	// Serialises blocks, of which there is one per transaction
+	sync.Mutex
+	GenesisDoc *genesis.GenesisDoc
+	// The address of the account named by TesterName
+	Tester     crypto.Address
+	blockchain *bcm.Blockchain
+	app        *abci.App
+	emitter    event.Emitter
+	listener   net.Listener
+	grpcServer *grpc.Server
+	// The first error from which the chain could not recover
+	panicked error
+}
+
+// Makes a chain from genesisSpec, which should include an account named TesterName, and starts serving it on a port
+// of the loopback interface picked by the operating system
+func NewChain(genesisSpec spec.GenesisSpec, logger *logging.Logger) (*Chain, error) {
+	keyClient := mock.NewKeyClient()
+	genesisDoc, err := genesisSpec.GenesisDoc(keyClient, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not make genesis for test chain: %v", err)
+	}
+	chain := &Chain{GenesisDoc: genesisDoc}
+	tester := false
+	for _, acc := range genesisDoc.Accounts {
+		if acc.Name == TesterName {
+			chain.Tester = acc.Address
+			tester = true
+		}
+	}
+	if !tester {
+		return nil, fmt.Errorf("genesis for test chain has no account named %s", TesterName)
+	}
+
+	db := dbm.NewMemDB()
+	chain.blockchain, err = bcm.LoadOrNewBlockchain(db, genesisDoc, logger)
+	if err != nil {
+		return nil, err
+	}
+	state, err := execution.MakeGenesisState(db, genesisDoc)
+	if err != nil {
+		return nil, err
+	}
+	txCodec := txs.NewAminoCodec()
+	checker := execution.NewBatchChecker(state, chain.blockchain, logger)
+	chain.emitter = event.NewEmitter(logger)
+	committer := execution.NewBatchCommitter(state, chain.blockchain, chain.emitter, logger)
+	chain.app = abci.NewApp("Burrow_TestChain", chain.blockchain, checker, committer, txCodec, chain.panic, logger)
+	transactor := execution.NewTransactor(chain.blockchain, chain.emitter,
+		execution.NewAccounts(checker, keyClient, 100), chain.broadcastTx, txCodec, logger)
+
+	chain.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	chain.grpcServer = rpc.NewGRPCServer(logger, nil, nil)
+	rpcquery.RegisterQueryServer(chain.grpcServer, &queryServer{
+		QueryServer: rpcquery.NewQueryServer(state, state, chain.blockchain, nil, nil, logger),
+		chain:       chain,
+	})
+	rpctransact.RegisterTransactServer(chain.grpcServer, rpctransact.NewTransactServer(transactor, txCodec))
+	rpcevents.RegisterExecutionEventsServer(chain.grpcServer, rpcevents.NewExecutionEventsServer(state,
+		chain.emitter, chain.blockchain, logger))
+	go chain.grpcServer.Serve(chain.listener)
+	return chain, nil
+}
+
+// The address at which the chain's GRPC services are served, for --chain-url
+func (chain *Chain) Address() string {
+	return chain.listener.Addr().String()
+}
+
+func (chain *Chain) Shutdown() error {
+	// The listener is closed for us
+	chain.grpcServer.Stop()
+	return chain.emitter.Shutdown(context.Background())
+}
+
+// Passes tx through the mempool checks and, if it passes them, commits it in a block of its own
+func (chain *Chain) broadcastTx(tx tmTypes.Tx, callback func(*abciTypes.Response)) error {
+	chain.Lock()
+	defer chain.Unlock()
+	if chain.panicked != nil {
+		return chain.panicked
+	}
+	checkTx := chain.app.CheckTx(tx)
+	callback(abciTypes.ToResponseCheckTx(checkTx))
+	if checkTx.IsErr() {
+		return nil
+	}
+
+	height := int64(chain.blockchain.LastBlockHeight()) + 1
+	blockTime := time.Now().UTC()
+	if !blockTime.After(chain.blockchain.LastBlockTime()) {
+		blockTime = chain.blockchain.LastBlockTime().Add(time.Millisecond)
+	}
+	hasher := sha256.New()
+	hasher.Write(chain.blockchain.LastBlockHash())
+	hasher.Write(tx)
+	chain.app.BeginBlock(abciTypes.RequestBeginBlock{
+		Hash: hasher.Sum(nil),
+		Header: abciTypes.Header{
+			ChainID:       chain.blockchain.ChainID(),
+			Height:        height,
+			Time:          blockTime,
+			NumTxs:        1,
+			LastBlockHash: chain.blockchain.LastBlockHash(),
+			AppHash:       chain.blockchain.AppHashAfterLastBlock(),
+		},
+		LastCommitInfo: abciTypes.LastCommitInfo{Validators: signingValidators(chain.blockchain.PreviousValidators())},
+	})
+	chain.app.DeliverTx(tx)
+	chain.app.EndBlock(abciTypes.RequestEndBlock{Height: height})
+	chain.app.Commit()
+	return chain.panicked
+}
+
+func (chain *Chain) panic(err error) {
+	if chain.panicked == nil {
+		chain.panicked = err
+	}
+}
+
+// We are the only validator and sign every block
+func signingValidators(validators *validator.Set) []abciTypes.SigningValidator {
+	var signing []abciTypes.SigningValidator
+	validators.Iterate(func(id crypto.Addressable, power *big.Int) (stop bool) {
+		signing = append(signing, abciTypes.SigningValidator{
+			Validator: abciTypes.Validator{
+				Address: id.Address().Bytes(),
+				Power:   power.Int64(),
+			},
+			SignedLastBlock: true,
+		})
+		return
+	})
+	return signing
+}
+
+// The query server describes the status of a node from its Tendermint node, which the test chain does without
+type queryServer struct {
+	rpcquery.QueryServer
+	chain *Chain
+}
+
+func (qs *queryServer) Status(ctx context.Context, param *rpcquery.StatusParam) (*rpc.ResultStatus, error) {
+	bc := qs.chain.blockchain
+	genesisValidator := qs.chain.GenesisDoc.Validators[0]
+	address := genesisValidator.PublicKey.Address()
+	return &rpc.ResultStatus{
+		ChainID:       bc.ChainID(),
+		BurrowVersion: project.FullVersion(),
+		GenesisHash:   bc.GenesisHash(),
+		SyncInfo: &rpc.SyncInfo{
+			LatestBlockHeight:   bc.LastBlockHeight(),
+			LatestBlockHash:     bc.LastBlockHash(),
+			LatestAppHash:       bc.AppHashAfterLastBlock(),
+			LatestBlockTime:     bc.LastBlockTime(),
+			LatestBlockSeenTime: bc.LastCommitTime(),
+		},
+		ValidatorInfo: &validator.Validator{
+			Address:   &address,
+			PublicKey: genesisValidator.PublicKey,
+			Power:     bc.Validators().Power(address).Uint64(),
+		},
+	}, nil
+}
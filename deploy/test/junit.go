@@ -0,0 +1,155 @@
+package test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// The results of a test run in the JUnit XML format understood by most CI servers
+
+type TestSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Errors   int          `xml:"errors,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Suites   []*TestSuite `xml:"testsuite"`
+}
+
+// The tests of a package or of the contracts of a source file
+type TestSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []*TestCase `xml:"testcase"`
+	elapsed  time.Duration
+}
+
+// A job of a package or a test function of a contract
+type TestCase struct {
+	Name       string      `xml:"name,attr"`
+	Classname  string      `xml:"classname,attr"`
+	Time       string      `xml:"time,attr"`
+	Properties *Properties `xml:"properties,omitempty"`
+	// Set when the test did not do what it should
+	Failure *Problem `xml:"failure,omitempty"`
+	// Set when the test could not be run at all, such as when its contract does not compile
+	Error   *Problem  `xml:"error,omitempty"`
+	Skipped *struct{} `xml:"skipped,omitempty"`
+}
+
+type Properties struct {
+	Properties []Property `xml:"property"`
+}
+
+type Property struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type Problem struct {
+	Message string `xml:"message,attr"`
+}
+
+func NewTestSuite(name string) *TestSuite {
+	return &TestSuite{Name: name}
+}
+
+// Adds the outcome of a test that took elapsed to run
+func (suite *TestSuite) Add(tc *TestCase, elapsed time.Duration) {
+	tc.Time = seconds(elapsed)
+	if tc.Classname == "" {
+		tc.Classname = suite.Name
+	}
+	suite.Cases = append(suite.Cases, tc)
+	suite.Tests++
+	switch {
+	case tc.Failure != nil:
+		suite.Failures++
+	case tc.Error != nil:
+		suite.Errors++
+	case tc.Skipped != nil:
+		suite.Skipped++
+	}
+	suite.elapsed += elapsed
+	suite.Time = seconds(suite.elapsed)
+}
+
+func (suite *TestSuite) Failed() bool {
+	return suite.Failures+suite.Errors > 0
+}
+
+func (ts *TestSuites) Add(suite *TestSuite) {
+	ts.Suites = append(ts.Suites, suite)
+	ts.Tests += suite.Tests
+	ts.Failures += suite.Failures
+	ts.Errors += suite.Errors
+	ts.Skipped += suite.Skipped
+	var elapsed time.Duration
+	for _, s := range ts.Suites {
+		elapsed += s.elapsed
+	}
+	ts.Time = seconds(elapsed)
+}
+
+func (ts *TestSuites) Failed() bool {
+	return ts.Failures+ts.Errors > 0
+}
+
+func (ts *TestSuites) WriteXML(w io.Writer) error {
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(ts)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// The gas a test used if it was recorded
+func (tc *TestCase) Gas() (string, bool) {
+	if tc.Properties != nil {
+		for _, p := range tc.Properties.Properties {
+			if p.Name == "gas" {
+				return p.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Records the gas a test used as a property of its test case
+func (tc *TestCase) SetGas(gas uint64) {
+	if tc.Properties == nil {
+		tc.Properties = new(Properties)
+	}
+	tc.Properties.Properties = append(tc.Properties.Properties, Property{Name: "gas", Value: strconv.FormatUint(gas, 10)})
+}
+
+func (tc *TestCase) Fail(format string, args ...interface{}) {
+	tc.Failure = &Problem{Message: fmt.Sprintf(format, args...)}
+}
+
+func (tc *TestCase) Err(err error) {
+	tc.Error = &Problem{Message: err.Error()}
+}
+
+func (tc *TestCase) Skip() {
+	tc.Skipped = &struct{}{}
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteXML(t *testing.T) {
+	suite := NewTestSuite("deploy.yaml")
+	pass := &TestCase{Name: "deploy"}
+	pass.SetGas(21000)
+	suite.Add(pass, 1500*time.Millisecond)
+	fail := &TestCase{Name: "check"}
+	fail.Fail("assertion failed: %d != %d", 5, 6)
+	suite.Add(fail, 0)
+	skip := &TestCase{Name: "after"}
+	skip.Skip()
+	suite.Add(skip, 0)
+	broken := NewTestSuite("Broken.sol")
+	errored := &TestCase{Name: "Broken.sol"}
+	errored.Err(fmt.Errorf("could not compile"))
+	broken.Add(errored, time.Second)
+
+	results := new(TestSuites)
+	results.Add(suite)
+	results.Add(broken)
+	assert.True(t, results.Failed())
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, results.WriteXML(buf))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="4" failures="1" errors="1" skipped="1" time="2.500">
+  <testsuite name="deploy.yaml" tests="3" failures="1" errors="0" skipped="1" time="1.500">
+    <testcase name="deploy" classname="deploy.yaml" time="1.500">
+      <properties>
+        <property name="gas" value="21000"></property>
+      </properties>
+    </testcase>
+    <testcase name="check" classname="deploy.yaml" time="0.000">
+      <failure message="assertion failed: 5 != 6"></failure>
+    </testcase>
+    <testcase name="after" classname="deploy.yaml" time="0.000">
+      <skipped></skipped>
+    </testcase>
+  </testsuite>
+  <testsuite name="Broken.sol" tests="1" failures="0" errors="1" skipped="0" time="1.000">
+    <testcase name="Broken.sol" classname="Broken.sol" time="1.000">
+      <error message="could not compile"></error>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, buf.String())
+}
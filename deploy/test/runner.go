@@ -0,0 +1,164 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	pkgs "github.com/hyperledger/burrow/deploy"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/loader"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+)
+
+const (
+	DefaultGas    = "1111111111"
+	DefaultFee    = "9999"
+	DefaultAmount = "9999"
+)
+
+// Runs deploy packages and Solidity test contracts against a chain
+type Runner struct {
+	chain  *Chain
+	client *def.Client
+	// Directory of compiler binaries from which versions pinned by packages are taken
+	compilerCache string
+	compilers     compile.Compilers
+	// Gas given to each transaction unless a job says otherwise
+	Gas string
+}
+
+func NewRunner(chain *Chain, compilerCache string) (*Runner, error) {
+	compilers, err := compile.NewCompilers(compilerCache, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := new(def.Client)
+	err = client.Dial(chain.Address(), "")
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to test chain: %v", err)
+	}
+	return &Runner{
+		chain:         chain,
+		client:        client,
+		compilerCache: compilerCache,
+		compilers:     compilers,
+		Gas:           DefaultGas,
+	}, nil
+}
+
+// Runs the tests in file, either a deploy package or a contract source or artifact file
+func (runner *Runner) Run(file string) *TestSuite {
+	switch filepath.Ext(file) {
+	case ".yaml", ".yml":
+		return runner.RunPackage(file)
+	default:
+		return runner.RunContracts(file)
+	}
+}
+
+// Runs the jobs of the deploy package in file as a test each, a job failing fails its test and skips those after it
+func (runner *Runner) RunPackage(file string) *TestSuite {
+	suite := NewTestSuite(file)
+	start := time.Now()
+	err := runner.runPackage(file, suite)
+	if err != nil {
+		tc := &TestCase{Name: filepath.Base(file)}
+		tc.Err(err)
+		suite.Add(tc, time.Since(start))
+	}
+	return suite
+}
+
+func (runner *Runner) runPackage(file string, suite *TestSuite) error {
+	pkg, err := loader.LoadPackage(file)
+	if err != nil {
+		return err
+	}
+	dir, err := filepath.Abs(filepath.Dir(file))
+	if err != nil {
+		return err
+	}
+	// Running a package changes to its directory
+	pwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	defer os.Chdir(pwd)
+	// Job results are only of interest to tests through the jobs that refer to them
+	output, err := ioutil.TempDir("", "burrow-test")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(output)
+
+	do := &def.Packages{
+		Address:       runner.chain.Tester.String(),
+		BinPath:       "[dir]/bin",
+		ChainURL:      runner.chain.Address(),
+		CompilerCache: runner.compilerCache,
+		DefaultAmount: DefaultAmount,
+		DefaultFee:    DefaultFee,
+		DefaultGas:    runner.Gas,
+		DefaultOutput: filepath.Join(output, "deploy.output.json"),
+		NoState:       true,
+		Path:          dir,
+		YAMLPath:      filepath.Base(file),
+		Package:       pkg,
+	}
+	var txHashes []binary.HexBytes
+	do.RecordTxHashes(&txHashes)
+	// The jobs of the package rather than those added to it for defaults
+	jobs := pkg.Jobs
+	done := make(map[*def.Job]bool)
+	failed := false
+	do.JobDone = func(job *def.Job, elapsed time.Duration, err error) {
+		// Each job sends its transactions before the next starts
+		hashes := txHashes
+		txHashes = txHashes[len(txHashes):]
+		for _, j := range jobs {
+			if j == job {
+				tc := &TestCase{Name: job.Name}
+				if len(hashes) > 0 {
+					tc.SetGas(runner.gasUsed(hashes))
+				}
+				if err != nil {
+					tc.Fail("%v", err)
+					failed = true
+				}
+				suite.Add(tc, elapsed)
+				done[job] = true
+			}
+		}
+	}
+
+	err = pkgs.RunPackage(do)
+	if err != nil && !failed {
+		return err
+	}
+	for _, job := range jobs {
+		if !done[job] {
+			tc := &TestCase{Name: job.Name}
+			tc.Skip()
+			suite.Add(tc, 0)
+		}
+	}
+	return nil
+}
+
+// Total gas used by the transactions with hashes
+func (runner *Runner) gasUsed(txHashes []binary.HexBytes) uint64 {
+	var gas uint64
+	for _, txHash := range txHashes {
+		txe, err := runner.client.Events().GetTx(context.Background(), &rpcevents.GetTxRequest{TxHash: txHash})
+		if err == nil && txe.Result != nil {
+			gas += txe.Result.GasUsed
+		}
+	}
+	return gas
+}
@@ -0,0 +1,196 @@
+package test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/genesis/spec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deployYAML = `jobs:
+- name: send
+  send:
+    destination: 1111111111111111111111111111111111111111
+    amount: 10
+- name: five
+  set:
+    val: 5
+- name: check
+  assert:
+    key: $five
+    relation: eq
+    val: 6
+- name: after
+  set:
+    val: 1
+`
+
+func TestRunPackage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "deploy.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(deployYAML), 0644))
+
+	runner := newRunner(t)
+	defer runner.chain.Shutdown()
+	suite := runner.Run(file)
+	require.Len(t, suite.Cases, 4)
+	assert.Equal(t, 4, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, 1, suite.Skipped)
+	assert.Nil(t, suite.Cases[0].Failure)
+	assert.Equal(t, "check", suite.Cases[2].Name)
+	assert.NotNil(t, suite.Cases[2].Failure)
+	assert.NotNil(t, suite.Cases[3].Skipped)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "nothing but the package is left in its directory")
+}
+
+const controlFlowYAML = `jobs:
+- name: payees
+  set:
+    val: 1111111111111111111111111111111111111111,2222222222222222222222222222222222222222
+- name: pay
+  for-each: $payees
+  send:
+    destination: $pay.item
+    amount: 10
+- name: paid
+  wait-for-event:
+    query: EventType = 'AccountOutputEvent' AND Address = '2222222222222222222222222222222222222222'
+    from: 1
+    timeout: 10s
+- name: again
+  if:
+    account: "2222222222222222222222222222222222222222"
+    field: balance
+    relation: lt
+    val: 10
+  send:
+    destination: "2222222222222222222222222222222222222222"
+    amount: 10
+- name: skipped
+  assert:
+    key: $again
+    relation: eq
+    val: ""
+- name: never
+  wait-for-event:
+    query: EventType = 'AccountOutputEvent' AND Address = '3333333333333333333333333333333333333333'
+    timeout: 100ms
+`
+
+func TestRunControlFlow(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "deploy.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(controlFlowYAML), 0644))
+
+	runner := newRunner(t)
+	defer runner.chain.Shutdown()
+	suite := runner.Run(file)
+	require.Len(t, suite.Cases, 6)
+	for _, tc := range suite.Cases[:5] {
+		assert.Nil(t, tc.Failure, "%s should pass", tc.Name)
+	}
+	_, ok := suite.Cases[1].Gas()
+	assert.True(t, ok, "for-each should send transactions")
+	_, ok = suite.Cases[3].Gas()
+	assert.False(t, ok, "job whose condition does not hold should send nothing")
+	require.NotNil(t, suite.Cases[5].Failure)
+	assert.Contains(t, suite.Cases[5].Failure.Message, "no event matching")
+}
+
+func TestRunContracts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "Tests.json")
+	writeTestArtifact(t, file)
+
+	runner := newRunner(t)
+	defer runner.chain.Shutdown()
+	suite := runner.Run(file)
+	require.Len(t, suite.Cases, 3)
+	assert.Equal(t, 2, suite.Failures)
+
+	pass := suite.Cases[0]
+	assert.Equal(t, "testPass", pass.Name)
+	assert.Equal(t, "Tests", pass.Classname)
+	assert.Nil(t, pass.Failure)
+	_, ok := pass.Gas()
+	assert.True(t, ok)
+
+	assert.Equal(t, "testFalse", suite.Cases[1].Name)
+	assert.Equal(t, "returned false", suite.Cases[1].Failure.Message)
+	assert.Equal(t, "testRevert", suite.Cases[2].Name)
+	assert.Equal(t, "reverted: nope", suite.Cases[2].Failure.Message)
+
+	suite = runner.Run(filepath.Join(dir, "Missing.json"))
+	require.Len(t, suite.Cases, 1)
+	assert.NotNil(t, suite.Cases[0].Error)
+}
+
+func newRunner(t *testing.T) *Runner {
+	chain, err := NewChain(spec.FullAccount(TesterName), logging.NewNoopLogger())
+	require.NoError(t, err)
+	runner, err := NewRunner(chain, "")
+	require.NoError(t, err)
+	return runner
+}
+
+// Writes an artifact for a contract with a test that passes, one that returns false, and one that reverts
+func writeTestArtifact(t *testing.T, file string) {
+	selector := func(signature string) []byte {
+		id := abi.GetFunctionID(signature)
+		return id[:]
+	}
+	returnWord := func(value byte) []byte {
+		return MustSplice(JUMPDEST, PUSH1, value, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	}
+	revertReason := MustSplice(JUMPDEST,
+		PUSH32, selector("Error(string)"), make([]byte, 28), PUSH1, 0, MSTORE,
+		PUSH1, 32, PUSH1, 4, MSTORE,
+		PUSH1, 4, PUSH1, 36, MSTORE,
+		PUSH32, []byte("nope"), make([]byte, 28), PUSH1, 68, MSTORE,
+		PUSH1, 100, PUSH1, 0, REVERT)
+	dispatch := func(pass, fail, revert int) []byte {
+		return MustSplice(PUSH1, 0, CALLDATALOAD, PUSH29, 1, make([]byte, 28), SWAP1, DIV,
+			DUP1, PUSH4, selector("testPass()"), EQ, PUSH1, pass, JUMPI,
+			DUP1, PUSH4, selector("testFalse()"), EQ, PUSH1, fail, JUMPI,
+			DUP1, PUSH4, selector("testRevert()"), EQ, PUSH1, revert, JUMPI,
+			PUSH1, 0, DUP1, REVERT)
+	}
+	n := len(dispatch(0, 0, 0))
+	runtime := MustSplice(dispatch(n, n+len(returnWord(1)), n+2*len(returnWord(1))), returnWord(1), returnWord(0),
+		revertReason)
+	code := MustSplice(PUSH1, len(runtime), DUP1, PUSH1, 11, PUSH1, 0, CODECOPY, PUSH1, 0, RETURN, runtime)
+
+	bs, err := json.Marshal(map[string]interface{}{
+		"contractName": "Tests",
+		"abi": json.RawMessage(`[
+			{"type":"function","name":"testPass","inputs":[],"outputs":[{"name":"","type":"bool"}]},
+			{"type":"function","name":"testFalse","inputs":[],"outputs":[{"name":"","type":"bool"}]},
+			{"type":"function","name":"testRevert","inputs":[],"outputs":[]},
+			{"type":"function","name":"helper","inputs":[],"outputs":[]}
+		]`),
+		"bytecode":         "0x" + hex.EncodeToString(code),
+		"deployedBytecode": "0x" + hex.EncodeToString(runtime),
+	})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(file, bs, 0644))
+}
@@ -0,0 +1,194 @@
+package test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Functions of a test contract taking no arguments whose names start with this are run as tests, each against a
+// fresh instance of the contract
+const TestPrefix = "test"
+
+// Called, if a test contract defines it, on each fresh instance of the contract before its test is run
+const SetUp = "setUp"
+
+// Solidity's require and revert give their reason as if calling a function Error(string)
+var errorSelector = abi.GetFunctionID("Error(string)")
+
+// Runs the test functions of the contracts in file, which may be any file the compilers compile
+func (runner *Runner) RunContracts(file string) *TestSuite {
+	suite := NewTestSuite(file)
+	start := time.Now()
+	resp, err := runner.compilers.Compile(file, false, nil)
+	if err == nil && resp.Error != "" {
+		err = fmt.Errorf("%s", resp.Error)
+	}
+	if err != nil {
+		tc := &TestCase{Name: filepath.Base(file)}
+		tc.Err(fmt.Errorf("could not compile %s: %v", file, err))
+		suite.Add(tc, time.Since(start))
+		return suite
+	}
+	for _, contract := range resp.Objects {
+		// Abstract contracts and interfaces cannot be deployed, though their tests are run by contracts deriving
+		// from them
+		if contract.Binary.Evm.Bytecode.Object == "" {
+			continue
+		}
+		tests, spec, err := testFunctions(contract.Binary.Abi)
+		if err != nil {
+			tc := &TestCase{Name: contract.Objectname}
+			tc.Err(fmt.Errorf("could not read ABI of %s: %v", contract.Objectname, err))
+			suite.Add(tc, 0)
+			continue
+		}
+		for _, test := range tests {
+			start := time.Now()
+			tc := runner.runTest(contract, spec, test)
+			suite.Add(tc, time.Since(start))
+		}
+	}
+	return suite
+}
+
+// The names of test functions in the order the ABI gives them
+func testFunctions(abiJSON json.RawMessage) ([]string, *abi.AbiSpec, error) {
+	spec, err := abi.ReadAbiSpec(abiJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+	var specJ []abi.AbiSpecJSON
+	err = json.Unmarshal(abiJSON, &specJ)
+	if err != nil {
+		return nil, nil, err
+	}
+	var tests []string
+	for _, s := range specJ {
+		if s.Type == "function" && strings.HasPrefix(s.Name, TestPrefix) && len(s.Inputs) == 0 {
+			tests = append(tests, s.Name)
+		}
+	}
+	return tests, spec, nil
+}
+
+func (runner *Runner) runTest(contract compile.ResponseItem, spec *abi.AbiSpec, test string) *TestCase {
+	tc := &TestCase{Name: test, Classname: contract.Objectname}
+	address, err := runner.deploy(contract)
+	if err != nil {
+		tc.Err(err)
+		return tc
+	}
+	if setUp, ok := spec.Functions[SetUp]; ok && len(setUp.Inputs) == 0 {
+		txe, err := runner.call(address, setUp)
+		if err == nil {
+			err = failure(txe, setUp)
+		}
+		if err != nil {
+			tc.Err(fmt.Errorf("%s failed: %v", SetUp, err))
+			return tc
+		}
+	}
+	function := spec.Functions[test]
+	txe, err := runner.call(address, function)
+	if err != nil {
+		tc.Fail("%v", err)
+		return tc
+	}
+	tc.SetGas(txe.Result.GasUsed)
+	err = failure(txe, function)
+	if err != nil {
+		tc.Fail("%v", err)
+	}
+	return tc
+}
+
+func (runner *Runner) deploy(contract compile.ResponseItem) (crypto.Address, error) {
+	bin, err := compile.LinkContract(contract.Binary, nil)
+	if err != nil {
+		return crypto.ZeroAddress, err
+	}
+	if strings.Contains(bin.Binary, "__") {
+		return crypto.ZeroAddress, fmt.Errorf("%s links libraries, which test contracts cannot", contract.Objectname)
+	}
+	txe, err := runner.transact("", bin.Binary)
+	if err != nil {
+		return crypto.ZeroAddress, fmt.Errorf("could not deploy %s: %v", contract.Objectname, err)
+	}
+	if txe.Exception != nil {
+		return crypto.ZeroAddress, fmt.Errorf("could not deploy %s: %v", contract.Objectname,
+			reverted(txe.Result.Return))
+	}
+	return txe.Receipt.ContractAddress, nil
+}
+
+func (runner *Runner) call(address crypto.Address, function abi.FunctionSpec) (*exec.TxExecution, error) {
+	return runner.transact(address.String(), hex.EncodeToString(function.FunctionID[:]))
+}
+
+func (runner *Runner) transact(address, data string) (*exec.TxExecution, error) {
+	tx, err := runner.client.Call(&def.CallArg{
+		Input:   runner.chain.Tester.String(),
+		Address: address,
+		Amount:  DefaultAmount,
+		Fee:     DefaultFee,
+		Gas:     runner.Gas,
+		Data:    data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return runner.client.SignAndBroadcast(tx)
+}
+
+// Tests fail by reverting or by returning false when they return a bool
+func failure(txe *exec.TxExecution, function abi.FunctionSpec) error {
+	if txe.Exception != nil {
+		return reverted(txe.Result.Return)
+	}
+	if len(function.Outputs) == 1 && function.Outputs[0].EVM.GetSignature() == "bool" && !function.Outputs[0].IsArray {
+		ret := txe.Result.Return
+		if len(ret) >= 32 && new(big.Int).SetBytes(ret[:32]).Sign() == 0 {
+			return fmt.Errorf("returned false")
+		}
+	}
+	return nil
+}
+
+// Describes a revert, with the reason the contract gave if any
+func reverted(ret []byte) error {
+	reason, ok := revertReason(ret)
+	if !ok {
+		return fmt.Errorf("reverted")
+	}
+	return fmt.Errorf("reverted: %s", reason)
+}
+
+// Decodes the string from the ABI encoding of Error(string)
+func revertReason(ret []byte) (string, bool) {
+	if len(ret) < abi.FunctionIDSize+64 || !bytes.Equal(ret[:abi.FunctionIDSize], errorSelector[:]) {
+		return "", false
+	}
+	args := ret[abi.FunctionIDSize:]
+	offset := new(big.Int).SetBytes(args[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(args)-32) {
+		return "", false
+	}
+	length := new(big.Int).SetBytes(args[offset.Uint64() : offset.Uint64()+32])
+	start := offset.Uint64() + 32
+	if !length.IsUint64() || length.Uint64() > uint64(len(args))-start {
+		return "", false
+	}
+	return string(args[start : start+length.Uint64()]), true
+}
@@ -198,32 +198,7 @@ func PreProcessInputData(function string, data interface{}, do *def.Packages, co
 		}
 		val := reflect.ValueOf(data)
 		for i := 0; i < val.Len(); i++ {
-			s := val.Index(i)
-			var newString string
-			switch s.Interface().(type) {
-			case bool:
-				newString = strconv.FormatBool(s.Interface().(bool))
-			case int, int32, int64:
-				newString = strconv.FormatInt(int64(s.Interface().(int)), 10)
-			case []interface{}:
-				var args []string
-				for _, index := range s.Interface().([]interface{}) {
-					value := reflect.ValueOf(index)
-					var stringified string
-					switch value.Kind() {
-					case reflect.Int:
-						stringified = strconv.FormatInt(value.Int(), 10)
-					case reflect.String:
-						stringified = value.String()
-					}
-					index, _ = PreProcess(stringified, do)
-					args = append(args, stringified)
-				}
-				newString = "[" + strings.Join(args, ",") + "]"
-				log.Debug(newString)
-			default:
-				newString = s.Interface().(string)
-			}
+			newString := formatInputData(val.Index(i).Interface())
 			newString, _ = PreProcess(newString, do)
 			callDataArray = append(callDataArray, newString)
 		}
@@ -231,6 +206,31 @@ func PreProcessInputData(function string, data interface{}, do *def.Packages, co
 	return function, callDataArray, nil
 }
 
+// Formats a value of the data of a job as the ABI packer reads it, lists becoming [a,b] so that they can be nested for
+// arrays of arrays and for tuples
+func formatInputData(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		args := make([]string, len(v))
+		for i, arg := range v {
+			args[i] = formatInputData(arg)
+		}
+		return "[" + strings.Join(args, ",") + "]"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
 func PreProcessLibs(libs string, do *def.Packages) (string, error) {
 	libraries, _ := PreProcess(libs, do)
 	if libraries != "" {
@@ -0,0 +1,233 @@
+package verify
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	bin "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/execution/evm/asm"
+)
+
+// The source of a contract is published in the name registry under this prefix followed by the contract's address
+const NamePrefix = "contract/"
+
+// The name registry only accepts the kind of characters found in simple JSON so we encode the ABI and metadata,
+// which may contain anything, with this
+var encoding = base64.RawURLEncoding
+
+// What is needed to rebuild the code of a deployed contract from its source
+type Source struct {
+	// Path of the source file as it was compiled
+	File string `json:"file"`
+	// Hex encoded SHA-256 hash of the source file
+	SourceHash string `json:"source_hash"`
+	// Name of the contract within the source file
+	Contract string `json:"contract"`
+	// The compiler that compiled the source (solc or vyper) and its version
+	Compiler string `json:"compiler,omitempty"`
+	Version  string `json:"version,omitempty"`
+	Optimize bool   `json:"optimize,omitempty"`
+	// Addresses of the libraries linked into the contract by library name
+	Libraries map[string]string `json:"libraries,omitempty"`
+	// The contract's ABI and the metadata its compiler output for it
+	Abi      json.RawMessage `json:"-"`
+	Metadata string          `json:"-"`
+}
+
+// The outcome of a successful verification
+type Result struct {
+	Address  crypto.Address
+	Contract string
+	// False when the code on chain matches the code compiled from source only once the hash of the compiler's
+	// metadata appended to each is removed, which happens when the source was compiled from a different path or only
+	// its comments differ
+	Exact bool
+}
+
+// Returns the name under which the source of the contract at address is published
+func Name(address crypto.Address) string {
+	return NamePrefix + address.String()
+}
+
+// Describes the source of contract, as compiled by a compiler of version from file
+func NewSource(file string, contract compile.ResponseItem, version string, optimize bool,
+	libraries map[string]string) (*Source, error) {
+	hash, err := hashFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return &Source{
+		File:       file,
+		SourceHash: hash,
+		Contract:   contract.Objectname,
+		Compiler:   compile.CompilerName(file),
+		Version:    version,
+		Optimize:   optimize,
+		Libraries:  libraries,
+		Abi:        contract.Binary.Abi,
+		Metadata:   contract.Binary.Metadata,
+	}, nil
+}
+
+// Encodes the source for the data of a name registry entry
+func (src *Source) Encode() (string, error) {
+	type source Source
+	bs, err := json.Marshal(struct {
+		*source
+		Abi      string `json:"abi"`
+		Metadata string `json:"metadata,omitempty"`
+	}{
+		source:   (*source)(src),
+		Abi:      encoding.EncodeToString(src.Abi),
+		Metadata: encoding.EncodeToString([]byte(src.Metadata)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+func DecodeSource(data string) (*Source, error) {
+	type source Source
+	src := new(Source)
+	encoded := struct {
+		*source
+		Abi      string `json:"abi"`
+		Metadata string `json:"metadata"`
+	}{source: (*source)(src)}
+	err := json.Unmarshal([]byte(data), &encoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode published contract source: %v", err)
+	}
+	src.Abi, err = encoding.DecodeString(encoded.Abi)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode ABI of published contract source: %v", err)
+	}
+	metadata, err := encoding.DecodeString(encoded.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode metadata of published contract source: %v", err)
+	}
+	src.Metadata = string(metadata)
+	return src, nil
+}
+
+// Gets the source published for the contract at address
+func GetSource(client *def.Client, address crypto.Address) (*Source, error) {
+	entry, err := client.GetName(Name(address))
+	if err != nil {
+		return nil, fmt.Errorf("could not get source published for %v: %v", address, err)
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("no source is published for %v", address)
+	}
+	return DecodeSource(entry.Data)
+}
+
+// Compiles file as described by src with compilers and checks that the contract it defines has code deployed at
+// address
+func Verify(address crypto.Address, code []byte, file string, src *Source, compilers compile.Compiler) (*Result, error) {
+	if src.SourceHash != "" {
+		hash, err := hashFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if hash != src.SourceHash {
+			return nil, fmt.Errorf("%s has hash %s but the source published for %v has hash %s",
+				file, hash, address, src.SourceHash)
+		}
+	}
+	resp, err := compilers.Compile(file, src.Optimize, src.Libraries)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("could not compile %s: %s", file, resp.Error)
+	}
+	if src.Version != "" && resp.Version != "" && resp.Version != src.Version {
+		return nil, fmt.Errorf("%v was compiled by %s version %s but %s version %s compiled %s",
+			address, src.Compiler, src.Version, src.Compiler, resp.Version, file)
+	}
+	contract, err := findContract(resp, file, src.Contract)
+	if err != nil {
+		return nil, err
+	}
+	runtime, err := compile.LinkDeployedContract(contract.Binary, src.Libraries)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := hex.DecodeString(runtime)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode code compiled for %s: %v", contract.Objectname, err)
+	}
+	compiled = withLibraryAddress(compiled, address)
+
+	result := &Result{Address: address, Contract: contract.Objectname}
+	switch {
+	case bytes.Equal(compiled, code):
+		result.Exact = true
+	case bytes.Equal(stripMetadata(compiled), stripMetadata(code)):
+	default:
+		return nil, fmt.Errorf("code at %v does not match %s compiled from %s", address, contract.Objectname, file)
+	}
+	return result, nil
+}
+
+func findContract(resp *compile.Response, file, name string) (*compile.ResponseItem, error) {
+	var found []compile.ResponseItem
+	for _, item := range resp.Objects {
+		if name == "" || item.Objectname == name {
+			found = append(found, item)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%s does not define contract %s", file, name)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("%s defines more than one contract, name the one to verify", file)
+	}
+}
+
+// solc has libraries push their own address, only known once deployed, to stop them being called other than by
+// DELEGATECALL, so we put in address where solc leaves zeroes
+func withLibraryAddress(code []byte, address crypto.Address) []byte {
+	if len(code) <= crypto.AddressLength || code[0] != byte(asm.PUSH20) ||
+		!bytes.Equal(code[1:1+crypto.AddressLength], crypto.ZeroAddress.Bytes()) {
+		return code
+	}
+	linked := make([]byte, len(code))
+	copy(linked, code)
+	copy(linked[1:], address.Bytes())
+	return linked
+}
+
+// Removes the CBOR encoded metadata compilers append to code, the length of which is given by its last two bytes
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	length := int(binary.BigEndian.Uint16(code[len(code)-2:])) + 2
+	if length > len(code) {
+		return code
+	}
+	return code[:len(code)-length]
+}
+
+func hashFile(file string) (string, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bs)
+	return bin.HexBytes(hash[:]).String(), nil
+}
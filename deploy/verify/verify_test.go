@@ -0,0 +1,111 @@
+package verify
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// What the name registry accepts as data
+var nameDataRegex = regexp.MustCompile(`^[a-zA-Z0-9_/ \-+"':,\n\t.{}()\[\]]*$`)
+
+const (
+	runtime = "6080604052600080fd"
+	// Metadata as solc appends it, the last two bytes giving its length
+	metadata      = "a165627a7a72305820" + "1111111111111111111111111111111111111111111111111111111111111111" + "0029"
+	otherMetadata = "a165627a7a72305820" + "2222222222222222222222222222222222222222222222222222222222222222" + "0029"
+)
+
+func TestSource(t *testing.T) {
+	src := &Source{
+		File:       "contracts/storage.sol",
+		SourceHash: "AB",
+		Contract:   "Storage",
+		Compiler:   "solc",
+		Version:    "0.5.17",
+		Libraries:  map[string]string{"Math": "29BFD0C2BAA5A7D7D9F3D2B5F3C3BE04FE7AE6C9"},
+		Abi:        json.RawMessage(`[{"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"uint256"}]}]`),
+		Metadata:   `{"compiler":{"version":"0.5.17+commit.d19bba13"},"settings":{"remappings":["a=b"]},"output":{"devdoc":{"details":"Holds <things> & stuff!"}}}`,
+	}
+	data, err := src.Encode()
+	require.NoError(t, err)
+	assert.Regexp(t, nameDataRegex, data)
+	decoded, err := DecodeSource(data)
+	require.NoError(t, err)
+	assert.Equal(t, src, decoded)
+}
+
+func TestVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	address := crypto.Address{1, 2, 3}
+
+	file := writeArtifact(t, dir, "Storage.json", runtime+metadata)
+	src, err := NewSource(file, compile.ResponseItem{Objectname: "Storage"}, "", false, nil)
+	require.NoError(t, err)
+	code := mustDecode(t, runtime+metadata)
+
+	result, err := Verify(address, code, file, src, compile.Artifact{})
+	require.NoError(t, err)
+	assert.Equal(t, &Result{Address: address, Contract: "Storage", Exact: true}, result)
+
+	// Compiling from another path changes the metadata hash but not the code itself
+	result, err = Verify(address, mustDecode(t, runtime+otherMetadata), file, src, compile.Artifact{})
+	require.NoError(t, err)
+	assert.False(t, result.Exact)
+
+	_, err = Verify(address, mustDecode(t, "6080604052600180fd"+metadata), file, src, compile.Artifact{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match Storage")
+
+	src.Contract = "Token"
+	_, err = Verify(address, code, file, src, compile.Artifact{})
+	require.Error(t, err)
+
+	// The source has changed since it was published
+	writeArtifact(t, dir, "Storage.json", runtime)
+	_, err = Verify(address, code, file, &Source{SourceHash: src.SourceHash}, compile.Artifact{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "but the source published")
+
+	// Libraries push their own address and are linked into those that use them
+	library := writeArtifact(t, dir, "Math.json", "73"+crypto.ZeroAddress.String()+"3014"+metadata)
+	result, err = Verify(address, mustDecode(t, "73"+address.String()+"3014"+metadata), library, &Source{},
+		compile.Artifact{})
+	require.NoError(t, err)
+	assert.True(t, result.Exact)
+	user := writeArtifact(t, dir, "User.json", "73__Math__________________________________"+metadata)
+	result, err = Verify(address, mustDecode(t, "73"+address.String()+metadata), user,
+		&Source{Libraries: map[string]string{"Math": address.String()}}, compile.Artifact{})
+	require.NoError(t, err)
+	assert.True(t, result.Exact)
+}
+
+func writeArtifact(t *testing.T, dir, name, deployedBytecode string) string {
+	file := filepath.Join(dir, name)
+	bs, err := json.Marshal(map[string]interface{}{
+		"contractName":     name[:len(name)-len(filepath.Ext(name))],
+		"abi":              []interface{}{},
+		"bytecode":         "0x00",
+		"deployedBytecode": "0x" + deployedBytecode,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(file, bs, 0644))
+	return file
+}
+
+func mustDecode(t *testing.T, code string) []byte {
+	bs, err := hex.DecodeString(code)
+	require.NoError(t, err)
+	return bs
+}
@@ -37,10 +37,6 @@ func NewAccounts(reader state.Reader, keyClient keys.KeyClient, mutexCount int)
 	}
 }
 func (accs *Accounts) SigningAccount(address crypto.Address) (*SigningAccount, error) {
-	signer, err := keys.AddressableSigner(accs.keyClient, address)
-	if err != nil {
-		return nil, err
-	}
 	account, err := state.GetMutableAccount(accs, address)
 	if err != nil {
 		return nil, err
@@ -51,6 +47,17 @@ func (accs *Accounts) SigningAccount(address crypto.Address) (*SigningAccount, e
 			Address: address,
 		}.MutableAccount()
 	}
+	// An account that has been rekeyed is signed for by the key bound to it rather than the key at its address
+	if pubKey := account.PublicKey(); pubKey.IsSet() && pubKey.Address() != address {
+		return &SigningAccount{
+			Account: account,
+			Signer:  keys.AccountSigner(accs.keyClient, address, pubKey),
+		}, nil
+	}
+	signer, err := keys.AddressableSigner(accs.keyClient, address)
+	if err != nil {
+		return nil, err
+	}
 	pubKey, err := accs.keyClient.PublicKey(address)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,7 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/state"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -55,14 +56,22 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution) error {
 			return fmt.Errorf("could not execution GovTx since account template %v contains neither "+
 				"address or public key", update)
 		}
+		// An account whose key has been rotated by RekeyTx keeps its address
+		rekeyed := false
 		if update.PublicKey == nil {
 			update.PublicKey, err = ctx.MaybeGetPublicKey(*update.Address)
 			if err != nil {
 				return err
 			}
+			rekeyed = update.PublicKey != nil && update.PublicKey.Address() != *update.Address
 		}
-		// Check address
-		if update.PublicKey != nil {
+		if rekeyed {
+			if update.Balances().HasPower() {
+				return fmt.Errorf("GovTx cannot alter validator power of %v since its key has been rotated",
+					*update.Address)
+			}
+		} else if update.PublicKey != nil {
+			// Check address
 			address := update.PublicKey.Address()
 			if update.Address != nil && address != *update.Address {
 				return fmt.Errorf("supplied public key %v whose address %v does not match %v provided by"+
@@ -138,6 +147,21 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.MutableAccount, update
 		return
 	}
 	err = ctx.StateWriter.UpdateAccount(account)
+	if err != nil {
+		return
+	}
+	for _, item := range update.Storage {
+		if len(item.Key) > binary.Word256Length || len(item.Value) > binary.Word256Length {
+			err = fmt.Errorf("storage key %v and value %v for %v must each be at most %d bytes", item.Key,
+				item.Value, account.Address(), binary.Word256Length)
+			return
+		}
+		err = ctx.StateWriter.SetStorage(account.Address(), binary.LeftPadWord256(item.Key),
+			binary.LeftPadWord256(item.Value))
+		if err != nil {
+			return
+		}
+	}
 	return
 }
 
@@ -0,0 +1,56 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// RekeyContext rotates the key bound to an account. The account keeps its address, balance, code, and permissions
+// but subsequent transactions from it must be signed by the new key.
+type RekeyContext struct {
+	StateWriter state.ReaderWriter
+	Logger      *logging.Logger
+	tx          *payload.RekeyTx
+}
+
+func (ctx *RekeyContext) Execute(txe *exec.TxExecution) error {
+	var ok bool
+	ctx.tx, ok = txe.Envelope.Tx.Payload.(*payload.RekeyTx)
+	if !ok {
+		return fmt.Errorf("payload must be RekeyTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+	inAcc, err := state.GetMutableAccount(ctx.StateWriter, ctx.tx.Input.Address)
+	if err != nil {
+		return err
+	}
+	if inAcc == nil {
+		ctx.Logger.InfoMsg("Cannot find input account",
+			"tx_input", ctx.tx.Input)
+		return errors.ErrorCodeInvalidAddress
+	}
+	if ctx.tx.Input.Amount != 0 {
+		// Nothing would receive the funds
+		return errors.ErrorCodeOverpayment
+	}
+	// A key that is not a valid key or that is not held by the account holder would lock the account for good
+	err = ctx.tx.VerifyProof(txe.Envelope.Tx.ChainID)
+	if err != nil {
+		return fmt.Errorf("RekeyTx for %v must provide a key held by the account holder: %v", inAcc.Address(), err)
+	}
+	ctx.Logger.InfoMsg("Rotating account key",
+		"address", inAcc.Address(),
+		"old_public_key", inAcc.PublicKey(),
+		"new_public_key", ctx.tx.PublicKey)
+	inAcc.SetPublicKey(ctx.tx.PublicKey)
+	err = ctx.StateWriter.UpdateAccount(inAcc)
+	if err != nil {
+		return err
+	}
+	txe.Input(ctx.tx.Input.Address, nil)
+	return nil
+}
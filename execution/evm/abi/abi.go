@@ -1,6 +1,7 @@
 package abi
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -12,8 +13,10 @@ import (
 	"strings"
 	"unsafe" // just for Sizeof
 
+	burrow_binary "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/tmthrgd/go-hex"
 )
 
 // EVM Solidity calls and return values are packed into
@@ -149,6 +152,15 @@ func (e EVMUint) pack(v interface{}) ([]byte, error) {
 		if n.Sign() < 0 {
 			return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
 		}
+	case reflect.Ptr:
+		b, ok := v.(*big.Int)
+		if !ok || b == nil {
+			return nil, fmt.Errorf("cannot convert type %s to uint%d", arg.Type().String(), e.M)
+		}
+		n.Set(b)
+		if n.Sign() < 0 {
+			return nil, fmt.Errorf("negative value not allowed for uint%d", e.M)
+		}
 	case reflect.Uint8:
 		fallthrough
 	case reflect.Uint16:
@@ -210,23 +222,21 @@ func (e EVMUint) unpack(data []byte, offset int, v interface{}) (int, error) {
 		b.SetBytes(data[empty:ElementSize])
 		*v = b.String()
 	case *big.Int:
-		b := new(big.Int)
-		b.SetBytes(data[0:ElementSize])
-		v = b
+		v.SetBytes(data[0:ElementSize])
 	case *uint64:
-		maxLen := int(unsafe.Sizeof(new(uint64)))
+		maxLen := int(unsafe.Sizeof(uint64(0)))
 		if length > maxLen {
 			return 0, fmt.Errorf("value to large for uint64")
 		}
 		*v = binary.BigEndian.Uint64(data[ElementSize-maxLen : ElementSize])
 	case *uint32:
-		maxLen := int(unsafe.Sizeof(new(uint32)))
+		maxLen := int(unsafe.Sizeof(uint32(0)))
 		if length > maxLen {
 			return 0, fmt.Errorf("value to large for uint64")
 		}
 		*v = binary.BigEndian.Uint32(data[ElementSize-maxLen : ElementSize])
 	case *uint16:
-		maxLen := int(unsafe.Sizeof(new(uint16)))
+		maxLen := int(unsafe.Sizeof(uint16(0)))
 		if length > maxLen {
 			return 0, fmt.Errorf("value to large for uint16")
 		}
@@ -238,19 +248,19 @@ func (e EVMUint) unpack(data []byte, offset int, v interface{}) (int, error) {
 		}
 		*v = uint8(data[31])
 	case *int64:
-		maxLen := int(unsafe.Sizeof(new(int64)))
+		maxLen := int(unsafe.Sizeof(int64(0)))
 		if length > maxLen || (data[ElementSize-maxLen]&0x80) != 0 {
 			return 0, fmt.Errorf("value to large for int64")
 		}
 		*v = int64(binary.BigEndian.Uint64(data[ElementSize-maxLen : ElementSize]))
 	case *int32:
-		maxLen := int(unsafe.Sizeof(new(int32)))
+		maxLen := int(unsafe.Sizeof(int32(0)))
 		if length > maxLen || (data[ElementSize-maxLen]&0x80) != 0 {
 			return 0, fmt.Errorf("value to large for int64")
 		}
 		*v = int32(binary.BigEndian.Uint32(data[ElementSize-maxLen : ElementSize]))
 	case *int16:
-		maxLen := int(unsafe.Sizeof(new(uint16)))
+		maxLen := int(unsafe.Sizeof(uint16(0)))
 		if length > maxLen || (data[ElementSize-maxLen]&0x80) != 0 {
 			return 0, fmt.Errorf("value to large for int16")
 		}
@@ -307,6 +317,12 @@ func (e EVMInt) pack(v interface{}) ([]byte, error) {
 		if !ok {
 			return nil, fmt.Errorf("Failed to parse `%s", arg.String())
 		}
+	case reflect.Ptr:
+		b, ok := v.(*big.Int)
+		if !ok || b == nil {
+			return nil, fmt.Errorf("cannot convert type %s to int%d", arg.Type().String(), e.M)
+		}
+		n.Set(b)
 	case reflect.Uint8:
 		fallthrough
 	case reflect.Uint16:
@@ -392,18 +408,16 @@ func (e EVMInt) unpack(data []byte, offset int, v interface{}) (int, error) {
 			*v = b.String()
 		}
 	case *big.Int:
-		b := new(big.Int)
-		b.SetBytes(data[0:ElementSize])
+		v.SetBytes(data[0:ElementSize])
 		if sign {
-			v = b.Neg(b)
-		} else {
-			v = b
+			// Two's complement
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), ElementSize*8))
 		}
 	case *uint64:
 		if sign {
 			return 0, fmt.Errorf("cannot convert negative EVM int to %s", toType)
 		}
-		maxLen := int(unsafe.Sizeof(new(uint64)))
+		maxLen := int(unsafe.Sizeof(uint64(0)))
 		if length > maxLen || (data[ElementSize-maxLen]&0x80) != 0 {
 			return 0, fmt.Errorf("value to large for uint64")
 		}
@@ -412,7 +426,7 @@ func (e EVMInt) unpack(data []byte, offset int, v interface{}) (int, error) {
 		if sign {
 			return 0, fmt.Errorf("cannot convert negative EVM int to %s", toType)
 		}
-		maxLen := int(unsafe.Sizeof(new(uint32)))
+		maxLen := int(unsafe.Sizeof(uint32(0)))
 		if length > maxLen || (data[ElementSize-maxLen]&0x80) != 0 {
 			return 0, fmt.Errorf("value to large for uint64")
 		}
@@ -421,29 +435,34 @@ func (e EVMInt) unpack(data []byte, offset int, v interface{}) (int, error) {
 		if sign {
 			return 0, fmt.Errorf("cannot convert negative EVM int to %s", toType)
 		}
-		maxLen := int(unsafe.Sizeof(new(uint16)))
+		maxLen := int(unsafe.Sizeof(uint16(0)))
 		if length > maxLen || (data[ElementSize-maxLen]&0x80) != 0 {
 			return 0, fmt.Errorf("value to large for uint16")
 		}
 		*v = binary.BigEndian.Uint16(data[ElementSize-maxLen : ElementSize])
 	case *int64:
-		maxLen := int(unsafe.Sizeof(new(int64)))
+		maxLen := int(unsafe.Sizeof(int64(0)))
 		if length > maxLen {
 			return 0, fmt.Errorf("value to large for uint64")
 		}
 		*v = int64(binary.BigEndian.Uint64(data[ElementSize-maxLen : ElementSize]))
 	case *int32:
-		maxLen := int(unsafe.Sizeof(new(int32)))
+		maxLen := int(unsafe.Sizeof(int32(0)))
 		if length > maxLen {
 			return 0, fmt.Errorf("value to large for uint64")
 		}
 		*v = int32(binary.BigEndian.Uint32(data[ElementSize-maxLen : ElementSize]))
 	case *int16:
-		maxLen := int(unsafe.Sizeof(new(uint16)))
+		maxLen := int(unsafe.Sizeof(uint16(0)))
 		if length > maxLen {
 			return 0, fmt.Errorf("value to large for uint16")
 		}
 		*v = int16(binary.BigEndian.Uint16(data[ElementSize-maxLen : ElementSize]))
+	case *int8:
+		if length > 1 {
+			return 0, fmt.Errorf("value to large for int8")
+		}
+		*v = int8(data[ElementSize-1])
 	default:
 		return 0, fmt.Errorf("unable to convert %s to %s", e.GetSignature(), toType)
 	}
@@ -474,25 +493,19 @@ func (e EVMAddress) GetSignature() string {
 
 func (e EVMAddress) pack(v interface{}) ([]byte, error) {
 	var err error
-	a, ok := v.(crypto.Address)
-	if !ok {
-		s, ok := v.(string)
-		if ok {
-			a, err = crypto.AddressFromHexString(s)
-			if err != nil {
-				return nil, err
-			}
-		}
-	} else {
-		b, ok := v.([]byte)
-		if !ok {
-			return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
-		}
-
-		a, err = crypto.AddressFromBytes(b)
-		if err != nil {
-			return nil, err
-		}
+	var a crypto.Address
+	switch v := v.(type) {
+	case crypto.Address:
+		a = v
+	case string:
+		a, err = crypto.AddressFromHexString(v)
+	case []byte:
+		a, err = crypto.AddressFromBytes(v)
+	default:
+		return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return pad(a[:], ElementSize, true), nil
@@ -693,6 +706,148 @@ func (e EVMFixed) isDynamic() bool {
 	return false
 }
 
+var _ EVMType = (*EVMArray)(nil)
+
+// EVMArray is an array whose elements may themselves be arrays, as in uint[2][]. The outermost array of an argument
+// is described by Argument.IsArray and Argument.ArrayLength, so this only appears as the element type of another array.
+type EVMArray struct {
+	Elem EVMType
+	// Zero for a dynamically sized array
+	Length uint64
+}
+
+func (e EVMArray) GetSignature() string {
+	if e.Length > 0 {
+		return fmt.Sprintf("%s[%d]", e.Elem.GetSignature(), e.Length)
+	}
+	return e.Elem.GetSignature() + "[]"
+}
+
+func (e EVMArray) getGoType() interface{} {
+	return new([]interface{})
+}
+
+func (e EVMArray) pack(v interface{}) ([]byte, error) {
+	values, err := listValues(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot map to %s: %v", e.GetSignature(), err)
+	}
+	if e.Length > 0 && uint64(len(values)) != e.Length {
+		return nil, fmt.Errorf("%s should have %d elements, not %d", e.GetSignature(), e.Length, len(values))
+	}
+	p, err := packSequence(e.elems(len(values)), values)
+	if err != nil {
+		return nil, err
+	}
+	if e.Length == 0 {
+		length := EVMUint{M: 256}
+		l, err := length.pack(len(values))
+		if err != nil {
+			return nil, err
+		}
+		p = append(l, p...)
+	}
+	return p, nil
+}
+
+func (e EVMArray) unpack(data []byte, offset int, v interface{}) (int, error) {
+	length := int(e.Length)
+	if e.Length == 0 {
+		lenType := EVMInt{M: 64}
+		var l int64
+		_, err := lenType.unpack(data, offset, &l)
+		if err != nil {
+			return 0, err
+		}
+		if l < 0 || l > int64(len(data)) {
+			return 0, fmt.Errorf("%s of length %d does not fit in %d bytes", e.GetSignature(), l, len(data))
+		}
+		length = int(l)
+		offset += ElementSize
+	}
+	err := unpackList(e.elems(length), data, offset, v, "[", "]")
+	if err != nil {
+		return 0, err
+	}
+	return headSize(e), nil
+}
+
+func (e EVMArray) isDynamic() bool {
+	return e.Length == 0 || e.Elem.isDynamic()
+}
+
+func (e EVMArray) elems(n int) []EVMType {
+	types := make([]EVMType, n)
+	for i := range types {
+		types[i] = e.Elem
+	}
+	return types
+}
+
+var _ EVMType = (*EVMTuple)(nil)
+
+// EVMTuple is a Solidity struct, given in the ABI as a tuple type with components
+type EVMTuple struct {
+	Components []Argument
+}
+
+func (e EVMTuple) GetSignature() string {
+	return signature("", e.Components)
+}
+
+func (e EVMTuple) getGoType() interface{} {
+	return new([]interface{})
+}
+
+func (e EVMTuple) pack(v interface{}) ([]byte, error) {
+	values, err := e.values(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot map to %s: %v", e.GetSignature(), err)
+	}
+	return packSequence(e.types(), values)
+}
+
+func (e EVMTuple) unpack(data []byte, offset int, v interface{}) (int, error) {
+	err := unpackList(e.types(), data, offset, v, "(", ")")
+	if err != nil {
+		return 0, err
+	}
+	return headSize(e), nil
+}
+
+func (e EVMTuple) isDynamic() bool {
+	for _, t := range e.types() {
+		if t.isDynamic() {
+			return true
+		}
+	}
+	return false
+}
+
+func (e EVMTuple) types() []EVMType {
+	types := make([]EVMType, len(e.Components))
+	for i, c := range e.Components {
+		types[i] = c.evmType()
+	}
+	return types
+}
+
+// A tuple may also be given as a map of its component names to their values
+func (e EVMTuple) values(v interface{}) ([]interface{}, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return listValues(v)
+	}
+	values := make([]interface{}, len(e.Components))
+	for i, c := range e.Components {
+		values[i], ok = m[c.Name]
+		if !ok {
+			return nil, fmt.Errorf("no value for component %s", c.Name)
+		}
+	}
+	return values, nil
+}
+
 type Argument struct {
 	Name        string
 	EVM         EVMType
@@ -701,6 +856,14 @@ type Argument struct {
 	ArrayLength uint64
 }
 
+// The type of the argument as a whole, taking into account that it may be an array of EVM
+func (a Argument) evmType() EVMType {
+	if !a.IsArray {
+		return a.EVM
+	}
+	return EVMArray{Elem: a.EVM, Length: a.ArrayLength}
+}
+
 const FunctionIDSize = 4
 
 type FunctionID [FunctionIDSize]byte
@@ -711,8 +874,14 @@ type FunctionSpec struct {
 	Outputs    []Argument
 }
 
+const EventIDSize = 32
+
+type EventID [EventIDSize]byte
+
 type Event struct {
+	EventID   EventID
 	Inputs    []Argument
+	Name      string
 	Anonymous bool
 }
 
@@ -721,6 +890,7 @@ type AbiSpec struct {
 	Fallback    FunctionSpec
 	Functions   map[string]FunctionSpec
 	Events      map[string]Event
+	EventsByID  map[EventID]Event
 }
 
 type ArgumentJSON struct {
@@ -741,6 +911,12 @@ type AbiSpecJSON struct {
 	Anonymous       bool
 }
 
+var (
+	isArrayType = regexp.MustCompile(`^(.*)\[([0-9]*)\]$`)
+	isMType     = regexp.MustCompile("^(bytes|uint|int)([0-9]+)$")
+	isMxNType   = regexp.MustCompile("^(fixed|ufixed)([0-9]+)x([0-9]+)$")
+)
+
 func readArgSpec(argsJ []ArgumentJSON) ([]Argument, error) {
 	args := make([]Argument, len(argsJ))
 	var err error
@@ -749,95 +925,119 @@ func readArgSpec(argsJ []ArgumentJSON) ([]Argument, error) {
 		args[i].Name = a.Name
 		args[i].Indexed = a.Indexed
 
+		// The last dimension of an array type such as uint[2][] is the outermost
 		baseType := a.Type
-		isArray := regexp.MustCompile("(.*)\\[([0-9]+)\\]")
-		m := isArray.FindStringSubmatch(a.Type)
+		m := isArrayType.FindStringSubmatch(a.Type)
 		if m != nil {
 			args[i].IsArray = true
-			args[i].ArrayLength, err = strconv.ParseUint(m[2], 10, 32)
-			if err != nil {
-				return nil, err
+			if m[2] != "" {
+				args[i].ArrayLength, err = strconv.ParseUint(m[2], 10, 32)
+				if err != nil {
+					return nil, err
+				}
 			}
 			baseType = m[1]
-		} else if strings.HasSuffix(a.Type, "[]") {
-			args[i].IsArray = true
-			baseType = strings.TrimSuffix(a.Type, "[]")
 		}
 
-		isM := regexp.MustCompile("(bytes|uint|int)([0-9]+)")
-		m = isM.FindStringSubmatch(baseType)
-		if m != nil {
-			M, err := strconv.ParseUint(m[2], 10, 32)
-			if err != nil {
-				return nil, err
-			}
-			switch m[1] {
-			case "bytes":
-				if M < 1 || M > 32 {
-					return nil, fmt.Errorf("bytes%d is not valid type", M)
-				}
-				args[i].EVM = EVMBytes{M}
-			case "uint":
-				if M < 8 || M > 256 || (M%8) != 0 {
-					return nil, fmt.Errorf("uint%d is not valid type", M)
-				}
-				args[i].EVM = EVMUint{M}
-			case "int":
-				if M < 8 || M > 256 || (M%8) != 0 {
-					return nil, fmt.Errorf("uint%d is not valid type", M)
-				}
-				args[i].EVM = EVMInt{M}
-			}
-			continue
+		args[i].EVM, err = readType(baseType, a.Components)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		isMxN := regexp.MustCompile("(fixed|ufixed)([0-9]+)x([0-9]+)")
-		m = isMxN.FindStringSubmatch(baseType)
-		if m != nil {
-			M, err := strconv.ParseUint(m[2], 10, 32)
+	return args, nil
+}
+
+// Reads a type that may be an array of arrays or a tuple with components
+func readType(typ string, components []ArgumentJSON) (EVMType, error) {
+	m := isArrayType.FindStringSubmatch(typ)
+	if m != nil {
+		elem, err := readType(m[1], components)
+		if err != nil {
+			return nil, err
+		}
+		array := EVMArray{Elem: elem}
+		if m[2] != "" {
+			array.Length, err = strconv.ParseUint(m[2], 10, 32)
 			if err != nil {
 				return nil, err
 			}
-			N, err := strconv.ParseUint(m[3], 10, 32)
-			if err != nil {
-				return nil, err
+		}
+		return array, nil
+	}
+
+	m = isMType.FindStringSubmatch(typ)
+	if m != nil {
+		M, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		switch m[1] {
+		case "bytes":
+			if M < 1 || M > 32 {
+				return nil, fmt.Errorf("bytes%d is not valid type", M)
 			}
+			return EVMBytes{M}, nil
+		case "uint":
 			if M < 8 || M > 256 || (M%8) != 0 {
-				return nil, fmt.Errorf("%s is not valid type", baseType)
-			}
-			if N <= 0 || N > 80 {
-				return nil, fmt.Errorf("%s is not valid type", baseType)
+				return nil, fmt.Errorf("uint%d is not valid type", M)
 			}
-			if m[1] == "fixed" {
-				args[i].EVM = EVMFixed{N: N, M: M, signed: true}
-			} else if m[1] == "ufixed" {
-				args[i].EVM = EVMFixed{N: N, M: M, signed: false}
-			} else {
-				panic(m[1])
+			return EVMUint{M}, nil
+		default:
+			if M < 8 || M > 256 || (M%8) != 0 {
+				return nil, fmt.Errorf("int%d is not valid type", M)
 			}
-			continue
+			return EVMInt{M}, nil
 		}
-		switch baseType {
-		case "uint":
-			args[i].EVM = EVMUint{M: 256}
-		case "int":
-			args[i].EVM = EVMInt{M: 256}
-		case "address":
-			args[i].EVM = EVMAddress{}
-		case "bool":
-			args[i].EVM = EVMBool{}
-		case "fixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: true}
-		case "ufixed":
-			args[i].EVM = EVMFixed{M: 128, N: 8, signed: false}
-		case "bytes":
-			args[i].EVM = EVMBytes{M: 0}
-		case "string":
-			args[i].EVM = EVMString{}
+	}
+
+	m = isMxNType.FindStringSubmatch(typ)
+	if m != nil {
+		M, err := strconv.ParseUint(m[2], 10, 32)
+		if err != nil {
+			return nil, err
 		}
+		N, err := strconv.ParseUint(m[3], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if M < 8 || M > 256 || (M%8) != 0 {
+			return nil, fmt.Errorf("%s is not valid type", typ)
+		}
+		if N <= 0 || N > 80 {
+			return nil, fmt.Errorf("%s is not valid type", typ)
+		}
+		return EVMFixed{N: N, M: M, signed: m[1] == "fixed"}, nil
 	}
 
-	return args, nil
+	switch typ {
+	case "uint":
+		return EVMUint{M: 256}, nil
+	case "int":
+		return EVMInt{M: 256}, nil
+	case "address":
+		return EVMAddress{}, nil
+	case "bool":
+		return EVMBool{}, nil
+	case "fixed":
+		return EVMFixed{M: 128, N: 8, signed: true}, nil
+	case "ufixed":
+		return EVMFixed{M: 128, N: 8, signed: false}, nil
+	case "bytes":
+		return EVMBytes{M: 0}, nil
+	case "string":
+		return EVMString{}, nil
+	case "function":
+		// An address followed by a function selector
+		return EVMBytes{M: 24}, nil
+	case "tuple":
+		args, err := readArgSpec(components)
+		if err != nil {
+			return nil, err
+		}
+		return EVMTuple{Components: args}, nil
+	}
+	return nil, fmt.Errorf("%s is not a known type", typ)
 }
 
 func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
@@ -848,8 +1048,9 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 	}
 
 	abiSpec := AbiSpec{
-		Events:    make(map[string]Event),
-		Functions: make(map[string]FunctionSpec),
+		Events:     make(map[string]Event),
+		EventsByID: make(map[EventID]Event),
+		Functions:  make(map[string]FunctionSpec),
 	}
 
 	for _, s := range specJ {
@@ -867,7 +1068,10 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 			if err != nil {
 				return nil, err
 			}
-			abiSpec.Events[s.Name] = Event{Inputs: inputs}
+			ev := Event{Name: s.Name, Inputs: inputs, Anonymous: s.Anonymous}
+			ev.SetEventID(s.Name)
+			abiSpec.Events[s.Name] = ev
+			abiSpec.EventsByID[ev.EventID] = ev
 		case "function":
 			inputs, err := readArgSpec(s.Inputs)
 			if err != nil {
@@ -978,22 +1182,7 @@ func SpecFromFunctionReflect(fname string, v reflect.Value, skipIn, skipOut int)
 }
 
 func (functionSpec *FunctionSpec) SetFunctionID(functionName string) {
-	sig := functionName + "("
-	for i, a := range functionSpec.Inputs {
-		if i > 0 {
-			sig += ","
-		}
-		sig += a.EVM.GetSignature()
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				sig += fmt.Sprintf("[%d]", a.ArrayLength)
-			} else {
-				sig += "[]"
-			}
-		}
-	}
-	sig += ")"
-	functionSpec.FunctionID = GetFunctionID(sig)
+	functionSpec.FunctionID = GetFunctionID(signature(functionName, functionSpec.Inputs))
 }
 
 func (fs FunctionID) Bytes() []byte {
@@ -1007,6 +1196,33 @@ func GetFunctionID(signature string) (id FunctionID) {
 	return
 }
 
+// SetEventID sets the EventID, which is the topic a non-anonymous event is logged under
+func (e *Event) SetEventID(eventName string) {
+	e.EventID = GetEventID(signature(eventName, e.Inputs))
+}
+
+func (id EventID) Bytes() []byte {
+	return id[:]
+}
+
+func GetEventID(signature string) (id EventID) {
+	hash := sha3.NewKeccak256()
+	hash.Write([]byte(signature))
+	copy(id[:], hash.Sum(nil))
+	return
+}
+
+func signature(name string, args []Argument) string {
+	sig := name + "("
+	for i, a := range args {
+		if i > 0 {
+			sig += ","
+		}
+		sig += a.evmType().GetSignature()
+	}
+	return sig + ")"
+}
+
 func (abiSpec *AbiSpec) Pack(fname string, args ...interface{}) ([]byte, error) {
 	var funcSpec FunctionSpec
 	var argSpec []Argument
@@ -1064,97 +1280,136 @@ func Pack(argSpec []Argument, args ...interface{}) ([]byte, error) {
 }
 
 func pack(argSpec []Argument, getArg func(int) interface{}) ([]byte, error) {
-	packed := make([]byte, 0)
-	packedDynamic := []byte{}
+	types := make([]EVMType, len(argSpec))
+	values := make([]interface{}, len(argSpec))
+	for i, a := range argSpec {
+		types[i] = a.evmType()
+		values[i] = getArg(i)
+	}
+	return packSequence(types, values)
+}
+
+// Packs the arguments of a call, or the elements of an array or tuple. Anything dynamic is stored after the "fixed"
+// head of the sequence. For the dynamic types, the head contains byte offsets from its start to the data. We need to
+// know the length of the head, so we can calculate the offsets.
+func packSequence(types []EVMType, values []interface{}) ([]byte, error) {
 	fixedSize := 0
-	// Anything dynamic is stored after the "fixed" block. For the dynamic types, the fixed
-	// block contains byte offsets to the data. We need to know the length of the fixed
-	// block, so we can calcute the offsets
-	for _, a := range argSpec {
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				fixedSize += ElementSize * int(a.ArrayLength)
-			} else {
-				fixedSize += ElementSize
-			}
-		} else {
-			fixedSize += ElementSize
-		}
+	for _, t := range types {
+		fixedSize += headSize(t)
 	}
 
-	addArg := func(v interface{}, a Argument) error {
-		var b []byte
-		var err error
-		if a.EVM.isDynamic() {
-			offset := EVMUint{M: 256}
-			b, _ = offset.pack(fixedSize)
-			d, err := a.EVM.pack(v)
+	packed := make([]byte, 0, fixedSize)
+	packedDynamic := []byte{}
+	offset := EVMUint{M: 256}
+	for i, t := range types {
+		d, err := t.pack(values[i])
+		if err != nil {
+			return nil, err
+		}
+		if t.isDynamic() {
+			b, err := offset.pack(fixedSize + len(packedDynamic))
 			if err != nil {
-				return err
+				return nil, err
 			}
-			fixedSize += len(d)
+			packed = append(packed, b...)
 			packedDynamic = append(packedDynamic, d...)
 		} else {
-			b, err = a.EVM.pack(v)
-			if err != nil {
-				return err
-			}
+			packed = append(packed, d...)
 		}
-		packed = append(packed, b...)
-		return nil
 	}
 
-	for i, as := range argSpec {
-		a := getArg(i)
-		if as.IsArray {
-			s, ok := a.(string)
-			if ok && s[0:1] == "[" && s[len(s)-1:] == "]" {
-				a = strings.Split(s[1:len(s)-1], ",")
-			}
+	return append(packed, packedDynamic...), nil
+}
 
-			val := reflect.ValueOf(a)
-			if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
-				return nil, fmt.Errorf("argument %d should be array or slice, not %s", i, val.Kind().String())
-			}
+// The number of bytes a value takes up in the head of a sequence; a dynamic value only leaves its offset there whereas
+// static arrays and tuples are stored in place
+func headSize(t EVMType) int {
+	if t.isDynamic() {
+		return ElementSize
+	}
+	switch t := t.(type) {
+	case EVMArray:
+		return int(t.Length) * headSize(t.Elem)
+	case EVMTuple:
+		size := 0
+		for _, c := range t.types() {
+			size += headSize(c)
+		}
+		return size
+	}
+	return ElementSize
+}
 
-			if as.ArrayLength > 0 {
-				if as.ArrayLength != uint64(val.Len()) {
-					return nil, fmt.Errorf("argumment %d should be array of %d, not %d", i, as.ArrayLength, val.Len())
-				}
+// The elements of an array or tuple given as a slice, an array, a struct, or a string such as [1,2] or (1,[a,b])
+func listValues(v interface{}) ([]interface{}, error) {
+	if s, ok := v.(string); ok {
+		elems, ok := splitList(s)
+		if !ok {
+			return nil, fmt.Errorf("%s should be a list of values such as [a,b]", s)
+		}
+		values := make([]interface{}, len(elems))
+		for i, e := range elems {
+			values[i] = e
+		}
+		return values, nil
+	}
 
-				for n := 0; n < val.Len(); n++ {
-					err := addArg(val.Index(n).Interface(), as)
-					if err != nil {
-						return nil, err
-					}
-				}
-			} else {
-				// dynamic array
-				offset := EVMUint{M: 256}
-				b, _ := offset.pack(fixedSize)
-				packed = append(packed, b...)
-				fixedSize += len(b)
-
-				// store length
-				b, _ = offset.pack(val.Len())
-				packedDynamic = append(packedDynamic, b...)
-				for n := 0; n < val.Len(); n++ {
-					d, err := as.EVM.pack(val.Index(n).Interface())
-					if err != nil {
-						return nil, err
-					}
-					packedDynamic = append(packedDynamic, d...)
-				}
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Ptr:
+		if !val.IsNil() {
+			return listValues(val.Elem().Interface())
+		}
+	case reflect.Slice, reflect.Array:
+		values := make([]interface{}, val.Len())
+		for i := range values {
+			values[i] = val.Index(i).Interface()
+		}
+		return values, nil
+	case reflect.Struct:
+		values := make([]interface{}, val.NumField())
+		for i := range values {
+			values[i] = val.Field(i).Interface()
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("%s is not an array, slice, struct or string", val.Kind().String())
+}
+
+// Splits a string such as [a,[b,c]] or (a,(b,c)) into its top level elements
+func splitList(s string) ([]string, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || !(s[0] == '[' && s[len(s)-1] == ']' || s[0] == '(' && s[len(s)-1] == ')') {
+		return nil, false
+	}
+	s = s[1 : len(s)-1]
+	if strings.TrimSpace(s) == "" {
+		return []string{}, true
+	}
+
+	var elems []string
+	depth := 0
+	start := 0
+	for i, c := range s {
+		switch c {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+			if depth < 0 {
+				return nil, false
 			}
-		} else {
-			err := addArg(a, as)
-			if err != nil {
-				return nil, err
+		case ',':
+			if depth == 0 {
+				elems = append(elems, strings.TrimSpace(s[start:i]))
+				start = i + 1
 			}
 		}
 	}
-
-	return append(packed, packedDynamic...), nil
+	if depth != 0 {
+		return nil, false
+	}
+	return append(elems, strings.TrimSpace(s[start:])), true
 }
 
 func GetPackingTypes(args []Argument) []interface{} {
@@ -1186,125 +1441,179 @@ func Unpack(argSpec []Argument, data []byte, args ...interface{}) error {
 }
 
 func unpack(argSpec []Argument, data []byte, getArg func(int) interface{}) error {
-	offset := 0
+	types := make([]EVMType, len(argSpec))
+	values := make([]interface{}, len(argSpec))
+	for i, a := range argSpec {
+		types[i] = a.evmType()
+		values[i] = getArg(i)
+	}
+	return unpackSequence(types, data, 0, values)
+}
+
+// Unpacks a sequence starting at offset in data, which dynamic values are stored after at offsets relative to start
+// of the sequence
+func unpackSequence(types []EVMType, data []byte, offset int, values []interface{}) error {
 	offType := EVMInt{M: 64}
+	head := offset
 
-	getPrimitive := func(e interface{}, a Argument) error {
-		if a.EVM.isDynamic() {
+	for i, t := range types {
+		if head+headSize(t) > len(data) {
+			return fmt.Errorf("%d bytes is too short to unpack %s", len(data), t.GetSignature())
+		}
+		if t.isDynamic() {
 			var o int64
-			l, err := offType.unpack(data, offset, &o)
+			l, err := offType.unpack(data, head, &o)
 			if err != nil {
 				return err
 			}
-			offset += l
-			l, err = a.EVM.unpack(data, int(o), e)
+			head += l
+			if o < 0 || int64(offset)+o+ElementSize > int64(len(data)) {
+				return fmt.Errorf("offset %d of %s is outside of the %d bytes to unpack", o, t.GetSignature(),
+					len(data))
+			}
+			_, err = t.unpack(data, offset+int(o), values[i])
 			if err != nil {
 				return err
 			}
 		} else {
-			l, err := a.EVM.unpack(data, offset, e)
+			l, err := t.unpack(data, head, values[i])
 			if err != nil {
 				return err
 			}
-			offset += l
+			head += l
 		}
-
-		return nil
 	}
 
-	for i, a := range argSpec {
-		arg := getArg(i)
-		if a.IsArray {
-			var array *[]interface{}
-
-			array, ok := arg.(*[]interface{})
-			if !ok {
-				if _, ok := arg.(*string); ok {
-					// We have been asked to return the value as a string; make intermediate
-					// array of strings; we will concatenate after
-					intermediate := make([]interface{}, a.ArrayLength)
-					for i, _ := range intermediate {
-						intermediate[i] = new(string)
-					}
-					array = &intermediate
-				} else {
-					return fmt.Errorf("argument %d should be array, slice or string", i)
-				}
-			}
-
-			if a.ArrayLength > 0 {
-				if int(a.ArrayLength) != len(*array) {
-					return fmt.Errorf("argument %d should be array or slice of %d elements", i, a.ArrayLength)
-				}
+	return nil
+}
 
-				for n := 0; n < len(*array); n++ {
-					err := getPrimitive((*array)[n], a)
-					if err != nil {
-						return err
-					}
-				}
-			} else {
-				var o int64
-				var length int64
+// Unpacks the elements of an array or tuple into v, which should be a pointer to a string, to a []interface{} of
+// pointers to unpack each element into, or to a slice, array or struct
+func unpackList(types []EVMType, data []byte, offset int, v interface{}, open, close string) error {
+	switch v := v.(type) {
+	case *string:
+		// We have been asked to return the value as a string; unpack the elements as strings and concatenate
+		elems := make([]interface{}, len(types))
+		for i := range elems {
+			elems[i] = new(string)
+		}
+		err := unpackSequence(types, data, offset, elems)
+		if err != nil {
+			return err
+		}
+		strs := make([]string, len(elems))
+		for i, e := range elems {
+			strs[i] = *(e.(*string))
+		}
+		*v = open + strings.Join(strs, ",") + close
+		return nil
+	case *[]interface{}:
+		if len(*v) != len(types) {
+			*v = make([]interface{}, len(types))
+			for i, t := range types {
+				(*v)[i] = goPointer(t)
+			}
+		}
+		return unpackSequence(types, data, offset, *v)
+	}
 
-				l, err := offType.unpack(data, offset, &o)
-				if err != nil {
-					return err
-				}
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return fmt.Errorf("cannot unpack %d elements into %s", len(types), val.Kind().String())
+	}
+	val = val.Elem()
+	elems := make([]interface{}, len(types))
+	switch val.Kind() {
+	case reflect.Slice:
+		val.Set(reflect.MakeSlice(val.Type(), len(types), len(types)))
+		fallthrough
+	case reflect.Array:
+		if val.Len() != len(types) {
+			return fmt.Errorf("cannot unpack %d elements into array of %d", len(types), val.Len())
+		}
+		for i := range elems {
+			elems[i] = target(val.Index(i))
+		}
+	case reflect.Struct:
+		if val.NumField() != len(types) {
+			return fmt.Errorf("cannot unpack %d elements into struct of %d fields", len(types), val.NumField())
+		}
+		for i := range elems {
+			elems[i] = target(val.Field(i))
+		}
+	default:
+		return fmt.Errorf("cannot unpack %d elements into %s", len(types), val.Kind().String())
+	}
+	return unpackSequence(types, data, offset, elems)
+}
 
-				offset += l
-				s, err := offType.unpack(data, int(o), &length)
-				if err != nil {
-					return err
-				}
-				o += int64(s)
-
-				intermediate := make([]interface{}, length)
-
-				if _, ok := arg.(*string); ok {
-					// We have been asked to return the value as a string; make intermediate
-					// array of strings; we will concatenate after
-					for i, _ := range intermediate {
-						intermediate[i] = new(string)
-					}
-				} else {
-					for i, _ := range intermediate {
-						intermediate[i] = a.EVM.getGoType()
-					}
-				}
+// What to unpack an element held in val into; pointers such as *big.Int are unpacked into directly once allocated
+func target(val reflect.Value) interface{} {
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+		return val.Interface()
+	}
+	return val.Addr().Interface()
+}
 
-				for i := 0; i < int(length); i++ {
-					l, err = a.EVM.unpack(data, int(o), intermediate[i])
-					if err != nil {
-						return err
-					}
-					o += int64(l)
-				}
+// A pointer to a new value of the Go type for t
+func goPointer(t EVMType) interface{} {
+	v := t.getGoType()
+	if reflect.TypeOf(v).Kind() == reflect.Ptr {
+		return v
+	}
+	p := reflect.New(reflect.TypeOf(v))
+	p.Elem().Set(reflect.ValueOf(v))
+	return p.Interface()
+}
 
-				array = &intermediate
-			}
+// UnpackEvent decodes the arguments of an event from the topics and data of a log. Indexed arguments are read from
+// topics, the remaining arguments are unpacked from data. Indexed arguments of dynamic type are logged as the Keccak
+// hash of their value so can only be returned as raw bytes (or hex when a string is requested).
+func UnpackEvent(eventSpec *Event, topics []burrow_binary.Word256, data []byte, args ...interface{}) error {
+	if len(args) != len(eventSpec.Inputs) {
+		return fmt.Errorf("%d arguments expected, %d received", len(eventSpec.Inputs), len(args))
+	}
+	if !eventSpec.Anonymous {
+		if len(topics) == 0 || !bytes.Equal(topics[0].Bytes(), eventSpec.EventID[:]) {
+			return fmt.Errorf("first topic does not match EventID of event %s", eventSpec.Name)
+		}
+		topics = topics[1:]
+	}
 
-			// If we were supposed to return a string, convert it back
-			if ret, ok := arg.(*string); ok {
-				s := "["
-				for i, e := range *array {
-					if i > 0 {
-						s += ","
-					}
-					s += *(e.(*string))
-				}
-				s += "]"
-				*ret = s
+	var dataSpec []Argument
+	var dataArgs []interface{}
+	topic := 0
+	for i, a := range eventSpec.Inputs {
+		if !a.Indexed {
+			dataSpec = append(dataSpec, a)
+			dataArgs = append(dataArgs, args[i])
+			continue
+		}
+		if topic >= len(topics) {
+			return fmt.Errorf("not enough topics to unpack indexed argument %d of event %s", i, eventSpec.Name)
+		}
+		if _, ok := a.EVM.(EVMTuple); ok || a.IsArray || a.EVM.isDynamic() {
+			switch v := args[i].(type) {
+			case *string:
+				*v = hex.EncodeUpperToString(topics[topic].Bytes())
+			case *[]byte:
+				*v = topics[topic].Bytes()
+			default:
+				return fmt.Errorf("indexed argument %d of event %s is hashed so can only be unpacked to string or bytes",
+					i, eventSpec.Name)
 			}
 		} else {
-			err := getPrimitive(arg, a)
+			_, err := a.EVM.unpack(topics[topic].Bytes(), 0, args[i])
 			if err != nil {
 				return err
 			}
 		}
+		topic++
 	}
-
-	return nil
+	return Unpack(dataSpec, data, dataArgs...)
 }
 
 // quick helper padding
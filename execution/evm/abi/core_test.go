@@ -3,9 +3,13 @@ package abi
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tmthrgd/go-hex"
 )
@@ -121,6 +125,30 @@ func TestPacker(t *testing.T) {
 			"arrayOfBoolsPack",
 			append(pad([]byte{1}, 32, true), pad([]byte{0}, 32, true)...),
 		},
+		{
+			`[{"constant":false,"inputs":[{"name":"","type":"uint256[][]"},{"name":"","type":"string[]"}],"name":"nestedArraysPack","outputs":[],"payable":false,"type":"function"}]`,
+			[]string{"[[1,2],[3]]", "[one,two,three]"},
+			"nestedArraysPack",
+			nestedArrays(t),
+		},
+		{
+			`[{"constant":false,"inputs":[{"name":"","type":"string[2]"}],"name":"arrayOfStringsPack","outputs":[],"payable":false,"type":"function"}]`,
+			[]string{"[a,b]"},
+			"arrayOfStringsPack",
+			arrayOfStrings(t),
+		},
+		{
+			`[{"constant":false,"inputs":[{"name":"s","type":"tuple","components":[{"name":"a","type":"uint256"},{"name":"b","type":"string"}]},{"name":"","type":"bool"}],"name":"tuplePack","outputs":[],"payable":false,"type":"function"}]`,
+			[]string{"(5,abc)", "true"},
+			"tuplePack",
+			dynamicTuple(t),
+		},
+		{
+			`[{"constant":false,"inputs":[{"name":"","type":"tuple[2]","components":[{"name":"a","type":"uint256"},{"name":"b","type":"bool"}]}],"name":"arrayOfTuplesPack","outputs":[],"payable":false,"type":"function"}]`,
+			[]string{"[(1,true),(2,false)]"},
+			"arrayOfTuplesPack",
+			hexToBytes(t, words("1", "1", "2", "0")),
+		},
 	} {
 		t.Log(test.args)
 		fmt.Println(test.name)
@@ -305,6 +333,47 @@ func TestUnpacker(t *testing.T) {
 				},
 			},
 		},
+		{
+			`[{"constant":false,"inputs":[],"name":"nestedArraysReturn","outputs":[{"name":"","type":"uint256[][]"},{"name":"","type":"string[]"}],"payable":false,"type":"function"}]`,
+			nestedArrays(t),
+			"nestedArraysReturn",
+			[]Variable{
+				{
+					Name:  "0",
+					Value: "[[1,2],[3]]",
+				},
+				{
+					Name:  "1",
+					Value: "[one,two,three]",
+				},
+			},
+		},
+		{
+			`[{"constant":false,"inputs":[],"name":"arrayOfStringsReturn","outputs":[{"name":"","type":"string[2]"}],"payable":false,"type":"function"}]`,
+			arrayOfStrings(t),
+			"arrayOfStringsReturn",
+			[]Variable{
+				{
+					Name:  "0",
+					Value: "[a,b]",
+				},
+			},
+		},
+		{
+			`[{"constant":false,"inputs":[],"name":"tupleReturn","outputs":[{"name":"s","type":"tuple","components":[{"name":"a","type":"uint256"},{"name":"b","type":"string"}]},{"name":"ok","type":"bool"}],"payable":false,"type":"function"}]`,
+			dynamicTuple(t),
+			"tupleReturn",
+			[]Variable{
+				{
+					Name:  "s",
+					Value: "(5,abc)",
+				},
+				{
+					Name:  "ok",
+					Value: "true",
+				},
+			},
+		},
 	} {
 		//t.Log(test.name)
 		t.Log(test.packed)
@@ -326,6 +395,116 @@ func TestUnpacker(t *testing.T) {
 	}
 }
 
+func TestUnpackEvent(t *testing.T) {
+	abiSpec, err := ReadAbiSpec([]byte(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"name","type":"bytes32"},` +
+		`{"indexed":false,"name":"description","type":"string"},{"indexed":true,"name":"tag","type":"string"},` +
+		`{"indexed":false,"name":"value","type":"uint64"}],"name":"UpdateTestEvents","type":"event"}]`))
+	require.NoError(t, err)
+
+	eventSpec := abiSpec.Events["UpdateTestEvents"]
+	require.Equal(t, eventSpec, abiSpec.EventsByID[eventSpec.EventID])
+	require.Equal(t, GetEventID("UpdateTestEvents(bytes32,string,string,uint64)"), eventSpec.EventID)
+
+	data, err := Pack([]Argument{eventSpec.Inputs[1], eventSpec.Inputs[3]}, "Marmots rule", uint64(42))
+	require.NoError(t, err)
+	tagHash := binary.LeftPadWord256([]byte{0xab, 0xcd})
+	topics := []binary.Word256{
+		binary.LeftPadWord256(eventSpec.EventID.Bytes()),
+		binary.RightPadWord256([]byte("marmot")),
+		tagHash,
+	}
+
+	var name, description, tag string
+	var value uint64
+	err = UnpackEvent(&eventSpec, topics, data, &name, &description, &tag, &value)
+	require.NoError(t, err)
+	assert.Equal(t, "marmot", name)
+	assert.Equal(t, "Marmots rule", description)
+	assert.Equal(t, hex.EncodeUpperToString(tagHash.Bytes()), tag)
+	assert.Equal(t, uint64(42), value)
+
+	err = UnpackEvent(&eventSpec, topics[1:], data, &name, &description, &tag, &value)
+	assert.Error(t, err, "should not unpack log with mismatched EventID")
+}
+
+func TestTuples(t *testing.T) {
+	abiSpec, err := ReadAbiSpec([]byte(`[{"inputs":[{"name":"ss","type":"tuple[]","components":[` +
+		`{"name":"a","type":"uint256"},{"name":"b","type":"string"}]},{"name":"xs","type":"uint8[2][]"}],` +
+		`"name":"f","outputs":[],"type":"function"}]`))
+	require.NoError(t, err)
+	f := abiSpec.Functions["f"]
+	assert.Equal(t, GetFunctionID("f((uint256,string)[],uint8[2][])"), f.FunctionID)
+
+	type S struct {
+		A uint64
+		B string
+	}
+	ss := []S{{A: 5, B: "abc"}, {A: 6, B: "def"}}
+	xs := [][2]uint8{{1, 2}, {3, 4}}
+	data, err := Pack(f.Inputs, ss, xs)
+	require.NoError(t, err)
+
+	var ssOut []S
+	var xsOut [][2]uint8
+	require.NoError(t, Unpack(f.Inputs, data, &ssOut, &xsOut))
+	assert.Equal(t, ss, ssOut)
+	assert.Equal(t, xs, xsOut)
+
+	var ssString, xsString string
+	require.NoError(t, Unpack(f.Inputs, data, &ssString, &xsString))
+	assert.Equal(t, "[(5,abc),(6,def)]", ssString)
+	assert.Equal(t, "[[1,2],[3,4]]", xsString)
+
+	data2, err := Pack(f.Inputs, []interface{}{map[string]interface{}{"a": 5, "b": "abc"}, "(6,def)"}, "[[1,2],[3,4]]")
+	require.NoError(t, err)
+	assert.Equal(t, data, data2)
+
+	err = Unpack(f.Inputs, data[:len(data)-ElementSize], &ssOut, &xsOut)
+	assert.Error(t, err, "should not unpack truncated data")
+}
+
+func TestGoTypes(t *testing.T) {
+	args := []Argument{{EVM: EVMUint{M: 256}}, {EVM: EVMInt{M: 256}}, {EVM: EVMUint{M: 32}}, {EVM: EVMAddress{}}}
+	address := crypto.Address{1, 2, 3}
+	data, err := Pack(args, big.NewInt(1000), big.NewInt(-1000), uint32(70000), address)
+	require.NoError(t, err)
+
+	u, i := new(big.Int), new(big.Int)
+	var small uint32
+	var addressOut crypto.Address
+	require.NoError(t, Unpack(args, data, u, i, &small, &addressOut))
+	assert.Equal(t, int64(1000), u.Int64())
+	assert.Equal(t, int64(-1000), i.Int64())
+	assert.Equal(t, uint32(70000), small)
+	assert.Equal(t, address, addressOut)
+}
+
+// The encoding of ([[1,2],[3]],[one,two,three]) as given by the ABI specification
+func nestedArrays(t testing.TB) []byte {
+	return hexToBytes(t, words("40", "140", "2", "40", "a0", "2", "1", "2", "1", "3", "3", "60", "a0", "e0",
+		"3", "6f6e65"+strings.Repeat("0", 58), "3", "74776f"+strings.Repeat("0", 58),
+		"5", "7468726565"+strings.Repeat("0", 54)))
+}
+
+// The encoding of [a,b] as string[2]
+func arrayOfStrings(t testing.TB) []byte {
+	return hexToBytes(t, words("20", "40", "80", "1", "61"+strings.Repeat("0", 62), "1", "62"+strings.Repeat("0", 62)))
+}
+
+// The encoding of ((5,abc),true) as ((uint256,string),bool)
+func dynamicTuple(t testing.TB) []byte {
+	return hexToBytes(t, words("40", "1", "5", "40", "3", "616263"+strings.Repeat("0", 58)))
+}
+
+// Left pads each hex string that is shorter than a word to a word and concatenates them
+func words(hexWords ...string) string {
+	s := ""
+	for _, w := range hexWords {
+		s += strings.Repeat("0", 2*ElementSize-len(w)) + w
+	}
+	return s
+}
+
 func hexToBytes(t testing.TB, hexString string) []byte {
 	bs, err := hex.DecodeString(hexString)
 	require.NoError(t, err)
@@ -158,6 +158,10 @@ func newExecutor(name string, runCall bool, backend ExecutorState, blockchain *b
 			StateWriter: exe.stateCache,
 			Logger:      exe.logger,
 		},
+		payload.TypeRekey: &contexts.RekeyContext{
+			StateWriter: exe.stateCache,
+			Logger:      exe.logger,
+		},
 	}
 	return exe
 }
@@ -326,18 +330,15 @@ func (exe *executor) finaliseBlockExecution(header *abciTypes.Header) (*exec.Blo
 // Capture public keys and update sequence numbers
 func (exe *executor) updateSignatories(txEnv *txs.Envelope) error {
 	for _, sig := range txEnv.Signatories {
-		// pointer dereferences are safe since txEnv.Validate() is run by txEnv.Verify() above which checks they are
-		// non-nil
 		acc, err := state.GetMutableAccount(exe.stateCache, *sig.Address)
 		if err != nil {
 			return fmt.Errorf("error getting account on which to set public key: %v", *sig.Address)
 		}
-		// Important that verify has been run against signatories at this point
-		if sig.PublicKey.Address() != acc.Address() {
-			return fmt.Errorf("unexpected mismatch between address %v and supplied public key %v",
-				acc.Address(), sig.PublicKey)
+		// Signatories were checked by txEnv.Verify so we need only learn keys not yet bound (a RekeyTx may have
+		// just bound a new key which we must not overwrite)
+		if !acc.PublicKey().IsSet() {
+			acc.SetPublicKey(*sig.PublicKey)
 		}
-		acc.SetPublicKey(*sig.PublicKey)
 
 		exe.logger.TraceMsg("Incrementing sequence number Tx signatory/input",
 			"tag", "sequence",
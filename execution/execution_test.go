@@ -40,6 +40,7 @@ import (
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tmthrgd/go-hex"
@@ -396,6 +397,31 @@ func TestCallPermission(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestConsecutiveDeploys(t *testing.T) {
+	require.NoError(t, crypto.SetAddressDerivation(crypto.AddressDerivationEthereum))
+	defer crypto.SetAddressDerivation("")
+	stateDB := dbm.NewDB("state", dbBackend, dbDir)
+	defer stateDB.Close()
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[0].Permissions.Base.Set(permission.CreateContract, true)
+	st, err := MakeGenesisState(stateDB, &genDoc)
+	require.NoError(t, err)
+	exe := makeExecutor(st)
+
+	// Each deploy increments the sequence once for the new contract and once for the transaction, so an account's
+	// consecutive deploys are at sequences 1 and 3 rather than at Ethereum's nonces 0 and 1
+	contractCode := []byte{0x60}
+	for _, sequence := range []uint64{1, 3} {
+		tx, _ := payload.NewCallTx(exe.stateCache, users[0].PublicKey(), nil, wrapContractForCreate(contractCode),
+			100, 100, 100)
+		require.Equal(t, sequence, tx.Input.Sequence)
+		require.NoError(t, exe.signExecuteCommit(tx, users[0]))
+		contractAcc := getAccount(exe.stateCache, crypto.NewContractAddress(users[0].Address(), sequence))
+		require.NotNil(t, contractAcc)
+		assert.Equal(t, contractCode, contractAcc.Code().Bytes())
+	}
+}
+
 func TestCreatePermission(t *testing.T) {
 	stateDB := dbm.NewDB("state", dbBackend, dbDir)
 	defer stateDB.Close()
@@ -892,6 +918,64 @@ func TestTxSequence(t *testing.T) {
 	}
 }
 
+func TestRekeyTx(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	exe := makeExecutor(st)
+	oldKey := privAccounts[0]
+	address := oldKey.Address()
+	newKey := acm.GeneratePrivateAccountFromSecret("rotated")
+	// Signs for the account at address with the rotated key
+	rekeyed := acm.ConcretePrivateAccount{
+		Address:    address,
+		PublicKey:  newKey.PublicKey(),
+		PrivateKey: newKey.PrivateKey(),
+	}.PrivateAccount()
+
+	sendTx := func(sequence uint64) *payload.SendTx {
+		return &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: address, Amount: 1, Sequence: sequence}},
+			Outputs: []*payload.TxOutput{{Address: privAccounts[1].Address(), Amount: 1}},
+		}
+	}
+
+	sequence := getAccount(exe.stateCache, address).Sequence()
+	rekeyTx := func(publicKey crypto.PublicKey, prover crypto.Signer) *payload.RekeyTx {
+		tx := payload.NewRekeyTxWithSequence(address, publicKey, sequence+1)
+		require.NoError(t, tx.Prove(testChainID, prover))
+		return tx
+	}
+
+	// Cannot rekey with a key not yet bound to the account
+	err := exe.signExecuteCommit(rekeyTx(newKey.PublicKey(), newKey), rekeyed)
+	require.Error(t, err)
+
+	// Nor without proving the new key is held
+	err = exe.signExecuteCommit(rekeyTx(newKey.PublicKey(), privAccounts[1]), oldKey)
+	require.Error(t, err)
+	err = exe.signExecuteCommit(payload.NewRekeyTxWithSequence(address, newKey.PublicKey(), sequence+1), oldKey)
+	require.Error(t, err)
+
+	// Nor to a key that is not a valid point
+	invalidKey := crypto.PublicKey{CurveType: crypto.CurveTypeSecp256k1, PublicKey: make([]byte, 33)}
+	err = exe.signExecuteCommit(rekeyTx(invalidKey, newKey), oldKey)
+	require.Error(t, err)
+
+	err = exe.signExecuteCommit(rekeyTx(newKey.PublicKey(), newKey), oldKey)
+	require.NoError(t, err)
+	acc := getAccount(exe.stateCache, address)
+	assert.Equal(t, newKey.PublicKey(), acc.PublicKey())
+	assert.Equal(t, sequence+1, acc.Sequence())
+
+	// The old key no longer signs for the account
+	err = exe.signExecuteCommit(sendTx(sequence+2), oldKey)
+	require.Error(t, err)
+
+	balance := getAccount(exe.stateCache, address).Balance()
+	err = exe.signExecuteCommit(sendTx(sequence+2), rekeyed)
+	require.NoError(t, err)
+	assert.Equal(t, balance-1, getAccount(exe.stateCache, address).Balance())
+}
+
 func TestNameTxs(t *testing.T) {
 	st, err := MakeGenesisState(dbm.NewMemDB(), testGenesisDoc)
 	require.NoError(t, err)
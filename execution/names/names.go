@@ -86,6 +86,8 @@ type ReaderWriter interface {
 
 type Iterable interface {
 	IterateNames(consumer func(*Entry) (stop bool)) (stopped bool, err error)
+	// Iterates over names in order starting from start (inclusive)
+	IterateNamesFrom(start string, consumer func(*Entry) (stop bool)) (stopped bool, err error)
 }
 
 type IterableReader interface {
@@ -52,7 +52,6 @@ const (
 
 var (
 	accountsStart, accountsEnd []byte = prefixKeyRange(accountsPrefix)
-	storageStart, storageEnd   []byte = prefixKeyRange(storagePrefix)
 	nameRegStart, nameRegEnd   []byte = prefixKeyRange(nameRegPrefix)
 	lastBlockHeightKey                = []byte("h")
 )
@@ -259,14 +258,20 @@ func (ws *writeState) RemoveAccount(address crypto.Address) error {
 }
 
 func (s *State) IterateAccounts(consumer func(acm.Account) (stop bool)) (stopped bool, err error) {
-	stopped = s.readTree.IterateRange(accountsStart, accountsEnd, true, func(key, value []byte) bool {
-		var account acm.Account
-		account, err = acm.Decode(value)
-		if err != nil {
-			return true
-		}
-		return consumer(account)
-	})
+	return s.IterateAccountsFrom(crypto.Address{}, consumer)
+}
+
+func (s *State) IterateAccountsFrom(start crypto.Address,
+	consumer func(acm.Account) (stop bool)) (stopped bool, err error) {
+	stopped = s.readTree.IterateRange(prefixedKey(accountsPrefix, start.Bytes()), accountsEnd, true,
+		func(key, value []byte) bool {
+			var account acm.Account
+			account, err = acm.Decode(value)
+			if err != nil {
+				return true
+			}
+			return consumer(account)
+		})
 	return
 }
 
@@ -286,20 +291,32 @@ func (ws *writeState) SetStorage(address crypto.Address, key, value binary.Word2
 
 func (s *State) IterateStorage(address crypto.Address,
 	consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error) {
-	stopped = s.readTree.IterateRange(storageStart, storageEnd, true, func(key []byte, value []byte) (stop bool) {
-		// Note: no left padding should occur unless there is a bug and non-words have been writte to this storage tree
-		if len(key) != binary.Word256Length {
-			err = fmt.Errorf("key '%X' stored for account %s is not a %v-byte word",
-				key, address, binary.Word256Length)
-			return true
-		}
-		if len(value) != binary.Word256Length {
-			err = fmt.Errorf("value '%X' stored for account %s is not a %v-byte word",
-				key, address, binary.Word256Length)
-			return true
-		}
-		return consumer(binary.LeftPadWord256(key), binary.LeftPadWord256(value))
-	})
+	return s.IterateStorageRange(address, nil, nil, consumer)
+}
+
+func (s *State) IterateStorageRange(address crypto.Address, start, end []byte,
+	consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error) {
+	prefix := prefixedKey(storagePrefix, address.Bytes())
+	_, rangeEnd := prefixKeyRange(string(prefix))
+	if end != nil {
+		rangeEnd = prefixedKey(string(prefix), end)
+	}
+	stopped = s.readTree.IterateRange(prefixedKey(string(prefix), start), rangeEnd, true,
+		func(key []byte, value []byte) (stop bool) {
+			key = key[len(prefix):]
+			// Note: no left padding should occur unless there is a bug and non-words have been writte to this storage tree
+			if len(key) != binary.Word256Length {
+				err = fmt.Errorf("key '%X' stored for account %s is not a %v-byte word",
+					key, address, binary.Word256Length)
+				return true
+			}
+			if len(value) != binary.Word256Length {
+				err = fmt.Errorf("value '%X' stored for account %s is not a %v-byte word",
+					key, address, binary.Word256Length)
+				return true
+			}
+			return consumer(binary.LeftPadWord256(key), binary.LeftPadWord256(value))
+		})
 	return
 }
 
@@ -409,14 +426,21 @@ func (s *State) GetName(name string) (*names.Entry, error) {
 }
 
 func (s *State) IterateNames(consumer func(*names.Entry) (stop bool)) (stopped bool, err error) {
-	return s.readTree.IterateRange(nameRegStart, nameRegEnd, true, func(key []byte, value []byte) (stop bool) {
-		var entry *names.Entry
-		entry, err = names.DecodeEntry(value)
-		if err != nil {
-			return true
-		}
-		return consumer(entry)
-	}), err
+	return s.IterateNamesFrom("", consumer)
+}
+
+func (s *State) IterateNamesFrom(start string,
+	consumer func(*names.Entry) (stop bool)) (stopped bool, err error) {
+	stopped = s.readTree.IterateRange(prefixedKey(nameRegPrefix, []byte(start)), nameRegEnd, true,
+		func(key, value []byte) bool {
+			var entry *names.Entry
+			entry, err = names.DecodeEntry(value)
+			if err != nil {
+				return true
+			}
+			return consumer(entry)
+		})
+	return
 }
 
 func (ws *writeState) UpdateName(entry *names.Entry) error {
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/state"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
@@ -108,3 +109,68 @@ func asJSON(t *testing.T, v interface{}) string {
 	require.NoError(t, err)
 	return string(bs)
 }
+
+func TestState_IterateAccountsFrom(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	var addresses []crypto.Address
+	_, err := s.Update(func(ws Updatable) error {
+		for i := byte(1); i <= 5; i++ {
+			address := crypto.Address{i}
+			addresses = append(addresses, address)
+			err := ws.UpdateAccount(acm.ConcreteAccount{Address: address}.MutableAccount())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	var listed []crypto.Address
+	_, err = s.IterateAccountsFrom(crypto.Address{3}, func(acc acm.Account) (stop bool) {
+		listed = append(listed, acc.Address())
+		return
+	})
+	require.NoError(t, err)
+	assert.Equal(t, addresses[2:], listed)
+}
+
+func TestState_IterateStorageRange(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	addressA := crypto.Address{1}
+	addressB := crypto.Address{2}
+	keys := []binary.Word256{{0x10}, {0x11, 0x01}, {0x11, 0x02}, {0x12}}
+	_, err := s.Update(func(ws Updatable) error {
+		for _, key := range keys {
+			for _, address := range []crypto.Address{addressA, addressB} {
+				err := ws.SetStorage(address, key, binary.Word256{0xff})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	listStorage := func(start, end []byte) []binary.Word256 {
+		var listed []binary.Word256
+		_, err := s.IterateStorageRange(addressA, start, end, func(key, value binary.Word256) (stop bool) {
+			listed = append(listed, key)
+			return
+		})
+		require.NoError(t, err)
+		return listed
+	}
+	assert.Equal(t, keys, listStorage(nil, nil))
+	assert.Equal(t, keys[1:3], listStorage(state.KeyPrefixRange([]byte{0x11})))
+	assert.Equal(t, keys[2:], listStorage(keys[2].Bytes(), nil))
+
+	var all []binary.Word256
+	_, err = s.IterateStorage(addressA, func(key, value binary.Word256) (stop bool) {
+		all = append(all, key)
+		return
+	})
+	require.NoError(t, err)
+	assert.Equal(t, keys, all)
+}
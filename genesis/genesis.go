@@ -65,6 +65,9 @@ type GenesisDoc struct {
 	GlobalPermissions permission.AccountPermissions
 	Accounts          []Account
 	Validators        []Validator
+	// How addresses are derived from secp256k1 public keys and contract creators, one of 'burrow' (the default) or
+	// 'ethereum' (Keccak-256)
+	AddressDerivation string `json:",omitempty" toml:",omitempty"`
 }
 
 func (genesisDoc *GenesisDoc) JSONString() string {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hyperledger/burrow/acm/balance"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/permission"
@@ -28,6 +29,8 @@ type GenesisSpec struct {
 	Salt              []byte            `json:",omitempty" toml:",omitempty"`
 	GlobalPermissions []string          `json:",omitempty" toml:",omitempty"`
 	Accounts          []TemplateAccount `json:",omitempty" toml:",omitempty"`
+	// See GenesisDoc.AddressDerivation
+	AddressDerivation string `json:",omitempty" toml:",omitempty"`
 }
 
 func (gs *GenesisSpec) RealiseKeys(keyClient keys.KeyClient) error {
@@ -43,6 +46,13 @@ func (gs *GenesisSpec) RealiseKeys(keyClient keys.KeyClient) error {
 // Produce a fully realised GenesisDoc from a template GenesisDoc that may omit values
 func (gs *GenesisSpec) GenesisDoc(keyClient keys.KeyClient, generateNodeKeys bool) (*genesis.GenesisDoc, error) {
 	genesisDoc := new(genesis.GenesisDoc)
+	// Addresses of accounts must be derived as they will be on the chain so the caller must have selected the
+	// chain's address derivation with crypto.SetAddressDerivation before realising the spec
+	if gs.addressDerivation() != crypto.AddressDerivation() {
+		return nil, fmt.Errorf("GenesisSpec has %s address derivation but addresses are being derived with %s "+
+			"address derivation", gs.addressDerivation(), crypto.AddressDerivation())
+	}
+	genesisDoc.AddressDerivation = gs.AddressDerivation
 	if gs.GenesisTime == nil {
 		genesisDoc.GenesisTime = time.Now()
 	} else {
@@ -134,3 +144,10 @@ func GenesisSpecFromJSON(jsonBlob []byte) (*GenesisSpec, error) {
 func accountNameFromIndex(index int) string {
 	return fmt.Sprintf("Account_%v", index)
 }
+
+func (gs *GenesisSpec) addressDerivation() string {
+	if gs.AddressDerivation == "" {
+		return crypto.AddressDerivationBurrow
+	}
+	return gs.AddressDerivation
+}
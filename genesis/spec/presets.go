@@ -87,6 +87,9 @@ func MergeGenesisSpecs(genesisSpecs ...GenesisSpec) GenesisSpec {
 		if genesisSpec.ChainName != "" {
 			mergedGenesisSpec.ChainName = genesisSpec.ChainName
 		}
+		if genesisSpec.AddressDerivation != "" {
+			mergedGenesisSpec.AddressDerivation = genesisSpec.AddressDerivation
+		}
 		// Take the max genesis time
 		if mergedGenesisSpec.GenesisTime == nil ||
 			(genesisSpec.GenesisTime != nil && genesisSpec.GenesisTime.After(*mergedGenesisSpec.GenesisTime)) {
@@ -9,6 +9,7 @@
 
 	It has these top-level messages:
 		TemplateAccount
+		StorageItem
 */
 package spec
 
@@ -17,11 +18,12 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
-import crypto "github.com/hyperledger/burrow/crypto"
 import balance "github.com/hyperledger/burrow/acm/balance"
+import crypto "github.com/hyperledger/burrow/crypto"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 import github_com_hyperledger_burrow_acm "github.com/hyperledger/burrow/acm"
+import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 
 import io "io"
 
@@ -46,6 +48,8 @@ type TemplateAccount struct {
 	Permissions []string                                      `protobuf:"bytes,6,rep,name=Permissions" json:",omitempty" toml:",omitempty"`
 	Roles       []string                                      `protobuf:"bytes,7,rep,name=Roles" json:",omitempty" toml:",omitempty"`
 	Code        *github_com_hyperledger_burrow_acm.Bytecode   `protobuf:"bytes,8,opt,name=Code,proto3,customtype=github.com/hyperledger/burrow/acm.Bytecode" json:"Code,omitempty"`
+	// Storage to write to the account, applied by GovTx
+	Storage []*StorageItem `protobuf:"bytes,9,rep,name=Storage" json:",omitempty" toml:",omitempty"`
 }
 
 func (m *TemplateAccount) Reset()                    { *m = TemplateAccount{} }
@@ -88,12 +92,35 @@ func (m *TemplateAccount) GetRoles() []string {
 	return nil
 }
 
+func (m *TemplateAccount) GetStorage() []*StorageItem {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 func (*TemplateAccount) XXX_MessageName() string {
 	return "spec.TemplateAccount"
 }
+
+type StorageItem struct {
+	Key   github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Key"`
+	Value github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+}
+
+func (m *StorageItem) Reset()                    { *m = StorageItem{} }
+func (m *StorageItem) String() string            { return proto.CompactTextString(m) }
+func (*StorageItem) ProtoMessage()               {}
+func (*StorageItem) Descriptor() ([]byte, []int) { return fileDescriptorSpec, []int{1} }
+
+func (*StorageItem) XXX_MessageName() string {
+	return "spec.StorageItem"
+}
 func init() {
 	proto.RegisterType((*TemplateAccount)(nil), "spec.TemplateAccount")
 	golang_proto.RegisterType((*TemplateAccount)(nil), "spec.TemplateAccount")
+	proto.RegisterType((*StorageItem)(nil), "spec.StorageItem")
+	golang_proto.RegisterType((*StorageItem)(nil), "spec.StorageItem")
 }
 func (m *TemplateAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -198,6 +225,52 @@ func (m *TemplateAccount) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if len(m.Storage) > 0 {
+		for _, msg := range m.Storage {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintSpec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *StorageItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintSpec(dAtA, i, uint64(m.Key.Size()))
+	n5, err := m.Key.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintSpec(dAtA, i, uint64(m.Value.Size()))
+	n6, err := m.Value.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
 	return i, nil
 }
 
@@ -251,6 +324,22 @@ func (m *TemplateAccount) Size() (n int) {
 		l = m.Code.Size()
 		n += 1 + l + sovSpec(uint64(l))
 	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovSpec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StorageItem) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovSpec(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovSpec(uint64(l))
 	return n
 }
 
@@ -543,6 +632,147 @@ func (m *TemplateAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, &StorageItem{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSpec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpec(dAtA[iNdEx:])
@@ -673,30 +903,35 @@ func init() { proto.RegisterFile("spec.proto", fileDescriptorSpec) }
 func init() { golang_proto.RegisterFile("spec.proto", fileDescriptorSpec) }
 
 var fileDescriptorSpec = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc6, 0x34, 0xbd, 0x52, 0xe7, 0x10, 0x9c, 0xa7, 0xe8, 0x86, 0x24, 0x2a, 0x03, 0x11, 0x3a,
-	0x12, 0xa9, 0x4c, 0x30, 0xd1, 0x20, 0x58, 0x90, 0x4e, 0xa7, 0x5c, 0x27, 0xb6, 0xc4, 0x79, 0xa4,
-	0x91, 0xe2, 0x38, 0xb2, 0x1d, 0xa1, 0x6c, 0xfc, 0x34, 0xc6, 0x8c, 0xcc, 0x1d, 0x22, 0xd4, 0x6e,
-	0x8c, 0xfc, 0x02, 0x54, 0xa7, 0xa1, 0x9d, 0x20, 0x0b, 0x93, 0xdf, 0xe7, 0xa7, 0xef, 0xfb, 0x9e,
-	0xde, 0x67, 0x63, 0x2c, 0x2b, 0xa0, 0x7e, 0x25, 0xb8, 0xe2, 0xc4, 0x38, 0xd4, 0xd7, 0x2f, 0xb3,
-	0x5c, 0x6d, 0xea, 0xc4, 0xa7, 0x9c, 0x05, 0x19, 0xcf, 0x78, 0xa0, 0x9b, 0x49, 0xfd, 0x59, 0x23,
-	0x0d, 0x74, 0xd5, 0x93, 0xae, 0x2f, 0xa9, 0x68, 0x2a, 0x35, 0xa0, 0xc7, 0x49, 0x5c, 0xc4, 0x25,
-	0x85, 0x1e, 0x2e, 0xbe, 0x4e, 0xf1, 0x93, 0x35, 0xb0, 0xaa, 0x88, 0x15, 0xac, 0x28, 0xe5, 0x75,
-	0xa9, 0x08, 0xc1, 0xc6, 0x6d, 0xcc, 0xc0, 0x42, 0x2e, 0xf2, 0xe6, 0x91, 0xae, 0x09, 0xc3, 0xb3,
-	0x55, 0x9a, 0x0a, 0x90, 0xd2, 0x7a, 0xe8, 0x22, 0xef, 0x32, 0xbc, 0xdf, 0x76, 0xce, 0xcd, 0xd9,
-	0x20, 0x9b, 0xa6, 0x02, 0x51, 0x40, 0x9a, 0x81, 0x08, 0x92, 0x5a, 0x08, 0xfe, 0x25, 0x38, 0xfa,
-	0x1e, 0x79, 0x3f, 0x3b, 0x07, 0xdf, 0x70, 0x96, 0x2b, 0x60, 0x95, 0x6a, 0x7e, 0x75, 0xce, 0x95,
-	0xe2, 0xac, 0x78, 0xb3, 0x38, 0xdd, 0x2d, 0xa2, 0xc1, 0x83, 0xd4, 0xd8, 0xbc, 0xe5, 0x29, 0x0c,
-	0x96, 0x93, 0xff, 0x67, 0x79, 0xee, 0x43, 0xd6, 0x78, 0x7e, 0x57, 0x27, 0x45, 0x4e, 0x3f, 0x42,
-	0x63, 0x19, 0x2e, 0xf2, 0xcc, 0xe5, 0x95, 0x7f, 0xd4, 0xfc, 0xd3, 0x08, 0x9f, 0x8d, 0xd1, 0x3d,
-	0x09, 0x91, 0x7b, 0x3c, 0x5b, 0xb1, 0xc3, 0x66, 0xa5, 0x35, 0x75, 0x27, 0x9e, 0xb9, 0x7c, 0xea,
-	0x0f, 0x21, 0x84, 0xfd, 0x19, 0x3e, 0x6f, 0x3b, 0xe7, 0xc1, 0xb8, 0x0d, 0xf5, 0x4a, 0xe4, 0x3d,
-	0x36, 0xef, 0x40, 0xb0, 0x5c, 0xca, 0x9c, 0x97, 0xd2, 0xba, 0x70, 0x27, 0xde, 0x7c, 0xdc, 0x64,
-	0xe7, 0x3c, 0xf2, 0x1a, 0x4f, 0x23, 0x5e, 0x80, 0xb4, 0x66, 0xe3, 0x05, 0x7a, 0x06, 0xf9, 0x80,
-	0x8d, 0x77, 0x3c, 0x05, 0xeb, 0x91, 0x0e, 0x67, 0xd9, 0x76, 0x0e, 0xda, 0x76, 0xce, 0x8b, 0xbf,
-	0x07, 0x14, 0x53, 0xe6, 0x87, 0x8d, 0x02, 0xca, 0x53, 0x88, 0x34, 0x3f, 0x7c, 0xdb, 0xee, 0x6c,
-	0xf4, 0x7d, 0x67, 0xa3, 0x1f, 0x3b, 0x1b, 0x7d, 0xdb, 0xdb, 0xa8, 0xdd, 0xdb, 0xe8, 0xd3, 0x3f,
-	0x74, 0x32, 0x28, 0x41, 0xe6, 0x32, 0x38, 0x7c, 0x88, 0xe4, 0x42, 0xbf, 0xe5, 0x57, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x89, 0xe7, 0xcb, 0xa5, 0x2b, 0x03, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xbd, 0x8e, 0xd3, 0x40,
+	0x14, 0x85, 0x99, 0x4d, 0xb2, 0x21, 0xe3, 0x45, 0x90, 0xa9, 0xac, 0x2d, 0x6c, 0x2b, 0x14, 0x58,
+	0x68, 0xd7, 0x96, 0x82, 0x28, 0xa0, 0x22, 0x46, 0xfc, 0x69, 0xa5, 0x55, 0xe4, 0xac, 0x28, 0xe8,
+	0xfc, 0x73, 0xf1, 0x5a, 0xf2, 0x78, 0xac, 0x99, 0xb1, 0xc0, 0xcf, 0xc3, 0x8b, 0x50, 0xa6, 0x44,
+	0x94, 0x29, 0x2c, 0x94, 0xed, 0x28, 0x79, 0x02, 0xe4, 0x71, 0xcc, 0xa6, 0x0a, 0x96, 0x10, 0x95,
+	0xef, 0x9d, 0xd1, 0xf9, 0xee, 0xd1, 0xb9, 0x1e, 0x8c, 0x45, 0x01, 0x91, 0x53, 0x70, 0x26, 0x19,
+	0x19, 0x36, 0xf5, 0xe9, 0x79, 0x92, 0xca, 0xeb, 0x32, 0x74, 0x22, 0x46, 0xdd, 0x84, 0x25, 0xcc,
+	0x55, 0x97, 0x61, 0xf9, 0x51, 0x75, 0xaa, 0x51, 0x55, 0x2b, 0x3a, 0x3d, 0x89, 0x78, 0x55, 0xc8,
+	0xae, 0xbb, 0x17, 0x06, 0x59, 0x90, 0x47, 0xd0, 0xb6, 0xb3, 0xef, 0x23, 0x7c, 0xff, 0x0a, 0x68,
+	0x91, 0x05, 0x12, 0x16, 0x51, 0xc4, 0xca, 0x5c, 0x12, 0x82, 0x87, 0x97, 0x01, 0x05, 0x1d, 0x59,
+	0xc8, 0x9e, 0xf8, 0xaa, 0x26, 0x14, 0x8f, 0x17, 0x71, 0xcc, 0x41, 0x08, 0xfd, 0xc8, 0x42, 0xf6,
+	0x89, 0xb7, 0xda, 0xd4, 0xe6, 0xd9, 0x9e, 0x91, 0xeb, 0xaa, 0x00, 0x9e, 0x41, 0x9c, 0x00, 0x77,
+	0xc3, 0x92, 0x73, 0xf6, 0xc9, 0xdd, 0xcd, 0xdd, 0xe9, 0x7e, 0xd6, 0x26, 0x3e, 0x63, 0x34, 0x95,
+	0x40, 0x0b, 0x59, 0xfd, 0xaa, 0xcd, 0xa9, 0x64, 0x34, 0x7b, 0x3e, 0xbb, 0x3d, 0x9b, 0xf9, 0xdd,
+	0x0c, 0x52, 0x62, 0xed, 0x92, 0xc5, 0xd0, 0x8d, 0x1c, 0xfc, 0xbf, 0x91, 0xfb, 0x73, 0xc8, 0x15,
+	0x9e, 0x2c, 0xcb, 0x30, 0x4b, 0xa3, 0x0b, 0xa8, 0xf4, 0xa1, 0x85, 0x6c, 0x6d, 0x3e, 0x75, 0x76,
+	0xcc, 0x3f, 0x17, 0xde, 0xc3, 0x3e, 0xdc, 0x5b, 0x10, 0x59, 0xe1, 0xf1, 0x82, 0x36, 0xc9, 0x0a,
+	0x7d, 0x64, 0x0d, 0x6c, 0x6d, 0xfe, 0xc0, 0xe9, 0x96, 0xe0, 0xb5, 0x5f, 0xef, 0xd1, 0xba, 0x36,
+	0xef, 0xf4, 0x4b, 0xa8, 0x25, 0x91, 0x57, 0x58, 0x5b, 0x02, 0xa7, 0xa9, 0x10, 0x29, 0xcb, 0x85,
+	0x7e, 0x6c, 0x0d, 0xec, 0x49, 0x3f, 0x67, 0xfb, 0x3a, 0xf2, 0x0c, 0x8f, 0x7c, 0x96, 0x81, 0xd0,
+	0xc7, 0xfd, 0x01, 0xad, 0x82, 0xbc, 0xc6, 0xc3, 0x97, 0x2c, 0x06, 0xfd, 0xae, 0x5a, 0xce, 0x7c,
+	0x5d, 0x9b, 0x68, 0x53, 0x9b, 0x8f, 0x0f, 0x2f, 0x28, 0x88, 0xa8, 0xe3, 0x55, 0x12, 0x22, 0x16,
+	0x83, 0xaf, 0xf4, 0x64, 0x89, 0xc7, 0x2b, 0xc9, 0x78, 0x90, 0x80, 0x3e, 0x51, 0xf1, 0x4c, 0x1d,
+	0xf5, 0xcb, 0xef, 0x0e, 0xdf, 0x49, 0xa0, 0xfd, 0x7c, 0x75, 0x98, 0xd9, 0x17, 0x84, 0xb5, 0x3d,
+	0x35, 0x79, 0x83, 0x07, 0xcd, 0x42, 0x91, 0x32, 0xfa, 0xb4, 0x89, 0x7a, 0x53, 0x9b, 0xe7, 0x87,
+	0x8d, 0x86, 0x69, 0x1e, 0xf0, 0xca, 0x79, 0x0b, 0x9f, 0x1b, 0xbb, 0xc2, 0x6f, 0x08, 0xe4, 0x02,
+	0x8f, 0xde, 0x07, 0x59, 0x09, 0xfa, 0xd1, 0xbf, 0xa0, 0x5a, 0x86, 0xf7, 0x62, 0xbd, 0x35, 0xd0,
+	0xb7, 0xad, 0x81, 0x7e, 0x6c, 0x0d, 0xf4, 0xf5, 0xc6, 0x40, 0xeb, 0x1b, 0x03, 0x7d, 0xf8, 0x4b,
+	0x7e, 0x09, 0xe4, 0x20, 0x52, 0xe1, 0x36, 0x09, 0x85, 0xc7, 0xea, 0x0d, 0x3f, 0xf9, 0x3d, 0x00,
+	0x23, 0xea, 0xbc, 0x18, 0x23, 0x04, 0x00, 0x00,
 }
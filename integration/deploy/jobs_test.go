@@ -0,0 +1,118 @@
+// +build integration
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const sends = 20
+
+func TestConcurrentJobs(t *testing.T) {
+	t.Run("MempoolSigning", func(t *testing.T) {
+		testConcurrentJobs(t, 1, "", "mempool.yaml")
+	})
+	t.Run("LocalSigning", func(t *testing.T) {
+		input := rpctest.PrivateAccounts[2]
+		conn, err := grpc.Dial(testConfig.RPC.GRPC.ListenAddress, grpc.WithInsecure())
+		require.NoError(t, err)
+		_, err = keys.NewKeysClient(conn).Import(context.Background(), &keys.ImportRequest{
+			CurveType: input.PublicKey().CurveType.String(),
+			KeyBytes:  input.PrivateKey().RawBytes(),
+		})
+		require.NoError(t, err)
+		testConcurrentJobs(t, 2, testConfig.RPC.GRPC.ListenAddress, "local.yaml")
+	})
+}
+
+// Sends from one account in concurrently running jobs then queries the outcome
+func testConcurrentJobs(t *testing.T, inputIndex int, signer, yamlPath string) {
+	input := rpctest.PrivateAccounts[inputIndex].Address()
+	output := rpctest.PrivateAccounts[5].Address()
+	qcli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
+	before, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: input})
+	require.NoError(t, err)
+
+	jobList := sendJobs(input, output, sends)
+	do := packages(input, jobList, yamlPath)
+	do.Signer = signer
+	do.Concurrency = sends
+	require.NoError(t, jobs.RunJobs(do))
+
+	assert.Equal(t, fmt.Sprintf("%d", before.Sequence+sends), jobList[sends].Result)
+	for _, job := range jobList[:sends] {
+		assert.NotEmpty(t, job.Result, "job %s should have recorded a tx hash", job.Name)
+	}
+}
+
+func TestResumeJobs(t *testing.T) {
+	input := rpctest.PrivateAccounts[3].Address()
+	output := rpctest.PrivateAccounts[5].Address()
+	jobList := sendJobs(input, output, 3)
+	require.NoError(t, jobs.RunJobs(packages(input, jobList, "resume.yaml")))
+	sequence := jobList[3].Result
+	txHash := jobList[1].Result
+
+	// Nothing is sent again
+	jobList = sendJobs(input, output, 3)
+	require.NoError(t, jobs.RunJobs(packages(input, jobList, "resume.yaml")))
+	assert.Equal(t, sequence, jobList[3].Result)
+	assert.Equal(t, txHash, jobList[1].Result)
+
+	// Unless forced
+	jobList = sendJobs(input, output, 3)
+	do := packages(input, jobList, "resume.yaml")
+	do.Force = []string{"send1"}
+	require.NoError(t, jobs.RunJobs(do))
+	assert.NotEqual(t, sequence, jobList[3].Result)
+	assert.NotEqual(t, txHash, jobList[1].Result)
+
+	// Or changed
+	sequence = jobList[3].Result
+	jobList = sendJobs(input, output, 3)
+	jobList[2].Send.Amount = "2"
+	require.NoError(t, jobs.RunJobs(packages(input, jobList, "resume.yaml")))
+	assert.NotEqual(t, sequence, jobList[3].Result)
+}
+
+// Sends from input to output followed by a query of input's sequence number
+func sendJobs(input, output crypto.Address, n int) []*def.Job {
+	var jobList []*def.Job
+	for i := 0; i < n; i++ {
+		jobList = append(jobList, &def.Job{
+			Name: fmt.Sprintf("send%d", i),
+			Send: &def.Send{Destination: output.String(), Amount: "1"},
+		})
+	}
+	return append(jobList, &def.Job{
+		Name:         "sequence",
+		QueryAccount: &def.QueryAccount{Account: input.String(), Field: "Sequence"},
+	})
+}
+
+func packages(input crypto.Address, jobList []*def.Job, yamlPath string) *def.Packages {
+	return &def.Packages{
+		ChainURL:      testConfig.RPC.GRPC.ListenAddress,
+		DefaultAmount: "1",
+		DefaultFee:    "0",
+		DefaultGas:    "1000000",
+		YAMLPath:      yamlPath,
+		DefaultOutput: "deploy.output.json",
+		Package: &def.Package{
+			Account: input.String(),
+			Jobs:    jobList,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+// +build integration
+
+package deploy
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const counterABI = `[
+  {"type":"function","name":"set","inputs":[{"name":"x","type":"uint256"}],"outputs":[],"stateMutability":"nonpayable"},
+  {"type":"function","name":"get","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}
+]`
+
+func TestProxyDeploy(t *testing.T) {
+	binPath, err := ioutil.TempDir("", "proxy-deploy")
+	require.NoError(t, err)
+	defer os.RemoveAll(binPath)
+	// Each implementation keeps a count in slot 0 and returns it multiplied by a different amount
+	writeCounter(t, binPath, "counter.bin", 1, `{"storage":[
+	  {"label":"count","offset":0,"slot":"0","type":"t_uint256"}
+	],"types":{"t_uint256":{"encoding":"inplace","label":"uint256","numberOfBytes":"32"}}}`)
+	writeCounter(t, binPath, "doubler.bin", 2, `{"storage":[
+	  {"label":"count","offset":0,"slot":"0","type":"t_uint256"},
+	  {"label":"owner","offset":0,"slot":"1","type":"t_address"}
+	],"types":{
+	  "t_uint256":{"encoding":"inplace","label":"uint256","numberOfBytes":"32"},
+	  "t_address":{"encoding":"inplace","label":"address","numberOfBytes":"20"}
+	}}`)
+	writeCounter(t, binPath, "tripler.bin", 3, `{"storage":[
+	  {"label":"count","offset":0,"slot":"0","type":"t_int256"}
+	],"types":{"t_int256":{"encoding":"inplace","label":"int256","numberOfBytes":"32"}}}`)
+
+	input := rpctest.PrivateAccounts[4].Address()
+	jobList := []*def.Job{
+		{Name: "proxy", ProxyDeploy: &def.ProxyDeploy{
+			Contract:     "counter.bin",
+			InitFunction: "set",
+			InitData:     []interface{}{"5"},
+		}},
+		{Name: "before", QueryContract: &def.QueryContract{Destination: "$proxy", Function: "get"}},
+		{Name: "upgrade", Upgrade: &def.Upgrade{Proxy: "$proxy", Contract: "doubler.bin"}},
+		{Name: "after", QueryContract: &def.QueryContract{Destination: "$proxy", Function: "get"}},
+	}
+	do := packages(input, jobList, "proxy.yaml")
+	do.BinPath = binPath
+	require.NoError(t, jobs.RunJobs(do))
+	assert.Equal(t, "5", jobList[1].Result)
+	assert.Equal(t, input.String(), jobList[0].Variables[1].Value)
+	assert.Equal(t, "10", jobList[3].Result)
+	proxy := jobList[0].Result.(string)
+
+	// Storage is laid out differently so the upgrade is refused
+	upgrade := &def.Upgrade{Proxy: proxy, Contract: "tripler.bin"}
+	do = packages(input, []*def.Job{{Name: "incompatible", Upgrade: upgrade}}, "incompatible.yaml")
+	do.BinPath = binPath
+	err = jobs.RunJobs(do)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "count has changed type from uint256 to int256")
+
+	// Unless told otherwise
+	upgrade = &def.Upgrade{Proxy: proxy, Contract: "tripler.bin", UnsafeSkipStorageCheck: true}
+	jobList = []*def.Job{
+		{Name: "unsafe", Upgrade: upgrade},
+		{Name: "tripled", QueryContract: &def.QueryContract{Destination: proxy, Function: "get"}},
+	}
+	do = packages(input, jobList, "unsafe.yaml")
+	do.BinPath = binPath
+	require.NoError(t, jobs.RunJobs(do))
+	assert.Equal(t, "15", jobList[1].Result)
+
+	// Only the admin can upgrade
+	other := rpctest.PrivateAccounts[5].Address()
+	upgrade = &def.Upgrade{Proxy: proxy, Implementation: jobList[0].Result.(string), UnsafeSkipStorageCheck: true}
+	do = packages(other, []*def.Job{{Name: "other", Upgrade: upgrade}}, "other.yaml")
+	do.BinPath = binPath
+	err = jobs.RunJobs(do)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only the admin")
+}
+
+// Writes a contract whose get() returns what was passed to set(uint256) multiplied by multiplier
+func writeCounter(t *testing.T, binPath, name string, multiplier byte, storageLayout string) {
+	set, get := abi.GetFunctionID("set(uint256)"), abi.GetFunctionID("get()")
+	runtime := MustSplice(
+		PUSH1, 0, CALLDATALOAD, PUSH1, 0xe0, SHR,
+		DUP1, PUSH4, set, EQ, PUSH1, 29, JUMPI,
+		PUSH4, get, EQ, PUSH1, 37, JUMPI,
+		PUSH1, 0, DUP1, REVERT,
+		// 29: set(uint256)
+		JUMPDEST, PUSH1, 4, CALLDATALOAD, PUSH1, 0, SSTORE, STOP,
+		// 37: get()
+		JUMPDEST, PUSH1, multiplier, PUSH1, 0, SLOAD, MUL, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN)
+	code := MustSplice(PUSH1, len(runtime), DUP1, PUSH1, 11, PUSH1, 0, CODECOPY, PUSH1, 0, RETURN, runtime)
+
+	contract := map[string]interface{}{
+		"Abi":           json.RawMessage(counterABI),
+		"Evm":           map[string]interface{}{"Bytecode": map[string]string{"Object": hex.EncodeToString(code)}},
+		"StorageLayout": json.RawMessage(storageLayout),
+	}
+	bs, err := json.Marshal(contract)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(binPath, name), bs, 0664))
+}
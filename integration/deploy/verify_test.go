@@ -0,0 +1,70 @@
+// +build integration
+
+package deploy
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+	"github.com/hyperledger/burrow/deploy/def"
+	"github.com/hyperledger/burrow/deploy/jobs"
+	"github.com/hyperledger/burrow/deploy/verify"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishAndVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Returns 42 followed by metadata as solc appends it
+	runtime := MustSplice(PUSH1, 42, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN,
+		"a165627a7a72305820", make([]byte, 32), "0029")
+	code := MustSplice(PUSH1, len(runtime), DUP1, PUSH1, 11, PUSH1, 0, CODECOPY, PUSH1, 0, RETURN, runtime)
+	artifact := filepath.Join(dir, "Answer.json")
+	bs, err := json.Marshal(map[string]interface{}{
+		"contractName":     "Answer",
+		"abi":              json.RawMessage(`[{"type":"fallback","stateMutability":"view"}]`),
+		"metadata":         `{"compiler":{"version":"0.5.17+commit.d19bba13"},"language":"Solidity"}`,
+		"bytecode":         "0x" + hex.EncodeToString(code),
+		"deployedBytecode": "0x" + hex.EncodeToString(runtime),
+	})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(artifact, bs, 0644))
+
+	input := rpctest.PrivateAccounts[6].Address()
+	jobList := []*def.Job{{Name: "answer", Deploy: &def.Deploy{Contract: artifact, Publish: true, PublishBlocks: "1000"}}}
+	do := packages(input, jobList, "verify.yaml")
+	do.BinPath = dir
+	require.NoError(t, jobs.RunJobs(do))
+	address, err := crypto.AddressFromHexString(jobList[0].Result.(string))
+	require.NoError(t, err)
+
+	client := new(def.Client)
+	require.NoError(t, client.Dial(testConfig.RPC.GRPC.ListenAddress, ""))
+	src, err := verify.GetSource(client, address)
+	require.NoError(t, err)
+	assert.Equal(t, artifact, src.File)
+	assert.Equal(t, "Answer", src.Contract)
+	assert.Equal(t, "0.5.17", src.Version)
+	assert.JSONEq(t, `[{"type":"fallback","stateMutability":"view"}]`, string(src.Abi))
+
+	acc, err := client.GetAccount(address)
+	require.NoError(t, err)
+	result, err := verify.Verify(address, acc.Code, artifact, src, compile.Artifact{})
+	require.NoError(t, err)
+	assert.True(t, result.Exact)
+
+	_, err = verify.GetSource(client, input)
+	assert.Error(t, err, "nothing published for an account")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -157,6 +158,30 @@ func TestCreateAccount(t *testing.T) {
 	assert.Equal(t, amount, ca.Balance)
 }
 
+func TestSeedStorage(t *testing.T) {
+	inputAddress := privateAccounts[0].Address()
+	grpcAddress := testConfigs[0].RPC.GRPC.ListenAddress
+	tcli := rpctest.NewTransactClient(t, grpcAddress)
+	qcli := rpctest.NewQueryClient(t, grpcAddress)
+	address := acm.GeneratePrivateAccountFromSecret("restored contract").Address()
+	code := acm.Bytecode{0x60, 0x00}
+	_, err := govSync(tcli, governance.UpdateAccountTx(inputAddress, &spec.TemplateAccount{
+		Address: &address,
+		Code:    &code,
+		Storage: []*spec.StorageItem{{Key: []byte{0x01}, Value: []byte{0xca, 0xfe}}},
+	}))
+	require.NoError(t, err)
+	ca, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address})
+	require.NoError(t, err)
+	assert.Equal(t, code, ca.Code)
+	stream, err := qcli.ListStorage(context.Background(), &rpcquery.ListStorageParam{Address: address})
+	require.NoError(t, err)
+	item, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, binary.LeftPadWord256([]byte{0x01}).Bytes(), item.Key.Bytes())
+	assert.Equal(t, binary.LeftPadWord256([]byte{0xca, 0xfe}).Bytes(), item.Value.Bytes())
+}
+
 func TestChangePowerByAddress(t *testing.T) {
 	// Should use the key client to look up public key
 	inputAddress := privateAccounts[0].Address()
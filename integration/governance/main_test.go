@@ -38,6 +38,11 @@ var kernels []*core.Kernel
 
 // Needs to be in a _test.go file to be picked up
 func TestMain(m *testing.M) {
+	// Deferred calls do not run on os.Exit so run the tests in a function of their own
+	os.Exit(runTests(m))
+}
+
+func runTests(m *testing.M) int {
 	cleanup := integration.EnterTestDirectory()
 	defer cleanup()
 	testConfigs = make([]*config.BurrowConfig, len(privateAccounts))
@@ -68,7 +73,7 @@ func TestMain(m *testing.M) {
 			}
 		}
 	}
-	os.Exit(m.Run())
+	return m.Run()
 }
 
 func connectKernels(k1, k2 *core.Kernel) {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"runtime"
 	"strconv"
@@ -42,7 +43,6 @@ import (
 
 const (
 	ChainName = "Integration_Test_Chain"
-	testDir   = "./test_scratch/tm_test"
 )
 
 // Enable logger output during tests
@@ -92,12 +92,26 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 	return kernel
 }
 
+// Enters a fresh temporary directory so that chain data written by tests (including node keys) never lands in the
+// source tree. The returned cleanup leaves and removes the directory.
 func EnterTestDirectory() (cleanup func()) {
-	os.RemoveAll(testDir)
-	os.MkdirAll(testDir, 0777)
-	os.Chdir(testDir)
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	testDir, err := ioutil.TempDir("", "burrow-integration-")
+	if err != nil {
+		panic(err)
+	}
+	err = os.Chdir(testDir)
+	if err != nil {
+		panic(err)
+	}
 	os.MkdirAll("config", 0777)
-	return func() { os.RemoveAll(testDir) }
+	return func() {
+		os.Chdir(wd)
+		os.RemoveAll(testDir)
+	}
 }
 
 func TestGenesisDoc(addressables []*acm.PrivateAccount) *genesis.GenesisDoc {
@@ -92,18 +92,23 @@ func TestListNames(t *testing.T) {
 		}
 	}
 	qcli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
-	entries := receiveNames(t, qcli, "")
+	entries := receiveNames(t, qcli, &rpcquery.ListNamesParam{})
 	assert.Len(t, entries, n)
-	entries = receiveNames(t, qcli, query.NewBuilder().AndEquals("Data", dataA).String())
+	entries = receiveNames(t, qcli, &rpcquery.ListNamesParam{
+		Query: query.NewBuilder().AndEquals("Data", dataA).String(),
+	})
 	if assert.Len(t, entries, n/2) {
 		assert.Equal(t, dataA, entries[0].Data)
 	}
+	entries = receiveNames(t, qcli, &rpcquery.ListNamesParam{Start: "Flub/2", Limit: 3})
+	if assert.Len(t, entries, 3) {
+		assert.Equal(t, "Flub/2", entries[0].Name)
+		assert.Equal(t, "Flub/4", entries[2].Name)
+	}
 }
 
-func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*names.Entry {
-	stream, err := qcli.ListNames(context.Background(), &rpcquery.ListNamesParam{
-		Query: query,
-	})
+func receiveNames(t testing.TB, qcli rpcquery.QueryClient, param *rpcquery.ListNamesParam) []*names.Entry {
+	stream, err := qcli.ListNames(context.Background(), param)
 	require.NoError(t, err)
 	var entries []*names.Entry
 	entry, err := stream.Recv()
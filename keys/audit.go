@@ -0,0 +1,149 @@
+package keys
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/tmthrgd/go-hex"
+)
+
+// An append-only log of sign requests in which each record includes the hash of the record before it so that any
+// alteration, removal, or reordering of records can be detected
+type auditLog struct {
+	sync.Mutex
+	path     string
+	file     *os.File
+	length   uint64
+	headHash string
+}
+
+// Opens the audit log at path, creating it if necessary, after verifying any existing records
+func openAuditLog(path string) (*auditLog, error) {
+	al := &auditLog{path: path}
+	err := al.scan(func(record *AuditRecord) error {
+		al.length = record.Index
+		al.headHash = record.Hash
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not open audit log %s: %v", path, err)
+	}
+	al.file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return al, nil
+}
+
+// Appends record to the log setting its Index, PreviousHash, and Hash
+func (al *auditLog) append(record *AuditRecord) error {
+	al.Lock()
+	defer al.Unlock()
+	record.Index = al.length + 1
+	record.PreviousHash = al.headHash
+	hash, err := auditRecordHash(record)
+	if err != nil {
+		return err
+	}
+	record.Hash = hash
+	bs, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = al.file.Write(append(bs, '\n'))
+	if err != nil {
+		return err
+	}
+	err = al.file.Sync()
+	if err != nil {
+		return err
+	}
+	al.length = record.Index
+	al.headHash = record.Hash
+	return nil
+}
+
+// Calls consume on each record in order having checked it is correctly chained to its predecessor
+func (al *auditLog) scan(consume func(record *AuditRecord) error) error {
+	file, err := os.Open(al.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1<<20)
+	previous := &AuditRecord{}
+	for scanner.Scan() {
+		record := new(AuditRecord)
+		err = json.Unmarshal(scanner.Bytes(), record)
+		if err != nil {
+			return fmt.Errorf("could not read audit record after index %d: %v", previous.Index, err)
+		}
+		err = verifyAuditRecord(previous, record)
+		if err != nil {
+			return err
+		}
+		err = consume(record)
+		if err != nil {
+			return err
+		}
+		previous = record
+	}
+	return scanner.Err()
+}
+
+func (al *auditLog) head() (uint64, string) {
+	al.Lock()
+	defer al.Unlock()
+	return al.length, al.headHash
+}
+
+func (al *auditLog) Close() error {
+	return al.file.Close()
+}
+
+// VerifyAuditLog checks every record of the audit log at path is intact and correctly chained, returning the number
+// of records and the hash of the last
+func VerifyAuditLog(path string) (uint64, string, error) {
+	al := &auditLog{path: path}
+	var length uint64
+	var headHash string
+	err := al.scan(func(record *AuditRecord) error {
+		length = record.Index
+		headHash = record.Hash
+		return nil
+	})
+	return length, headHash, err
+}
+
+func verifyAuditRecord(previous, record *AuditRecord) error {
+	if record.Index != previous.Index+1 {
+		return fmt.Errorf("audit record %d follows record %d", record.Index, previous.Index)
+	}
+	if record.PreviousHash != previous.Hash {
+		return fmt.Errorf("audit record %d does not chain to the hash of record %d", record.Index, previous.Index)
+	}
+	hash, err := auditRecordHash(record)
+	if err != nil {
+		return err
+	}
+	if hash != record.Hash {
+		return fmt.Errorf("audit record %d does not match its hash", record.Index)
+	}
+	return nil
+}
+
+func auditRecordHash(record *AuditRecord) (string, error) {
+	unhashed := *record
+	unhashed.Hash = ""
+	bs, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bs)
+	return hex.EncodeUpperToString(hash[:]), nil
+}
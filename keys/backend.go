@@ -0,0 +1,111 @@
+package keys
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+)
+
+const (
+	// Encrypted key files in a directory
+	BackendDirectory = "directory"
+	// A single encrypted file holding all keys
+	BackendVault = "vault"
+	// Another keys server
+	BackendRemote = "remote"
+	// A PKCS#11 token
+	BackendPKCS11 = "pkcs11"
+
+	DefaultVaultFile          = "vault.json"
+	DefaultVaultPassphraseEnv = "BURROW_KEYS_VAULT_PASSPHRASE"
+	DefaultPKCS11PINEnv       = "BURROW_KEYS_PKCS11_PIN"
+)
+
+// Backend stores the keys of a KeyStore. Signing is delegated to the Backend so that backends such as PKCS#11
+// tokens need never reveal private keys. Passphrases protect individual keys where the backend supports it and are
+// otherwise ignored.
+type Backend interface {
+	// Generate creates and stores a new key
+	Generate(passphrase string, curveType crypto.CurveType) (*Key, error)
+	// Import stores an existing key
+	Import(passphrase string, key *Key) error
+	// Export returns the key at address including its private key, backends that do not allow private keys to be
+	// exported return an error
+	Export(passphrase string, address crypto.Address) (*Key, error)
+	// PublicKey returns the public key of the key at address
+	PublicKey(address crypto.Address) (crypto.PublicKey, error)
+	// Sign signs message with the key at address
+	Sign(passphrase string, address crypto.Address, message []byte) (crypto.Signature, error)
+	// Addresses lists the addresses of stored keys
+	Addresses() ([]crypto.Address, error)
+	// Delete removes the key at address
+	Delete(passphrase string, address crypto.Address) error
+}
+
+// NewBackend returns the Backend described by conf.Backend, or a directory backend in KeysDirectory if there is none
+func NewBackend(conf *KeysConfig, logger *logging.Logger) (Backend, error) {
+	backendConf := conf.Backend
+	if backendConf == nil {
+		backendConf = &BackendConfig{Type: BackendDirectory}
+	}
+	scryptParams, err := conf.ScryptParams()
+	if err != nil {
+		return nil, err
+	}
+	switch backendConf.Type {
+	case "", BackendDirectory:
+		db := NewDirectoryBackend(conf.KeysDirectory, conf.AllowBadFilePermissions, logger)
+		db.scrypt = scryptParams
+		return db, nil
+	case BackendVault:
+		vaultConf := backendConf.Vault
+		if vaultConf == nil {
+			vaultConf = DefaultVaultConfig()
+		}
+		vaultPath := vaultConf.Path
+		if !path.IsAbs(vaultPath) {
+			vaultPath = path.Join(conf.KeysDirectory, vaultPath)
+		}
+		vb, err := NewVaultBackend(vaultPath, lookupSecret(vaultConf.PassphraseEnv), logger)
+		if err != nil {
+			return nil, err
+		}
+		vb.scrypt = scryptParams
+		return vb, nil
+	case BackendRemote:
+		if backendConf.Remote == nil || backendConf.Remote.Address == "" {
+			return nil, fmt.Errorf("remote keys backend requires an address")
+		}
+		return NewRemoteBackend(backendConf.Remote.Address, backendConf.Remote.TLS, logger)
+	case BackendPKCS11:
+		pkcs11Conf := backendConf.PKCS11
+		if pkcs11Conf == nil || pkcs11Conf.Module == "" {
+			return nil, fmt.Errorf("pkcs11 keys backend requires the path of a PKCS#11 module")
+		}
+		return NewPKCS11Backend(pkcs11Conf.Module, pkcs11Conf.TokenLabel, lookupSecret(pkcs11Conf.PINEnv), logger)
+	default:
+		return nil, fmt.Errorf("unknown keys backend '%s', expected one of %s, %s, %s, or %s", backendConf.Type,
+			BackendDirectory, BackendVault, BackendRemote, BackendPKCS11)
+	}
+}
+
+// ErrUnsupported is returned by a Backend that cannot perform an operation
+type ErrUnsupported struct {
+	Backend   string
+	Operation string
+}
+
+func (err ErrUnsupported) Error() string {
+	return fmt.Sprintf("%s keys backend does not support %s", err.Backend, err.Operation)
+}
+
+// Reads a secret from the environment variable env, if named
+func lookupSecret(env string) string {
+	if env == "" {
+		return ""
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,143 @@
+package keys
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/tmthrgd/go-hex"
+)
+
+// Stores each key as a JSON file named by its address under the 'data' subdirectory of a keys directory
+type directoryBackend struct {
+	sync.Mutex
+	keysDirPath             string
+	allowBadFilePermissions bool
+	scrypt                  ScryptParams
+	logger                  *logging.Logger
+}
+
+var _ Backend = (*directoryBackend)(nil)
+
+func NewDirectoryBackend(dir string, allowBadFilePermissions bool, logger *logging.Logger) *directoryBackend {
+	return &directoryBackend{
+		keysDirPath:             dir,
+		allowBadFilePermissions: allowBadFilePermissions,
+		scrypt:                  DefaultScryptParams(),
+		logger:                  logger.WithScope("DirectoryBackend"),
+	}
+}
+
+func (db *directoryBackend) Generate(passphrase string, curveType crypto.CurveType) (*Key, error) {
+	key, err := NewKey(curveType)
+	if err != nil {
+		return nil, err
+	}
+	return key, db.Import(passphrase, key)
+}
+
+func (db *directoryBackend) Import(passphrase string, key *Key) error {
+	bs, err := marshalKey(passphrase, key, db.scrypt)
+	if err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+	_, err = writeKey(db.keysDirPath, key.Address[:], bs)
+	return err
+}
+
+func (db *directoryBackend) Export(passphrase string, address crypto.Address) (*Key, error) {
+	bs, err := db.readKeyFile(address)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalKey(passphrase, bs)
+}
+
+func (db *directoryBackend) PublicKey(address crypto.Address) (crypto.PublicKey, error) {
+	bs, err := db.readKeyFile(address)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	return unmarshalPublicKey(bs)
+}
+
+func (db *directoryBackend) Sign(passphrase string, address crypto.Address, message []byte) (crypto.Signature, error) {
+	key, err := db.Export(passphrase, address)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	return key.PrivateKey.Sign(message)
+}
+
+func (db *directoryBackend) Addresses() ([]crypto.Address, error) {
+	db.Lock()
+	defer db.Unlock()
+	dataDirPath, err := returnDataDir(db.keysDirPath)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := GetAllAddresses(dataDirPath)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []crypto.Address
+	for _, addr := range addrs {
+		address, err := crypto.AddressFromBytes(addr)
+		if err != nil {
+			// Not a key file
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func (db *directoryBackend) Delete(passphrase string, address crypto.Address) error {
+	db.Lock()
+	defer db.Unlock()
+	dataDirPath, err := returnDataDir(db.keysDirPath)
+	if err != nil {
+		return err
+	}
+	return os.Remove(keyFilePath(dataDirPath, address[:]))
+}
+
+// Writes a key file as is, so it remains encrypted under its original passphrase
+func (db *directoryBackend) ImportJSON(address crypto.Address, keyJSON []byte) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := writeKey(db.keysDirPath, address[:], keyJSON)
+	return err
+}
+
+func (db *directoryBackend) readKeyFile(address crypto.Address) ([]byte, error) {
+	db.Lock()
+	defer db.Unlock()
+	dataDirPath, err := returnDataDir(db.keysDirPath)
+	if err != nil {
+		return nil, err
+	}
+	filename := keyFilePath(dataDirPath, address[:])
+	fileInfo, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	if (uint32(fileInfo.Mode()) & 0077) != 0 {
+		db.logger.InfoMsg("file should be accessible by user only", "file", filename)
+		if !db.allowBadFilePermissions {
+			return nil, fmt.Errorf("file %s should be accessible by user only", filename)
+		}
+	}
+	return ioutil.ReadFile(filename)
+}
+
+func keyFilePath(dataDirPath string, addr []byte) string {
+	return path.Join(dataDirPath, strings.ToUpper(hex.EncodeToString(addr))+".json")
+}
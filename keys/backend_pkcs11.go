@@ -0,0 +1,410 @@
+// +build pkcs11
+
+package keys
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/miekg/pkcs11"
+)
+
+// Edwards curve constants from PKCS#11 v3.0 not yet defined by github.com/miekg/pkcs11
+const (
+	ckkECEdwards           = 0x00000040
+	ckmECEdwardsKeyPairGen = 0x00001055
+	ckmEdDSA               = 0x00001057
+)
+
+// DER encoded object identifiers of the curves, used as CKA_EC_PARAMS
+var (
+	secp256k1Params = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+	ed25519Params   = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+)
+
+// Holds keys on a PKCS#11 token. Keys are generated on the token (or imported into it) as non-extractable and are
+// identified by CKA_ID set to their address, private keys never leave the token.
+type pkcs11Backend struct {
+	// PKCS#11 sessions are not safe for concurrent use
+	sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	logger  *logging.Logger
+}
+
+var _ Backend = (*pkcs11Backend)(nil)
+
+// NewPKCS11Backend loads module and logs in to the token labelled tokenLabel (or the first token if tokenLabel is
+// empty) with pin
+func NewPKCS11Backend(module, tokenLabel, pin string, logger *logging.Logger) (Backend, error) {
+	ctx := pkcs11.New(module)
+	if ctx == nil {
+		return nil, fmt.Errorf("could not load PKCS#11 module %s", module)
+	}
+	err := ctx.Initialize()
+	if err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not initialise PKCS#11 module %s: %v", module, err)
+	}
+	slot, err := findSlot(ctx, tokenLabel)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not open PKCS#11 session: %v", err)
+	}
+	err = ctx.Login(session, pkcs11.CKU_USER, pin)
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		ctx.CloseSession(session)
+		ctx.Finalize()
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not log in to PKCS#11 token: %v", err)
+	}
+	logger = logger.WithScope("PKCS11Backend")
+	logger.InfoMsg("Logged in to PKCS#11 token", "module", module, "slot", slot)
+	return &pkcs11Backend{
+		ctx:     ctx,
+		session: session,
+		logger:  logger,
+	}, nil
+}
+
+func (pb *pkcs11Backend) Generate(passphrase string, curveType crypto.CurveType) (*Key, error) {
+	var mechanism *pkcs11.Mechanism
+	var keyType uint
+	var params []byte
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)
+		keyType = pkcs11.CKK_EC
+		params = secp256k1Params
+	case crypto.CurveTypeEd25519:
+		mechanism = pkcs11.NewMechanism(ckmECEdwardsKeyPairGen, nil)
+		keyType = ckkECEdwards
+		params = ed25519Params
+	default:
+		return nil, fmt.Errorf("PKCS#11 keys backend does not support curve type %v", curveType)
+	}
+	// We only learn the address once the key exists so label the pair with a temporary ID
+	tempID := []byte("burrow-pending")
+	pb.Lock()
+	defer pb.Unlock()
+	pubHandle, privHandle, err := pb.ctx.GenerateKeyPair(pb.session, []*pkcs11.Mechanism{mechanism},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, tempID),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, tempID),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("could not generate key on PKCS#11 token: %v", err)
+	}
+	publicKey, err := pb.readPublicKey(pubHandle, curveType)
+	if err != nil {
+		pb.destroy(pubHandle, privHandle)
+		return nil, err
+	}
+	address := publicKey.Address()
+	for _, handle := range []pkcs11.ObjectHandle{pubHandle, privHandle} {
+		err = pb.ctx.SetAttributeValue(pb.session, handle, pb.identify(address))
+		if err != nil {
+			pb.destroy(pubHandle, privHandle)
+			return nil, fmt.Errorf("could not set ID of key on PKCS#11 token: %v", err)
+		}
+	}
+	return &Key{CurveType: curveType, Address: address, PublicKey: publicKey}, nil
+}
+
+func (pb *pkcs11Backend) Import(passphrase string, key *Key) error {
+	var keyType uint
+	var params, value, point []byte
+	switch key.CurveType {
+	case crypto.CurveTypeSecp256k1:
+		keyType = pkcs11.CKK_EC
+		params = secp256k1Params
+		value = key.PrivateKey.RawBytes()
+		pub, err := btcec.ParsePubKey(key.PublicKey.PublicKey, btcec.S256())
+		if err != nil {
+			return err
+		}
+		point = pub.SerializeUncompressed()
+	case crypto.CurveTypeEd25519:
+		keyType = ckkECEdwards
+		params = ed25519Params
+		// Our ed25519 private keys are the seed followed by the public key
+		value = key.PrivateKey.RawBytes()[:32]
+		point = key.PublicKey.PublicKey
+	default:
+		return fmt.Errorf("PKCS#11 keys backend does not support curve type %v", key.CurveType)
+	}
+	encodedPoint, err := asn1.Marshal(point)
+	if err != nil {
+		return err
+	}
+	pb.Lock()
+	defer pb.Unlock()
+	privHandle, err := pb.ctx.CreateObject(pb.session, append(pb.identify(key.Address),
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, value),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+	))
+	if err != nil {
+		return fmt.Errorf("could not import private key to PKCS#11 token: %v", err)
+	}
+	_, err = pb.ctx.CreateObject(pb.session, append(pb.identify(key.Address),
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, keyType),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, encodedPoint),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+	))
+	if err != nil {
+		pb.destroy(privHandle)
+		return fmt.Errorf("could not import public key to PKCS#11 token: %v", err)
+	}
+	return nil
+}
+
+func (pb *pkcs11Backend) Export(passphrase string, address crypto.Address) (*Key, error) {
+	return nil, ErrUnsupported{Backend: BackendPKCS11, Operation: "exporting private keys"}
+}
+
+func (pb *pkcs11Backend) PublicKey(address crypto.Address) (crypto.PublicKey, error) {
+	pb.Lock()
+	defer pb.Unlock()
+	handle, err := pb.find(pkcs11.CKO_PUBLIC_KEY, address)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	curveType, err := pb.curveType(handle)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	return pb.readPublicKey(handle, curveType)
+}
+
+func (pb *pkcs11Backend) Sign(passphrase string, address crypto.Address, message []byte) (crypto.Signature, error) {
+	pb.Lock()
+	defer pb.Unlock()
+	handle, err := pb.find(pkcs11.CKO_PRIVATE_KEY, address)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	curveType, err := pb.curveType(handle)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	var mechanism *pkcs11.Mechanism
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		// Like btcec we sign message as given rather than hashing it
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	case crypto.CurveTypeEd25519:
+		mechanism = pkcs11.NewMechanism(ckmEdDSA, nil)
+	}
+	err = pb.ctx.SignInit(pb.session, []*pkcs11.Mechanism{mechanism}, handle)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	sig, err := pb.ctx.Sign(pb.session, message)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if curveType == crypto.CurveTypeSecp256k1 {
+		// PKCS#11 gives us r || s but we use DER encoded signatures with canonical (low) s
+		if len(sig) != 64 {
+			return crypto.Signature{}, fmt.Errorf("unexpected PKCS#11 ECDSA signature length %d", len(sig))
+		}
+		sig = (&btcec.Signature{
+			R: new(big.Int).SetBytes(sig[:32]),
+			S: new(big.Int).SetBytes(sig[32:]),
+		}).Serialize()
+	}
+	return crypto.SignatureFromBytes(sig, curveType)
+}
+
+func (pb *pkcs11Backend) Addresses() ([]crypto.Address, error) {
+	pb.Lock()
+	defer pb.Unlock()
+	handles, err := pb.findAll([]*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY)})
+	if err != nil {
+		return nil, err
+	}
+	var addresses []crypto.Address
+	for _, handle := range handles {
+		attrs, err := pb.ctx.GetAttributeValue(pb.session, handle,
+			[]*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, nil)})
+		if err != nil {
+			return nil, err
+		}
+		address, err := crypto.AddressFromBytes(attrs[0].Value)
+		if err != nil {
+			// Not one of ours
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func (pb *pkcs11Backend) Delete(passphrase string, address crypto.Address) error {
+	pb.Lock()
+	defer pb.Unlock()
+	handles, err := pb.findAll(pb.identify(address))
+	if err != nil {
+		return err
+	}
+	if len(handles) == 0 {
+		return fmt.Errorf("no key with address %v on PKCS#11 token", address)
+	}
+	pb.destroy(handles...)
+	return nil
+}
+
+// Close logs out of the token and unloads the module
+func (pb *pkcs11Backend) Close() error {
+	pb.Lock()
+	defer pb.Unlock()
+	pb.ctx.Logout(pb.session)
+	pb.ctx.CloseSession(pb.session)
+	err := pb.ctx.Finalize()
+	pb.ctx.Destroy()
+	return err
+}
+
+func (pb *pkcs11Backend) identify(address crypto.Address) []*pkcs11.Attribute {
+	return []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_ID, address.Bytes()),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, address.String()),
+	}
+}
+
+// Must hold lock
+func (pb *pkcs11Backend) find(class uint, address crypto.Address) (pkcs11.ObjectHandle, error) {
+	handles, err := pb.findAll(append(pb.identify(address), pkcs11.NewAttribute(pkcs11.CKA_CLASS, class)))
+	if err != nil {
+		return 0, err
+	}
+	if len(handles) == 0 {
+		return 0, fmt.Errorf("no key with address %v on PKCS#11 token", address)
+	}
+	return handles[0], nil
+}
+
+// Must hold lock
+func (pb *pkcs11Backend) findAll(template []*pkcs11.Attribute) ([]pkcs11.ObjectHandle, error) {
+	err := pb.ctx.FindObjectsInit(pb.session, template)
+	if err != nil {
+		return nil, err
+	}
+	defer pb.ctx.FindObjectsFinal(pb.session)
+	var handles []pkcs11.ObjectHandle
+	for {
+		found, _, err := pb.ctx.FindObjects(pb.session, 100)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return handles, nil
+		}
+		handles = append(handles, found...)
+	}
+}
+
+// Must hold lock
+func (pb *pkcs11Backend) curveType(handle pkcs11.ObjectHandle) (crypto.CurveType, error) {
+	attrs, err := pb.ctx.GetAttributeValue(pb.session, handle,
+		[]*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil)})
+	if err != nil {
+		return 0, err
+	}
+	params := string(attrs[0].Value)
+	switch params {
+	case string(secp256k1Params):
+		return crypto.CurveTypeSecp256k1, nil
+	case string(ed25519Params):
+		return crypto.CurveTypeEd25519, nil
+	}
+	return 0, fmt.Errorf("PKCS#11 key has unsupported curve parameters %X", attrs[0].Value)
+}
+
+// Must hold lock
+func (pb *pkcs11Backend) readPublicKey(handle pkcs11.ObjectHandle, curveType crypto.CurveType) (crypto.PublicKey, error) {
+	attrs, err := pb.ctx.GetAttributeValue(pb.session, handle,
+		[]*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	// The point should be a DER encoded OCTET STRING but some tokens return it raw
+	point := attrs[0].Value
+	var unwrapped []byte
+	if rest, err := asn1.Unmarshal(point, &unwrapped); err == nil && len(rest) == 0 {
+		point = unwrapped
+	}
+	if curveType == crypto.CurveTypeSecp256k1 {
+		pub, err := btcec.ParsePubKey(point, btcec.S256())
+		if err != nil {
+			return crypto.PublicKey{}, err
+		}
+		point = pub.SerializeCompressed()
+	}
+	return crypto.PublicKeyFromBytes(point, curveType)
+}
+
+// Must hold lock
+func (pb *pkcs11Backend) destroy(handles ...pkcs11.ObjectHandle) {
+	for _, handle := range handles {
+		err := pb.ctx.DestroyObject(pb.session, handle)
+		if err != nil {
+			pb.logger.InfoMsg("Could not destroy PKCS#11 object", "error", err)
+		}
+	}
+}
+
+func findSlot(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("could not list PKCS#11 slots: %v", err)
+	}
+	for _, slot := range slots {
+		if tokenLabel == "" {
+			return slot, nil
+		}
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, err
+		}
+		if strings.TrimSpace(info.Label) == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("could not find PKCS#11 token labelled '%s'", tokenLabel)
+}
@@ -0,0 +1,14 @@
+// +build !pkcs11
+
+package keys
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/logging"
+)
+
+func NewPKCS11Backend(module, tokenLabel, pin string, logger *logging.Logger) (Backend, error) {
+	return nil, fmt.Errorf("burrow was built without PKCS#11 support, rebuild with '-tags pkcs11' to use the " +
+		"pkcs11 keys backend")
+}
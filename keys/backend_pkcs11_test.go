@@ -0,0 +1,70 @@
+// +build pkcs11
+
+package keys
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Run against SoftHSM with a token initialised by something like:
+//   softhsm2-util --init-token --free --label burrow-test --so-pin 1234 --pin 1234
+//   BURROW_TEST_PKCS11_PIN=1234 go test -tags pkcs11 ./keys/ -run PKCS11
+func TestPKCS11Backend(t *testing.T) {
+	module := os.Getenv("BURROW_TEST_PKCS11_MODULE")
+	if module == "" {
+		module = "/usr/lib/softhsm/libsofthsm2.so"
+	}
+	if _, err := os.Stat(module); err != nil {
+		t.Skipf("no PKCS#11 module at %s: %v", module, err)
+	}
+	tokenLabel := os.Getenv("BURROW_TEST_PKCS11_TOKEN")
+	if tokenLabel == "" {
+		tokenLabel = "burrow-test"
+	}
+	backend, err := NewPKCS11Backend(module, tokenLabel, os.Getenv("BURROW_TEST_PKCS11_PIN"),
+		logging.NewNoopLogger())
+	require.NoError(t, err)
+	defer backend.(*pkcs11Backend).Close()
+
+	msg := sha3.Sha3([]byte("the hash of something!"))
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeSecp256k1, crypto.CurveTypeEd25519} {
+		key, err := backend.Generate("", curveType)
+		require.NoError(t, err)
+
+		publicKey, err := backend.PublicKey(key.Address)
+		require.NoError(t, err)
+		assert.Equal(t, key.PublicKey, publicKey)
+
+		sig, err := backend.Sign("", key.Address, msg)
+		require.NoError(t, err)
+		assert.NoError(t, publicKey.Verify(msg, sig))
+
+		_, err = backend.Export("", key.Address)
+		assert.IsType(t, ErrUnsupported{}, err, "private keys must not leave the token")
+
+		// Imported keys should sign identically to their originals (ed25519 is deterministic)
+		imported, err := NewKey(curveType)
+		require.NoError(t, err)
+		require.NoError(t, backend.Import("", imported))
+		sig, err = backend.Sign("", imported.Address, msg)
+		require.NoError(t, err)
+		assert.NoError(t, imported.PublicKey.Verify(msg, sig))
+
+		addresses, err := backend.Addresses()
+		require.NoError(t, err)
+		assert.Contains(t, addresses, key.Address)
+		assert.Contains(t, addresses, imported.Address)
+
+		require.NoError(t, backend.Delete("", key.Address))
+		require.NoError(t, backend.Delete("", imported.Address))
+		_, err = backend.PublicKey(key.Address)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,140 @@
+package keys
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
+	"google.golang.org/grpc"
+)
+
+// Delegates to another server implementing the Keys service. A remote signer need only implement GenerateKey,
+// Import, PublicKey, Sign, and List (see protobuf/keys.proto), returning an error from Export if private keys may
+// not leave it.
+type remoteBackend struct {
+	kc     KeysClient
+	logger *logging.Logger
+}
+
+var _ Backend = (*remoteBackend)(nil)
+
+// If tlsConf is nil or not enabled the connection is plaintext
+func NewRemoteBackend(rpcAddress string, tlsConf *tlsconfig.ClientConfig, logger *logging.Logger) (*remoteBackend, error) {
+	transportOpt, err := tlsConf.GRPCDialOption()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(rpcAddress, transportOpt)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteBackend{
+		kc:     NewKeysClient(conn),
+		logger: logger.WithScope("RemoteBackend").With("remote_address", rpcAddress),
+	}, nil
+}
+
+func (rb *remoteBackend) Generate(passphrase string, curveType crypto.CurveType) (*Key, error) {
+	ctx, cancel := remoteContext()
+	defer cancel()
+	resp, err := rb.kc.GenerateKey(ctx, &GenRequest{Passphrase: passphrase, CurveType: curveType.String()})
+	if err != nil {
+		return nil, err
+	}
+	address, err := crypto.AddressFromHexString(resp.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := rb.PublicKey(address)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{CurveType: curveType, Address: address, PublicKey: publicKey}, nil
+}
+
+func (rb *remoteBackend) Import(passphrase string, key *Key) error {
+	ctx, cancel := remoteContext()
+	defer cancel()
+	_, err := rb.kc.Import(ctx, &ImportRequest{
+		Passphrase: passphrase,
+		CurveType:  key.CurveType.String(),
+		KeyBytes:   key.PrivateKey.RawBytes(),
+	})
+	return err
+}
+
+func (rb *remoteBackend) Export(passphrase string, address crypto.Address) (*Key, error) {
+	ctx, cancel := remoteContext()
+	defer cancel()
+	resp, err := rb.kc.Export(ctx, &ExportRequest{Passphrase: passphrase, Address: address.String()})
+	if err != nil {
+		return nil, err
+	}
+	curveType, err := crypto.CurveTypeFromString(resp.GetCurveType())
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyFromPriv(curveType, resp.GetPrivatekey())
+}
+
+func (rb *remoteBackend) PublicKey(address crypto.Address) (crypto.PublicKey, error) {
+	ctx, cancel := remoteContext()
+	defer cancel()
+	resp, err := rb.kc.PublicKey(ctx, &PubRequest{Address: address.String()})
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	curveType, err := crypto.CurveTypeFromString(resp.GetCurveType())
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	return crypto.PublicKeyFromBytes(resp.GetPublicKey(), curveType)
+}
+
+func (rb *remoteBackend) Sign(passphrase string, address crypto.Address, message []byte) (crypto.Signature, error) {
+	ctx, cancel := remoteContext()
+	defer cancel()
+	rb.logger.TraceMsg("Forwarding Sign request", "address", address)
+	resp, err := rb.kc.Sign(ctx, &SignRequest{Passphrase: passphrase, Address: address.String(), Message: message})
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	curveType, err := crypto.CurveTypeFromString(resp.GetCurveType())
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	return crypto.SignatureFromBytes(resp.GetSignature(), curveType)
+}
+
+// The Keys service can only list named keys
+func (rb *remoteBackend) Addresses() ([]crypto.Address, error) {
+	ctx, cancel := remoteContext()
+	defer cancel()
+	resp, err := rb.kc.List(ctx, &ListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[crypto.Address]bool)
+	var addresses []crypto.Address
+	for _, keyID := range resp.GetKey() {
+		address, err := crypto.AddressFromHexString(keyID.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses, nil
+}
+
+func (rb *remoteBackend) Delete(passphrase string, address crypto.Address) error {
+	return ErrUnsupported{Backend: BackendRemote, Operation: "deleting keys"}
+}
+
+func remoteContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 10*time.Second)
+}
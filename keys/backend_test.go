@@ -0,0 +1,144 @@
+package keys
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryBackend(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	testBackend(t, NewDirectoryBackend(dir, false, logging.NewNoopLogger()))
+}
+
+func TestVaultBackend(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	vaultPath := path.Join(dir, DefaultVaultFile)
+	vb, err := NewVaultBackend(vaultPath, "vault passphrase", logging.NewNoopLogger())
+	require.NoError(t, err)
+	testBackend(t, vb)
+
+	addresses, err := vb.Addresses()
+	require.NoError(t, err)
+
+	// Keys should survive reopening the vault
+	reopened, err := NewVaultBackend(vaultPath, "vault passphrase", logging.NewNoopLogger())
+	require.NoError(t, err)
+	reopenedAddresses, err := reopened.Addresses()
+	require.NoError(t, err)
+	assert.Equal(t, addresses, reopenedAddresses)
+
+	_, err = NewVaultBackend(vaultPath, "wrong passphrase", logging.NewNoopLogger())
+	assert.Error(t, err)
+
+	// The vault must not leak key material
+	bs, err := ioutil.ReadFile(vaultPath)
+	require.NoError(t, err)
+	for _, address := range addresses {
+		assert.NotContains(t, string(bs), address.String())
+	}
+}
+
+func TestRemoteBackend(t *testing.T) {
+	// Uses the server started by server_test.go
+	rb, err := NewRemoteBackend(DefaultHost+":"+TestPort, nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+	key, err := rb.Generate("", crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	msg := []byte("sign me remotely")
+	sig, err := rb.Sign("", key.Address, msg)
+	require.NoError(t, err)
+	require.NoError(t, key.PublicKey.Verify(msg, sig))
+
+	_, err = rb.Addresses()
+	require.NoError(t, err)
+	err = rb.Delete("", key.Address)
+	assert.IsType(t, ErrUnsupported{}, err)
+}
+
+func TestNewBackend(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	conf := DefaultKeysConfig()
+	conf.KeysDirectory = dir
+
+	backend, err := NewBackend(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &directoryBackend{}, backend)
+
+	conf.Backend = &BackendConfig{Type: BackendVault}
+	backend, err = NewBackend(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &vaultBackend{}, backend)
+
+	conf.Backend = &BackendConfig{Type: BackendRemote}
+	_, err = NewBackend(conf, logging.NewNoopLogger())
+	assert.Error(t, err, "remote backend needs an address")
+
+	conf.Backend = &BackendConfig{Type: "filing-cabinet"}
+	_, err = NewBackend(conf, logging.NewNoopLogger())
+	assert.Error(t, err)
+}
+
+// Exercises a Backend that allows private keys to be exported
+func testBackend(t *testing.T, backend Backend) {
+	msg := sha3.Sha3([]byte("the hash of something!"))
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeEd25519, crypto.CurveTypeSecp256k1} {
+		key, err := backend.Generate("", curveType)
+		require.NoError(t, err)
+
+		publicKey, err := backend.PublicKey(key.Address)
+		require.NoError(t, err)
+		assert.Equal(t, key.PublicKey, publicKey)
+
+		sig, err := backend.Sign("", key.Address, msg)
+		require.NoError(t, err)
+		assert.NoError(t, publicKey.Verify(msg, sig))
+
+		exported, err := backend.Export("", key.Address)
+		require.NoError(t, err)
+		assert.Equal(t, key.PrivateKey, exported.PrivateKey)
+	}
+
+	// Keys protected by their own passphrase
+	key, err := NewKey(crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	require.NoError(t, backend.Import("key passphrase", key))
+	publicKey, err := backend.PublicKey(key.Address)
+	require.NoError(t, err, "public key should be available without passphrase")
+	assert.Equal(t, key.PublicKey, publicKey)
+	_, err = backend.Sign("wrong passphrase", key.Address, msg)
+	assert.Error(t, err)
+	sig, err := backend.Sign("key passphrase", key.Address, msg)
+	require.NoError(t, err)
+	assert.NoError(t, publicKey.Verify(msg, sig))
+
+	addresses, err := backend.Addresses()
+	require.NoError(t, err)
+	assert.Len(t, addresses, 3)
+	assert.Contains(t, addresses, key.Address)
+
+	require.NoError(t, backend.Delete("key passphrase", key.Address))
+	_, err = backend.PublicKey(key.Address)
+	assert.Error(t, err)
+	addresses, err = backend.Addresses()
+	require.NoError(t, err)
+	assert.Len(t, addresses, 2)
+}
+
+func backendTestDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "burrow-keys-backend")
+	require.NoError(t, err)
+	return dir, func() {
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,200 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/tmthrgd/go-hex"
+)
+
+// The vault file holds every key as serialised by marshalKey encrypted together under the vault passphrase
+type vaultJSON struct {
+	Keys privateKeyJSON
+}
+
+type vaultBackend struct {
+	sync.RWMutex
+	path       string
+	passphrase string
+	// Serialised keys by address
+	keys   map[crypto.Address]json.RawMessage
+	scrypt ScryptParams
+	logger *logging.Logger
+}
+
+var _ Backend = (*vaultBackend)(nil)
+
+// NewVaultBackend opens (or prepares to create) the vault file at vaultPath. If passphrase is empty the vault is not
+// encrypted as a whole but keys stored with their own passphrase remain encrypted.
+func NewVaultBackend(vaultPath, passphrase string, logger *logging.Logger) (*vaultBackend, error) {
+	vb := &vaultBackend{
+		path:       vaultPath,
+		passphrase: passphrase,
+		keys:       make(map[crypto.Address]json.RawMessage),
+		scrypt:     DefaultScryptParams(),
+		logger:     logger.WithScope("VaultBackend"),
+	}
+	bs, err := ioutil.ReadFile(vaultPath)
+	if os.IsNotExist(err) {
+		return vb, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vault := new(vaultJSON)
+	err = json.Unmarshal(bs, vault)
+	if err != nil {
+		return nil, fmt.Errorf("could not read keys vault %s: %v", vaultPath, err)
+	}
+	plain, err := decryptPrivate(passphrase, vault.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt keys vault %s: %v", vaultPath, err)
+	}
+	keys := make(map[string]json.RawMessage)
+	err = json.Unmarshal(plain, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("could not read keys vault %s: %v", vaultPath, err)
+	}
+	for addr, keyJSON := range keys {
+		address, err := crypto.AddressFromHexString(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s in keys vault %s: %v", addr, vaultPath, err)
+		}
+		vb.keys[address] = keyJSON
+	}
+	vb.logger.InfoMsg("Opened keys vault", "path", vaultPath, "keys", len(vb.keys))
+	return vb, nil
+}
+
+func (vb *vaultBackend) Generate(passphrase string, curveType crypto.CurveType) (*Key, error) {
+	key, err := NewKey(curveType)
+	if err != nil {
+		return nil, err
+	}
+	return key, vb.Import(passphrase, key)
+}
+
+func (vb *vaultBackend) Import(passphrase string, key *Key) error {
+	bs, err := marshalKey(passphrase, key, vb.scrypt)
+	if err != nil {
+		return err
+	}
+	vb.Lock()
+	defer vb.Unlock()
+	previous, replacing := vb.keys[key.Address]
+	vb.keys[key.Address] = bs
+	err = vb.write()
+	if err != nil {
+		// Keep memory consistent with disk
+		if replacing {
+			vb.keys[key.Address] = previous
+		} else {
+			delete(vb.keys, key.Address)
+		}
+	}
+	return err
+}
+
+func (vb *vaultBackend) Export(passphrase string, address crypto.Address) (*Key, error) {
+	bs, err := vb.get(address)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalKey(passphrase, bs)
+}
+
+func (vb *vaultBackend) PublicKey(address crypto.Address) (crypto.PublicKey, error) {
+	bs, err := vb.get(address)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	return unmarshalPublicKey(bs)
+}
+
+func (vb *vaultBackend) Sign(passphrase string, address crypto.Address, message []byte) (crypto.Signature, error) {
+	key, err := vb.Export(passphrase, address)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	return key.PrivateKey.Sign(message)
+}
+
+func (vb *vaultBackend) Addresses() ([]crypto.Address, error) {
+	vb.RLock()
+	defer vb.RUnlock()
+	addresses := make([]crypto.Address, 0, len(vb.keys))
+	for address := range vb.keys {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].String() < addresses[j].String()
+	})
+	return addresses, nil
+}
+
+func (vb *vaultBackend) Delete(passphrase string, address crypto.Address) error {
+	vb.Lock()
+	defer vb.Unlock()
+	previous, ok := vb.keys[address]
+	if !ok {
+		return fmt.Errorf("no key with address %v in keys vault", address)
+	}
+	delete(vb.keys, address)
+	err := vb.write()
+	if err != nil {
+		vb.keys[address] = previous
+	}
+	return err
+}
+
+func (vb *vaultBackend) get(address crypto.Address) (json.RawMessage, error) {
+	vb.RLock()
+	defer vb.RUnlock()
+	bs, ok := vb.keys[address]
+	if !ok {
+		return nil, fmt.Errorf("no key with address %v in keys vault", address)
+	}
+	return bs, nil
+}
+
+// Must hold write lock. Replaces the vault file atomically so that a failed write cannot lose existing keys.
+func (vb *vaultBackend) write() error {
+	keys := make(map[string]json.RawMessage, len(vb.keys))
+	for address, keyJSON := range vb.keys {
+		keys[address.String()] = keyJSON
+	}
+	plain, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	var secret privateKeyJSON
+	if vb.passphrase != "" {
+		secret, err = encryptPrivate(vb.passphrase, plain, vb.scrypt)
+		if err != nil {
+			return err
+		}
+	} else {
+		secret = privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(plain)}
+	}
+	bs, err := json.Marshal(vaultJSON{Keys: secret})
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(path.Dir(vb.path), 0700)
+	if err != nil {
+		return err
+	}
+	tmp := vb.path + ".tmp"
+	err = ioutil.WriteFile(tmp, bs, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, vb.path)
+}
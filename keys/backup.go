@@ -0,0 +1,256 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/tmthrgd/go-hex"
+)
+
+const (
+	// Prefix of encoded shares, also identifying the share format version
+	KeySharePrefix = "BURROW-SHARE-1:"
+	// What a share is a share of
+	ShareOfKey  = "key"
+	ShareOfSeed = "seed"
+
+	printableShareWidth = 64
+)
+
+// Shares use the base32 alphabet so that they can be written down unambiguously and fit the alphanumeric mode of a QR
+// code
+var shareEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// A KeyShare is one of the Shamir shares of a key or seed produced by KeyStore.BackupKey or BackupSeed. The secret
+// shared is the plain text keyJSON of a key, or seedJSON of a seed, in the format they are stored with no passphrase.
+type KeyShare struct {
+	// Identifies the shares split from one secret so that shares of different backups are not mixed
+	BackupID  string
+	Kind      string
+	Address   string `json:",omitempty"`
+	Name      string `json:",omitempty"`
+	Index     int
+	Shares    int
+	Threshold int
+	// Truncated SHA256 of the secret to check recovery
+	Digest string
+	Data   []byte
+}
+
+// Encode produces the printable form of the share
+func (share *KeyShare) Encode() (string, error) {
+	bs, err := json.Marshal(share)
+	if err != nil {
+		return "", err
+	}
+	return KeySharePrefix + shareEncoding.EncodeToString(bs), nil
+}
+
+// Printable produces the encoded share wrapped into short lines under a comment describing it, suitable for printing
+// or writing to a file
+func (share *KeyShare) Printable() (string, error) {
+	encoded, err := share.Encode()
+	if err != nil {
+		return "", err
+	}
+	sb := new(strings.Builder)
+	fmt.Fprintf(sb, "# Burrow %s\n", share.Description())
+	for len(encoded) > printableShareWidth {
+		sb.WriteString(encoded[:printableShareWidth])
+		sb.WriteString("\n")
+		encoded = encoded[printableShareWidth:]
+	}
+	sb.WriteString(encoded)
+	sb.WriteString("\n")
+	return sb.String(), nil
+}
+
+func (share *KeyShare) Description() string {
+	subject := share.Address
+	if share.Kind == ShareOfSeed || share.Address == "" {
+		subject = share.Name
+	}
+	return fmt.Sprintf("share %d of %d (threshold %d) of %s %s from backup %s", share.Index, share.Shares,
+		share.Threshold, share.Kind, subject, share.BackupID)
+}
+
+// DecodeKeyShare reads a share produced by Encode ignoring whitespace and lines starting with '#' so that encoded
+// shares can be wrapped and annotated when printed
+func DecodeKeyShare(encoded string) (*KeyShare, error) {
+	var sb strings.Builder
+	for _, line := range strings.Split(encoded, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		sb.WriteString(strings.Join(strings.Fields(line), ""))
+	}
+	encoded = sb.String()
+	if !strings.HasPrefix(encoded, KeySharePrefix) {
+		return nil, fmt.Errorf("key share should start with %s", KeySharePrefix)
+	}
+	bs, err := shareEncoding.DecodeString(strings.ToUpper(encoded[len(KeySharePrefix):]))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key share: %v", err)
+	}
+	share := new(KeyShare)
+	err = json.Unmarshal(bs, share)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode key share: %v", err)
+	}
+	if share.Index < 1 || share.Index > share.Shares || share.Index > 255 {
+		return nil, fmt.Errorf("key share has invalid index %d", share.Index)
+	}
+	return share, nil
+}
+
+// BackupKey splits the key at address into shares of which any threshold suffice for RecoverFromShares
+func (ks *KeyStore) BackupKey(passphrase string, address crypto.Address, shares, threshold int) ([]*KeyShare, error) {
+	key, err := ks.GetKey(passphrase, address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	secret, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	template := KeyShare{Kind: ShareOfKey, Address: address.String()}
+	names, err := coreNameList(ks.keysDirPath)
+	if err != nil {
+		return nil, err
+	}
+	for name, addr := range names {
+		// Prefer the first name in order should there be several
+		if addr == template.Address && (template.Name == "" || name < template.Name) {
+			template.Name = name
+		}
+	}
+	return splitKeyShares(template, secret, shares, threshold)
+}
+
+// BackupSeed splits the seed stored under name into shares of which any threshold suffice for
+// RecoverFromShares
+func (ks *KeyStore) BackupSeed(passphrase, name string, shares, threshold int) ([]*KeyShare, error) {
+	if name == "" {
+		name = DefaultSeedName
+	}
+	seed, err := ks.GetSeed(passphrase, name)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := json.Marshal(seedJSON{
+		Name: name,
+		Seed: privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(seed)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return splitKeyShares(KeyShare{Kind: ShareOfSeed, Name: name}, secret, shares, threshold)
+}
+
+// RecoverFromShares rebuilds the key or seed from which shares were split and stores it encrypted with passphrase,
+// returning a share describing what was recovered
+func (ks *KeyStore) RecoverFromShares(passphrase string, shares []*KeyShare) (*KeyShare, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("no shares provided")
+	}
+	first := shares[0]
+	ys := make(map[byte][]byte, len(shares))
+	for _, share := range shares {
+		if share.BackupID != first.BackupID || share.Kind != first.Kind || share.Threshold != first.Threshold ||
+			share.Digest != first.Digest {
+			return nil, fmt.Errorf("%s does not belong with %s", share.Description(), first.Description())
+		}
+		if _, ok := ys[byte(share.Index)]; ok {
+			return nil, fmt.Errorf("%s provided more than once", share.Description())
+		}
+		ys[byte(share.Index)] = share.Data
+	}
+	if len(ys) < first.Threshold {
+		return nil, fmt.Errorf("%d shares of backup %s were provided but %d are required", len(ys),
+			first.BackupID, first.Threshold)
+	}
+	secret, err := combineShares(ys)
+	if err != nil {
+		return nil, err
+	}
+	if shareDigest(secret) != first.Digest {
+		return nil, fmt.Errorf("secret recovered from backup %s does not match its digest", first.BackupID)
+	}
+	recovered := &KeyShare{BackupID: first.BackupID, Kind: first.Kind, Name: first.Name, Address: first.Address}
+	switch first.Kind {
+	case ShareOfKey:
+		key := new(Key)
+		err = key.UnmarshalJSON(secret)
+		if err != nil {
+			return nil, fmt.Errorf("could not read recovered key: %v", err)
+		}
+		err = ks.StoreKey(passphrase, key)
+		if err != nil {
+			return nil, err
+		}
+		recovered.Address = key.Address.String()
+		if first.Name != "" {
+			err = coreNameAdd(ks.keysDirPath, first.Name, recovered.Address)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case ShareOfSeed:
+		seedJ := new(seedJSON)
+		err = json.Unmarshal(secret, seedJ)
+		if err != nil {
+			return nil, fmt.Errorf("could not read recovered seed: %v", err)
+		}
+		seed, err := decryptPrivate("", seedJ.Seed)
+		if err != nil {
+			return nil, err
+		}
+		err = ks.storeSeed(passphrase, seedJ.Name, seed)
+		if err != nil {
+			return nil, err
+		}
+		recovered.Name = seedJ.Name
+	default:
+		return nil, fmt.Errorf("unknown kind of share '%s'", first.Kind)
+	}
+	return recovered, nil
+}
+
+func splitKeyShares(template KeyShare, secret []byte, shares, threshold int) ([]*KeyShare, error) {
+	ys, err := splitSecret(secret, shares, threshold)
+	if err != nil {
+		return nil, err
+	}
+	backupID := make([]byte, 4)
+	_, err = rand.Read(backupID)
+	if err != nil {
+		return nil, err
+	}
+	template.BackupID = hex.EncodeUpperToString(backupID)
+	template.Shares = shares
+	template.Threshold = threshold
+	template.Digest = shareDigest(secret)
+	keyShares := make([]*KeyShare, shares)
+	for i, y := range ys {
+		share := template
+		share.Index = i + 1
+		share.Data = y
+		keyShares[i] = &share
+	}
+	// Do not leave the plain text secret in memory longer than necessary
+	for i := range secret {
+		secret[i] = 0
+	}
+	return keyShares, nil
+}
+
+func shareDigest(secret []byte) string {
+	digest := sha256.Sum256(secret)
+	return hex.EncodeUpperToString(digest[:8])
+}
@@ -0,0 +1,120 @@
+package keys
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShamirSplitAndCombine(t *testing.T) {
+	secret := []byte("a secret worth sharing carefully")
+	ys, err := splitSecret(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, ys, 5)
+	for a := 0; a < 5; a++ {
+		for b := a + 1; b < 5; b++ {
+			for c := b + 1; c < 5; c++ {
+				recovered, err := combineShares(map[byte][]byte{
+					byte(a + 1): ys[a], byte(b + 1): ys[b], byte(c + 1): ys[c]})
+				require.NoError(t, err)
+				assert.Equal(t, secret, recovered)
+			}
+		}
+	}
+	recovered, err := combineShares(map[byte][]byte{1: ys[0], 2: ys[1]})
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, recovered)
+
+	_, err = splitSecret(secret, 2, 3)
+	assert.Error(t, err)
+	_, err = splitSecret(secret, 3, 1)
+	assert.Error(t, err)
+}
+
+func TestBackupAndRecover(t *testing.T) {
+	from, cleanupFrom := newBackupTestKeyStore(t)
+	defer cleanupFrom()
+	to, cleanupTo := newBackupTestKeyStore(t)
+	defer cleanupTo()
+	ctx := context.Background()
+
+	gen, err := from.GenerateKey(ctx, &GenRequest{Passphrase: "pass", CurveType: "secp256k1", KeyName: "treasury"})
+	require.NoError(t, err)
+	backup, err := from.Backup(ctx, &BackupRequest{Passphrase: "pass", Name: "treasury", Shares: 5, Threshold: 3})
+	require.NoError(t, err)
+	require.Len(t, backup.Shares, 5)
+
+	// Shares survive being printed
+	var printed []string
+	for _, encoded := range backup.Shares {
+		share, err := DecodeKeyShare(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, "treasury", share.Name)
+		printable, err := share.Printable()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(printable, "#"))
+		printed = append(printed, printable)
+	}
+
+	_, err = to.Recover(ctx, &RecoverRequest{Shares: printed[:2]})
+	assert.Error(t, err, "too few shares")
+	_, err = to.Recover(ctx, &RecoverRequest{Shares: []string{printed[0], printed[0], printed[1]}})
+	assert.Error(t, err, "repeated share")
+
+	recovered, err := to.Recover(ctx, &RecoverRequest{Passphrase: "new", Shares: printed[2:]})
+	require.NoError(t, err)
+	assert.Equal(t, gen.Address, recovered.Address)
+	assert.Equal(t, "treasury", recovered.Name)
+	address, err := crypto.AddressFromHexString(gen.Address)
+	require.NoError(t, err)
+	original, err := from.GetKey("pass", address.Bytes())
+	require.NoError(t, err)
+	key, err := to.GetKey("new", address.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, original.PrivateKey, key.PrivateKey)
+	name, err := coreNameGet(to.keysDirPath, "treasury")
+	require.NoError(t, err)
+	assert.Equal(t, gen.Address, name)
+
+	// Shares from another backup do not mix
+	other, err := from.Backup(ctx, &BackupRequest{Passphrase: "pass", Address: gen.Address, Shares: 3, Threshold: 2})
+	require.NoError(t, err)
+	_, err = to.Recover(ctx, &RecoverRequest{Shares: []string{backup.Shares[0], other.Shares[1], other.Shares[2]}})
+	assert.Error(t, err)
+}
+
+func TestBackupAndRecoverSeed(t *testing.T) {
+	from, cleanupFrom := newBackupTestKeyStore(t)
+	defer cleanupFrom()
+	to, cleanupTo := newBackupTestKeyStore(t)
+	defer cleanupTo()
+	ctx := context.Background()
+
+	_, err := from.GenerateFromSeed(ctx, &GenerateFromSeedRequest{SeedName: "cold"})
+	require.NoError(t, err)
+	backup, err := from.Backup(ctx, &BackupRequest{SeedName: "cold", Shares: 3, Threshold: 2})
+	require.NoError(t, err)
+	recovered, err := to.Recover(ctx, &RecoverRequest{Passphrase: "secret", Shares: backup.Shares[1:]})
+	require.NoError(t, err)
+	assert.Equal(t, "cold", recovered.SeedName)
+
+	seed, err := from.GetSeed("", "cold")
+	require.NoError(t, err)
+	recoveredSeed, err := to.GetSeed("secret", "cold")
+	require.NoError(t, err)
+	assert.Equal(t, seed, recoveredSeed)
+}
+
+func newBackupTestKeyStore(t *testing.T) (*KeyStore, func()) {
+	dir, cleanup := backendTestDir(t)
+	conf := DefaultKeysConfig()
+	conf.KeysDirectory = dir
+	ks, err := NewKeyStoreFromConfig(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	return ks, cleanup
+}
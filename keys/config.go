@@ -1,10 +1,76 @@
 package keys
 
+import "github.com/hyperledger/burrow/rpc/tlsconfig"
+
 type KeysConfig struct {
 	GRPCServiceEnabled      bool
 	AllowBadFilePermissions bool
 	RemoteAddress           string
 	KeysDirectory           string
+	// TLS used to connect to the keys server at RemoteAddress
+	RemoteTLS *tlsconfig.ClientConfig `json:",omitempty" toml:",omitempty"`
+	// TLS served by the standalone keys server
+	ServerTLS *tlsconfig.ServerConfig `json:",omitempty" toml:",omitempty"`
+	// Where the local keys service stores keys, defaults to key files in KeysDirectory
+	Backend *BackendConfig `json:",omitempty" toml:",omitempty"`
+	// Parameters with which to encrypt keys, raising these strengthens newly stored keys and those passed to ReEncrypt
+	Scrypt *ScryptParams `json:",omitempty" toml:",omitempty"`
+	// Restrictions on who may sign what with which keys
+	Policies []*SigningPolicy `json:",omitempty" toml:",omitempty"`
+	// File to which a hash-chained record of every sign request is appended, relative paths are resolved against
+	// KeysDirectory. Empty disables the audit log.
+	AuditLog string `json:",omitempty" toml:",omitempty"`
+	// How a standalone keys server derives addresses from public keys, 'burrow' (the default) or 'ethereum'. When
+	// run as part of a node the chain's GenesisDoc.AddressDerivation is used.
+	AddressDerivation string `json:",omitempty" toml:",omitempty"`
+}
+
+// A SigningPolicy restricts signing with a key. A sign request must satisfy every restriction of the policy applying
+// to its key, which is the policy naming the key by Address or KeyName or failing that a policy with Address '*'.
+// Keys without a policy are unrestricted.
+type SigningPolicy struct {
+	Address string `json:",omitempty" toml:",omitempty"`
+	KeyName string `json:",omitempty" toml:",omitempty"`
+	// TLS client certificate common names or IP addresses of callers allowed to sign, 'local' for callers within the
+	// same process. Empty allows any caller.
+	AllowedCallers []string `json:",omitempty" toml:",omitempty"`
+	// Maximum number of signatures in any minute (0 for no limit)
+	MaxSignaturesPerMinute int `json:",omitempty" toml:",omitempty"`
+	// Transaction types such as 'CallTx' or 'SendTx' that may be signed, including 'Raw' allows messages that are
+	// not transactions (such as consensus votes) to be signed. Empty allows anything.
+	AllowedPayloadTypes []string `json:",omitempty" toml:",omitempty"`
+}
+
+type BackendConfig struct {
+	// One of 'directory', 'vault', 'remote', or 'pkcs11'
+	Type   string
+	Vault  *VaultConfig         `json:",omitempty" toml:",omitempty"`
+	Remote *RemoteBackendConfig `json:",omitempty" toml:",omitempty"`
+	PKCS11 *PKCS11Config        `json:",omitempty" toml:",omitempty"`
+}
+
+// A single file holding every key encrypted together
+type VaultConfig struct {
+	// Path of the vault file, relative paths are resolved against KeysDirectory
+	Path string
+	// Environment variable holding the passphrase protecting the vault (keys may additionally have their own)
+	PassphraseEnv string
+}
+
+// A keys server implementing the Keys gRPC service (see protobuf/keys.proto) that holds keys and signs on our behalf
+type RemoteBackendConfig struct {
+	Address string
+	TLS     *tlsconfig.ClientConfig `json:",omitempty" toml:",omitempty"`
+}
+
+// A PKCS#11 token such as a hardware security module, only available when burrow is built with '-tags pkcs11'
+type PKCS11Config struct {
+	// Path of the PKCS#11 module (shared library) provided by the token vendor
+	Module string
+	// Label of the token holding the keys
+	TokenLabel string
+	// Environment variable holding the user PIN of the token
+	PINEnv string
 }
 
 func DefaultKeysConfig() *KeysConfig {
@@ -16,3 +82,24 @@ func DefaultKeysConfig() *KeysConfig {
 		KeysDirectory:           DefaultKeysDir,
 	}
 }
+
+// ScryptParams returns the configured scrypt parameters or the defaults if there are none
+func (conf *KeysConfig) ScryptParams() (ScryptParams, error) {
+	if conf.Scrypt == nil {
+		return DefaultScryptParams(), nil
+	}
+	return *conf.Scrypt, conf.Scrypt.Validate()
+}
+
+func DefaultVaultConfig() *VaultConfig {
+	return &VaultConfig{
+		Path:          DefaultVaultFile,
+		PassphraseEnv: DefaultVaultPassphraseEnv,
+	}
+}
+
+func DefaultPKCS11Config() *PKCS11Config {
+	return &PKCS11Config{
+		PINEnv: DefaultPKCS11PINEnv,
+	}
+}
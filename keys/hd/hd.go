@@ -0,0 +1,184 @@
+// Package hd implements hierarchical deterministic key derivation from BIP39 mnemonics using BIP32 for secp256k1 keys
+// and SLIP-0010 for ed25519 keys
+package hd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Child indices at or above this offset are hardened
+const HardenedOffset uint32 = 0x80000000
+
+const DefaultEntropyBits = 256
+
+// BIP44 paths used when none is given, ed25519 permits only hardened derivation under SLIP-0010
+const (
+	DefaultSecp256k1Path = "m/44'/60'/0'/0/0"
+	DefaultEd25519Path   = "m/44'/60'/0'/0'/0'"
+)
+
+// Keys used to derive the master key from a seed
+var (
+	secp256k1SeedKey = []byte("Bitcoin seed")
+	ed25519SeedKey   = []byte("ed25519 seed")
+)
+
+// NewMnemonic generates a BIP39 mnemonic from entropyBits of randomness, which must be a multiple of 32 between 128
+// and 256
+func NewMnemonic(entropyBits int) (string, error) {
+	entropy, err := bip39.NewEntropy(entropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// Seed returns the BIP39 seed for mnemonic and the optional passphrase, returning an error if mnemonic is invalid
+func Seed(mnemonic, passphrase string) ([]byte, error) {
+	return bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), passphrase)
+}
+
+// Path is a sequence of child indices from the master key
+type Path []uint32
+
+// ParsePath parses derivation paths of the form m/44'/60'/0'/0/0 where a trailing ' or h marks a hardened index
+func ParsePath(path string) (Path, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("derivation path '%s' should begin with 'm'", path)
+	}
+	p := make(Path, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		var offset uint32
+		if strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") {
+			offset = HardenedOffset
+			segment = segment[:len(segment)-1]
+		}
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid index '%s' in derivation path '%s'", segment, path)
+		}
+		p = append(p, uint32(index)+offset)
+	}
+	return p, nil
+}
+
+// DefaultPath returns the path used for curveType when none is given
+func DefaultPath(curveType crypto.CurveType) Path {
+	var path Path
+	var err error
+	if curveType == crypto.CurveTypeEd25519 {
+		path, err = ParsePath(DefaultEd25519Path)
+	} else {
+		path, err = ParsePath(DefaultSecp256k1Path)
+	}
+	if err != nil {
+		panic(err)
+	}
+	return path
+}
+
+func (p Path) String() string {
+	segments := make([]string, len(p)+1)
+	segments[0] = "m"
+	for i, index := range p {
+		if index >= HardenedOffset {
+			segments[i+1] = strconv.FormatUint(uint64(index-HardenedOffset), 10) + "'"
+		} else {
+			segments[i+1] = strconv.FormatUint(uint64(index), 10)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// DerivePrivateKey derives the private key at path from seed using BIP32 for secp256k1 and SLIP-0010 for ed25519
+func DerivePrivateKey(seed []byte, path Path, curveType crypto.CurveType) (crypto.PrivateKey, error) {
+	var secret []byte
+	var err error
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		secret, err = deriveSecp256k1(seed, path)
+	case crypto.CurveTypeEd25519:
+		secret, err = deriveEd25519(seed, path)
+	default:
+		return crypto.PrivateKey{}, fmt.Errorf("cannot derive keys of curve type %v", curveType)
+	}
+	if err != nil {
+		return crypto.PrivateKey{}, err
+	}
+	return crypto.GeneratePrivateKey(bytes.NewReader(secret), curveType)
+}
+
+func deriveSecp256k1(seed []byte, path Path) ([]byte, error) {
+	n := btcec.S256().N
+	key, chainCode := split(hmacSHA512(secp256k1SeedKey, seed))
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(n) >= 0 {
+		return nil, fmt.Errorf("seed produces an invalid master key")
+	}
+	for _, index := range path {
+		var data []byte
+		if index >= HardenedOffset {
+			data = append([]byte{0}, key...)
+		} else {
+			_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key)
+			data = pub.SerializeCompressed()
+		}
+		il, ir := split(hmacSHA512(chainCode, appendIndex(data, index)))
+		t := new(big.Int).SetBytes(il)
+		if t.Cmp(n) >= 0 {
+			return nil, fmt.Errorf("derivation of index %d produces an invalid key", index)
+		}
+		k.Add(k, t).Mod(k, n)
+		if k.Sign() == 0 {
+			return nil, fmt.Errorf("derivation of index %d produces an invalid key", index)
+		}
+		key = padTo32(k.Bytes())
+		chainCode = ir
+	}
+	return key, nil
+}
+
+func deriveEd25519(seed []byte, path Path) ([]byte, error) {
+	key, chainCode := split(hmacSHA512(ed25519SeedKey, seed))
+	for _, index := range path {
+		if index < HardenedOffset {
+			return nil, fmt.Errorf("ed25519 keys can only be derived at hardened indices but path has index %d", index)
+		}
+		key, chainCode = split(hmacSHA512(chainCode, appendIndex(append([]byte{0}, key...), index)))
+	}
+	return key, nil
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func split(bs []byte) (left, right []byte) {
+	return bs[:32], bs[32:]
+}
+
+func appendIndex(data []byte, index uint32) []byte {
+	bs := make([]byte, 4)
+	binary.BigEndian.PutUint32(bs, index)
+	return append(data, bs...)
+}
+
+func padTo32(bs []byte) []byte {
+	padded := make([]byte, 32)
+	copy(padded[32-len(bs):], bs)
+	return padded
+}
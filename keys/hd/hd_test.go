@@ -0,0 +1,80 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vector 1 from BIP32 and SLIP-0010
+var testSeed, _ = hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+func TestDerivePrivateKey_Secp256k1(t *testing.T) {
+	vectors := map[string]string{
+		"m":           "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"m/0'":        "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		"m/0'/1":      "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		"m/0'/1/2'":   "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+		"m/0h/1/2h/2": "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4",
+	}
+	for path, expected := range vectors {
+		p, err := ParsePath(path)
+		require.NoError(t, err)
+		key, err := DerivePrivateKey(testSeed, p, crypto.CurveTypeSecp256k1)
+		require.NoError(t, err)
+		assert.Equal(t, expected, hex.EncodeToString(key.RawBytes()), path)
+	}
+}
+
+func TestDerivePrivateKey_Ed25519(t *testing.T) {
+	vectors := map[string]string{
+		"m":          "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		"m/0'":       "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		"m/0'/1'":    "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+		"m/0'/1'/2'": "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9",
+	}
+	for path, expected := range vectors {
+		p, err := ParsePath(path)
+		require.NoError(t, err)
+		key, err := DerivePrivateKey(testSeed, p, crypto.CurveTypeEd25519)
+		require.NoError(t, err)
+		// The private key is the 32-byte secret followed by the public key
+		assert.Equal(t, expected, hex.EncodeToString(key.RawBytes()[:32]), path)
+	}
+
+	_, err := DerivePrivateKey(testSeed, Path{0}, crypto.CurveTypeEd25519)
+	assert.Error(t, err, "ed25519 should not permit non-hardened derivation")
+}
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath(DefaultSecp256k1Path)
+	require.NoError(t, err)
+	assert.Equal(t, Path{44 + HardenedOffset, 60 + HardenedOffset, HardenedOffset, 0, 0}, path)
+	assert.Equal(t, DefaultSecp256k1Path, path.String())
+
+	for _, bad := range []string{"", "44'/0", "m/x", "m/2147483648", "m//1"} {
+		_, err = ParsePath(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	mnemonic, err := NewMnemonic(DefaultEntropyBits)
+	require.NoError(t, err)
+	seed, err := Seed(mnemonic, "")
+	require.NoError(t, err)
+	assert.Len(t, seed, 64)
+
+	// Vector from BIP39 reference implementation
+	seed, err = Seed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"TREZOR")
+	require.NoError(t, err)
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf14163"+
+		"0c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+
+	_, err = Seed("abandon abandon abandon", "")
+	assert.Error(t, err)
+}
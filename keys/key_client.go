@@ -21,6 +21,7 @@ import (
 
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
 	"google.golang.org/grpc"
 )
 
@@ -151,10 +152,15 @@ func (l *remoteKeyClient) HealthCheck() error {
 
 // keyClient.New returns a new monax-keys client for provided rpc location
 // Monax-keys connects over http request-responses
-func NewRemoteKeyClient(rpcAddress string, logger *logging.Logger) (KeyClient, error) {
+// If tlsConf is nil or not enabled the connection is plaintext
+func NewRemoteKeyClient(rpcAddress string, tlsConf *tlsconfig.ClientConfig, logger *logging.Logger) (KeyClient, error) {
 	logger = logger.WithScope("RemoteKeyClient")
 	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithInsecure())
+	transportOpt, err := tlsConf.GRPCDialOption()
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, transportOpt)
 	conn, err := grpc.Dial(rpcAddress, opts...)
 	if err != nil {
 		return nil, err
@@ -173,6 +179,8 @@ type Signer struct {
 	keyClient KeyClient
 	address   crypto.Address
 	publicKey crypto.PublicKey
+	// Address under which the signing key is held, which differs from address for accounts that have been rekeyed
+	keyAddress crypto.Address
 }
 
 // Creates a AddressableSigner that assumes the address holds an Ed25519 key
@@ -183,12 +191,24 @@ func AddressableSigner(keyClient KeyClient, address crypto.Address) (*Signer, er
 	}
 	// TODO: we can do better than this and return a typed signature when we reform the keys service
 	return &Signer{
-		keyClient: keyClient,
-		address:   address,
-		publicKey: publicKey,
+		keyClient:  keyClient,
+		address:    address,
+		publicKey:  publicKey,
+		keyAddress: address,
 	}, nil
 }
 
+// Creates an AddressableSigner for the account at address signing with publicKey, which may have been bound to the
+// account by a RekeyTx and so be held under a different address
+func AccountSigner(keyClient KeyClient, address crypto.Address, publicKey crypto.PublicKey) *Signer {
+	return &Signer{
+		keyClient:  keyClient,
+		address:    address,
+		publicKey:  publicKey,
+		keyAddress: publicKey.Address(),
+	}
+}
+
 func (ms *Signer) Address() crypto.Address {
 	return ms.address
 }
@@ -198,7 +218,7 @@ func (ms *Signer) PublicKey() crypto.PublicKey {
 }
 
 func (ms *Signer) Sign(messsage []byte) (crypto.Signature, error) {
-	signature, err := ms.keyClient.Sign(ms.address, messsage)
+	signature, err := ms.keyClient.Sign(ms.keyAddress, messsage)
 	if err != nil {
 		return crypto.Signature{}, err
 	}
@@ -0,0 +1,350 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/tmthrgd/go-hex"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Formats in which keys can be imported and exported
+const (
+	// Burrow's own key JSON
+	KeyFormatBurrow = "burrow"
+	// Ethereum Web3 Secret Storage (V3) as written by geth
+	KeyFormatEthereum = "ethereum"
+	// Tendermint's priv_validator_key.json
+	KeyFormatTendermint = "tendermint"
+)
+
+const (
+	ethereumKeyVersion   = 3
+	ethereumCipher       = "aes-128-ctr"
+	ethereumKDFScrypt    = "scrypt"
+	ethereumKDFPBKDF2    = "pbkdf2"
+	ethereumPRF          = "hmac-sha256"
+	ethereumKeyDKLen     = 32
+	tendermintTypePrefix = "tendermint/"
+	// Bound on pbkdf2 iterations (geth uses 262144) for the same reason ScryptParams are bounded
+	maxPBKDF2Iterations = 1 << 22
+)
+
+type ethereumKeyJSON struct {
+	Address string             `json:"address"`
+	Crypto  ethereumCryptoJSON `json:"crypto"`
+	ID      string             `json:"id"`
+	Version int                `json:"version"`
+}
+
+type ethereumCryptoJSON struct {
+	Cipher       string                   `json:"cipher"`
+	CipherText   string                   `json:"ciphertext"`
+	CipherParams ethereumCipherParamsJSON `json:"cipherparams"`
+	KDF          string                   `json:"kdf"`
+	KDFParams    ethereumKDFParamsJSON    `json:"kdfparams"`
+	MAC          string                   `json:"mac"`
+}
+
+type ethereumCipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// Union of the scrypt (n, r, p) and pbkdf2 (c, prf) parameters
+type ethereumKDFParamsJSON struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+}
+
+type tendermintKeyJSON struct {
+	Address string             `json:"address"`
+	PubKey  tendermintTypedKey `json:"pub_key"`
+	PrivKey tendermintTypedKey `json:"priv_key"`
+}
+
+// Amino JSON encoding of a key
+type tendermintTypedKey struct {
+	Type  string `json:"type"`
+	Value []byte `json:"value"`
+}
+
+// DetectKeyFormat returns the format of keyJSON, which is assumed to be KeyFormatBurrow if it is neither of the others
+func DetectKeyFormat(keyJSON []byte) (string, error) {
+	probe := new(struct {
+		Version int             `json:"version"`
+		Crypto  json.RawMessage `json:"crypto"`
+		PrivKey json.RawMessage `json:"priv_key"`
+	})
+	err := json.Unmarshal(keyJSON, probe)
+	if err != nil {
+		return "", fmt.Errorf("could not read key JSON: %v", err)
+	}
+	switch {
+	case len(probe.Crypto) > 0:
+		if probe.Version != ethereumKeyVersion {
+			return "", fmt.Errorf("only version %d Ethereum keys are supported but key has version %d",
+				ethereumKeyVersion, probe.Version)
+		}
+		return KeyFormatEthereum, nil
+	case len(probe.PrivKey) > 0:
+		return KeyFormatTendermint, nil
+	default:
+		return KeyFormatBurrow, nil
+	}
+}
+
+// EncodeKey encodes key in format, encrypting it with passphrase for those formats that support encryption
+func EncodeKey(key *Key, passphrase, format string, params ScryptParams) ([]byte, error) {
+	switch format {
+	case "", KeyFormatBurrow:
+		return marshalKey(passphrase, key, params)
+	case KeyFormatEthereum:
+		return EncodeEthereumKey(key, passphrase, params)
+	case KeyFormatTendermint:
+		return EncodeTendermintKey(key)
+	default:
+		return nil, fmt.Errorf("unknown key format '%s', expected one of %s, %s, or %s", format,
+			KeyFormatBurrow, KeyFormatEthereum, KeyFormatTendermint)
+	}
+}
+
+// DecodeKey decodes a key in any of the supported formats, decrypting it with passphrase if it is encrypted
+func DecodeKey(passphrase string, keyJSON []byte) (*Key, error) {
+	format, err := DetectKeyFormat(keyJSON)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case KeyFormatEthereum:
+		return DecodeEthereumKey(passphrase, keyJSON)
+	case KeyFormatTendermint:
+		return DecodeTendermintKey(keyJSON)
+	default:
+		return unmarshalKey(passphrase, keyJSON)
+	}
+}
+
+// EncodeEthereumKey encrypts a secp256k1 key with passphrase in the Web3 Secret Storage (V3) format using scrypt
+// with params
+func EncodeEthereumKey(key *Key, passphrase string, params ScryptParams) ([]byte, error) {
+	address, err := key.PublicKey.EthereumAddress()
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, bs := range [][]byte{salt, iv, id} {
+		_, err = rand.Read(bs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, ethereumKeyDKLen)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTR(derivedKey[:16], iv, key.PrivateKey.RawBytes())
+	if err != nil {
+		return nil, err
+	}
+	// Random (version 4) UUID
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return json.Marshal(ethereumKeyJSON{
+		Address: strings.ToLower(address.String()),
+		Crypto: ethereumCryptoJSON{
+			Cipher:       ethereumCipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: ethereumCipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          ethereumKDFScrypt,
+			KDFParams: ethereumKDFParamsJSON{
+				DKLen: ethereumKeyDKLen,
+				Salt:  hex.EncodeToString(salt),
+				N:     params.N,
+				R:     params.R,
+				P:     params.P,
+			},
+			MAC: hex.EncodeToString(ethereumMAC(derivedKey, cipherText)),
+		},
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version: ethereumKeyVersion,
+	})
+}
+
+// DecodeEthereumKey decrypts a Web3 Secret Storage (V3) key encrypted with either scrypt or pbkdf2
+func DecodeEthereumKey(passphrase string, keyJSON []byte) (*Key, error) {
+	ethKey := new(ethereumKeyJSON)
+	err := json.Unmarshal(keyJSON, ethKey)
+	if err != nil {
+		return nil, err
+	}
+	if ethKey.Version != ethereumKeyVersion {
+		return nil, fmt.Errorf("only version %d Ethereum keys are supported but key has version %d",
+			ethereumKeyVersion, ethKey.Version)
+	}
+	keyCrypto := ethKey.Crypto
+	if keyCrypto.Cipher != ethereumCipher {
+		return nil, fmt.Errorf("Ethereum key uses unsupported cipher '%s'", keyCrypto.Cipher)
+	}
+	kdfParams := keyCrypto.KDFParams
+	salt, err := hex.DecodeString(kdfParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key salt: %v", err)
+	}
+	// The derivation parameters come from the key itself so are checked before we spend any effort on them
+	if kdfParams.DKLen != ethereumKeyDKLen {
+		return nil, fmt.Errorf("Ethereum key derived key length must be %d but is %d", ethereumKeyDKLen,
+			kdfParams.DKLen)
+	}
+	var derivedKey []byte
+	switch keyCrypto.KDF {
+	case ethereumKDFScrypt:
+		params := ScryptParams{N: kdfParams.N, R: kdfParams.R, P: kdfParams.P}
+		err = params.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("Ethereum key has invalid scrypt parameters: %v", err)
+		}
+		derivedKey, err = scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, ethereumKeyDKLen)
+		if err != nil {
+			return nil, err
+		}
+	case ethereumKDFPBKDF2:
+		if kdfParams.PRF != ethereumPRF {
+			return nil, fmt.Errorf("Ethereum key uses unsupported pbkdf2 PRF '%s'", kdfParams.PRF)
+		}
+		if kdfParams.C <= 0 || kdfParams.C > maxPBKDF2Iterations {
+			return nil, fmt.Errorf("Ethereum key pbkdf2 iterations must be positive and at most %d but is %d",
+				maxPBKDF2Iterations, kdfParams.C)
+		}
+		derivedKey = pbkdf2.Key([]byte(passphrase), salt, kdfParams.C, ethereumKeyDKLen, sha256.New)
+	default:
+		return nil, fmt.Errorf("Ethereum key uses unsupported key derivation function '%s'", keyCrypto.KDF)
+	}
+	cipherText, err := hex.DecodeString(keyCrypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key cipher text: %v", err)
+	}
+	mac, err := hex.DecodeString(keyCrypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key MAC: %v", err)
+	}
+	if !bytes.Equal(mac, ethereumMAC(derivedKey, cipherText)) {
+		return nil, fmt.Errorf("could not decrypt Ethereum key: MAC mismatch (wrong passphrase?)")
+	}
+	iv, err := hex.DecodeString(keyCrypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode Ethereum key IV: %v", err)
+	}
+	privateKey, err := aesCTR(derivedKey[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	key, err := NewKeyFromPriv(crypto.CurveTypeSecp256k1, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	if ethKey.Address != "" {
+		address, err := key.PublicKey.EthereumAddress()
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(strings.TrimPrefix(ethKey.Address, "0x"), address.String()) {
+			return nil, fmt.Errorf("Ethereum key has address %s but its private key has address %v",
+				ethKey.Address, address)
+		}
+	}
+	return key, nil
+}
+
+// EncodeTendermintKey encodes key as a Tendermint priv_validator_key.json
+func EncodeTendermintKey(key *Key) ([]byte, error) {
+	typeName, err := tendermintKeyTypeName(key.CurveType)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(tendermintKeyJSON{
+		Address: key.PublicKey.TendermintAddress().String(),
+		PubKey: tendermintTypedKey{
+			Type:  tendermintTypePrefix + "PubKey" + typeName,
+			Value: key.PublicKey.PublicKey,
+		},
+		PrivKey: tendermintTypedKey{
+			Type:  tendermintTypePrefix + "PrivKey" + typeName,
+			Value: key.PrivateKey.RawBytes(),
+		},
+	}, "", "  ")
+}
+
+// DecodeTendermintKey reads a Tendermint priv_validator_key.json
+func DecodeTendermintKey(keyJSON []byte) (*Key, error) {
+	tmKey := new(tendermintKeyJSON)
+	err := json.Unmarshal(keyJSON, tmKey)
+	if err != nil {
+		return nil, err
+	}
+	var curveType crypto.CurveType
+	switch tmKey.PrivKey.Type {
+	case tendermintTypePrefix + "PrivKeyEd25519":
+		curveType = crypto.CurveTypeEd25519
+	case tendermintTypePrefix + "PrivKeySecp256k1":
+		curveType = crypto.CurveTypeSecp256k1
+	default:
+		return nil, fmt.Errorf("unsupported Tendermint private key type '%s'", tmKey.PrivKey.Type)
+	}
+	key, err := NewKeyFromPriv(curveType, tmKey.PrivKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(tmKey.PubKey.Value) > 0 && !bytes.Equal(tmKey.PubKey.Value, key.PublicKey.PublicKey) {
+		return nil, fmt.Errorf("Tendermint key has public key %X but its private key has public key %X",
+			tmKey.PubKey.Value, key.PublicKey.PublicKey)
+	}
+	if tmKey.Address != "" && !strings.EqualFold(tmKey.Address, key.PublicKey.TendermintAddress().String()) {
+		return nil, fmt.Errorf("Tendermint key has address %s but its private key has address %v",
+			tmKey.Address, key.PublicKey.TendermintAddress())
+	}
+	return key, nil
+}
+
+func tendermintKeyTypeName(curveType crypto.CurveType) (string, error) {
+	switch curveType {
+	case crypto.CurveTypeEd25519:
+		return "Ed25519", nil
+	case crypto.CurveTypeSecp256k1:
+		return "Secp256k1", nil
+	default:
+		return "", fmt.Errorf("Tendermint does not support keys with curve type %v", curveType)
+	}
+}
+
+func ethereumMAC(derivedKey, cipherText []byte) []byte {
+	return sha3.Sha3(derivedKey[16:32], cipherText)
+}
+
+func aesCTR(key, iv, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("IV should have length %d but has length %d", aes.BlockSize, len(iv))
+	}
+	out := make([]byte, len(text))
+	cipher.NewCTR(block, iv).XORKeyStream(out, text)
+	return out, nil
+}
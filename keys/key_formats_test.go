@@ -0,0 +1,159 @@
+package keys
+
+import (
+	"context"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/go-amino"
+	tmEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tmthrgd/go-hex"
+)
+
+// Test vectors from the Web3 Secret Storage Definition
+const (
+	ethereumTestPassphrase = "testpassword"
+	ethereumTestPrivateKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	ethereumTestAddress    = "008AEEDA4D805471DF9B2A5B0F38A0C3BCBA786B"
+	ethereumPBKDF2Key      = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},` +
+		`"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2",` +
+		`"kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256",` +
+		`"salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},` +
+		`"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},` +
+		`"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+	ethereumScryptKey = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},` +
+		`"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt",` +
+		`"kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,` +
+		`"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},` +
+		`"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},` +
+		`"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+func TestDecodeEthereumKey(t *testing.T) {
+	for _, keyJSON := range []string{ethereumPBKDF2Key, ethereumScryptKey} {
+		format, err := DetectKeyFormat([]byte(keyJSON))
+		require.NoError(t, err)
+		assert.Equal(t, KeyFormatEthereum, format)
+		key, err := DecodeKey(ethereumTestPassphrase, []byte(keyJSON))
+		require.NoError(t, err)
+		assert.Equal(t, ethereumTestPrivateKey, hex.EncodeToString(key.PrivateKey.RawBytes()))
+		address, err := key.PublicKey.EthereumAddress()
+		require.NoError(t, err)
+		assert.Equal(t, ethereumTestAddress, address.String())
+
+		_, err = DecodeKey("wrong", []byte(keyJSON))
+		assert.Error(t, err)
+	}
+}
+
+func TestDecodeEthereumKeyBoundsKDFParams(t *testing.T) {
+	for _, keyJSON := range []string{
+		strings.Replace(ethereumScryptKey, `"n":262144`, `"n":1073741824`, 1),
+		strings.Replace(ethereumScryptKey, `"n":262144`, `"n":262143`, 1),
+		strings.Replace(ethereumScryptKey, `"r":1,"p":8`, `"r":1,"p":1048576`, 1),
+		strings.Replace(ethereumScryptKey, `"r":1,"p":8`, `"r":32,"p":1`, 1),
+		strings.Replace(ethereumScryptKey, `"dklen":32`, `"dklen":1073741824`, 1),
+		strings.Replace(ethereumPBKDF2Key, `"c":262144`, `"c":2147483647`, 1),
+		strings.Replace(ethereumPBKDF2Key, `"c":262144`, `"c":0`, 1),
+		strings.Replace(ethereumPBKDF2Key, `"dklen":32`, `"dklen":16`, 1),
+	} {
+		_, err := DecodeKey(ethereumTestPassphrase, []byte(keyJSON))
+		assert.Error(t, err, keyJSON)
+	}
+}
+
+func TestKeyFormatsRoundTrip(t *testing.T) {
+	params := ScryptParams{N: 1 << 10, R: 8, P: 1}
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeEd25519, crypto.CurveTypeSecp256k1} {
+		key, err := NewKey(curveType)
+		require.NoError(t, err)
+		formats := []string{KeyFormatBurrow, KeyFormatTendermint}
+		if curveType == crypto.CurveTypeSecp256k1 {
+			formats = append(formats, KeyFormatEthereum)
+		}
+		for _, format := range formats {
+			keyJSON, err := EncodeKey(key, "pass", format, params)
+			require.NoError(t, err)
+			detected, err := DetectKeyFormat(keyJSON)
+			require.NoError(t, err)
+			assert.Equal(t, format, detected)
+			decoded, err := DecodeKey("pass", keyJSON)
+			require.NoError(t, err)
+			assert.Equal(t, key.PrivateKey, decoded.PrivateKey, "%v key in %s format", curveType, format)
+			assert.Equal(t, key.Address, decoded.Address)
+		}
+	}
+	key, err := NewKey(crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	_, err = EncodeKey(key, "pass", KeyFormatEthereum, params)
+	assert.Error(t, err, "ethereum keys must be secp256k1")
+	_, err = EncodeKey(key, "pass", "pem", params)
+	assert.Error(t, err)
+}
+
+func TestTendermintKeyCompatibility(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	// As written by Tendermint
+	pvFile := path.Join(dir, "priv_validator.json")
+	pv := privval.GenFilePV(pvFile)
+	pv.Save()
+	pvJSON, err := ioutil.ReadFile(pvFile)
+	require.NoError(t, err)
+	format, err := DetectKeyFormat(pvJSON)
+	require.NoError(t, err)
+	assert.Equal(t, KeyFormatTendermint, format)
+	key, err := DecodeKey("", pvJSON)
+	require.NoError(t, err)
+	privKey := pv.PrivKey.(tmEd25519.PrivKeyEd25519)
+	assert.Equal(t, privKey[:], key.PrivateKey.RawBytes())
+	assert.Equal(t, pv.GetAddress().Bytes(), key.PublicKey.TendermintAddress().Bytes())
+
+	// As read by Tendermint
+	keyJSON, err := EncodeTendermintKey(key)
+	require.NoError(t, err)
+	cdc := amino.NewCodec()
+	cryptoAmino.RegisterAmino(cdc)
+	readPV := new(privval.FilePV)
+	require.NoError(t, cdc.UnmarshalJSON(keyJSON, readPV))
+	assert.Equal(t, pv.PrivKey, readPV.PrivKey)
+	assert.Equal(t, pv.PubKey, readPV.PubKey)
+	assert.Equal(t, pv.Address, readPV.Address)
+}
+
+func TestImportExportFormats(t *testing.T) {
+	ks, cleanup := newBackupTestKeyStore(t)
+	defer cleanup()
+	ks.scrypt = ScryptParams{N: 1 << 10, R: 8, P: 1}
+	ctx := context.Background()
+
+	imported, err := ks.ImportJSON(ctx, &ImportJSONRequest{Passphrase: ethereumTestPassphrase, JSON: ethereumPBKDF2Key})
+	require.NoError(t, err)
+	address, err := crypto.AddressFromHexString(imported.Address)
+	require.NoError(t, err)
+	key, err := ks.GetKey(ethereumTestPassphrase, address.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, ethereumTestPrivateKey, hex.EncodeToString(key.PrivateKey.RawBytes()))
+
+	exported, err := ks.Export(ctx, &ExportRequest{Passphrase: ethereumTestPassphrase, Address: imported.Address,
+		Format: KeyFormatEthereum})
+	require.NoError(t, err)
+	assert.Empty(t, exported.Privatekey)
+	assert.Contains(t, exported.JSON, strings.ToLower(ethereumTestAddress))
+
+	exported, err = ks.Export(ctx, &ExportRequest{Passphrase: ethereumTestPassphrase, Address: imported.Address,
+		Format: KeyFormatTendermint})
+	require.NoError(t, err)
+	other, cleanupOther := newBackupTestKeyStore(t)
+	defer cleanupOther()
+	reimported, err := other.ImportJSON(ctx, &ImportJSONRequest{JSON: exported.JSON})
+	require.NoError(t, err)
+	assert.Equal(t, imported.Address, reimported.Address)
+}
@@ -21,9 +21,6 @@ import (
 )
 
 const (
-	scryptN       = 1 << 18
-	scryptr       = 8
-	scryptp       = 1
 	scryptdkLen   = 32
 	CryptoNone    = "none"
 	CryptoAESGCM  = "scrypt-aes-gcm"
@@ -31,6 +28,45 @@ const (
 	HashSecp256k1 = "btc"
 )
 
+// Cost parameters of the scrypt key derivation function used to encrypt private keys
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+// Parameters used for keys encrypted without recording their parameters
+var legacyScryptParams = ScryptParams{N: 1 << 18, R: 8, P: 1}
+
+func DefaultScryptParams() ScryptParams {
+	return legacyScryptParams
+}
+
+// Bounds on scrypt parameters, which are read from the keys we decrypt (including those imported over the network),
+// so that a key cannot make us spend unbounded memory or CPU deriving its encryption key
+const (
+	maxScryptN = 1 << 20
+	// scrypt uses 128*N*R bytes of memory
+	maxScryptNR = 1 << 23
+	maxScryptRP = 16
+)
+
+func (sp ScryptParams) Validate() error {
+	// Restrictions from scrypt.Key
+	if sp.N <= 1 || sp.N&(sp.N-1) != 0 || sp.N > maxScryptN {
+		return fmt.Errorf("scrypt N must be a power of two greater than one and at most %d but is %d", maxScryptN,
+			sp.N)
+	}
+	if sp.R <= 0 || sp.P <= 0 || sp.R > maxScryptRP || sp.P > maxScryptRP || sp.R*sp.P > maxScryptRP {
+		return fmt.Errorf("scrypt R and P must be positive with R*P at most %d but R is %d and P is %d",
+			maxScryptRP, sp.R, sp.P)
+	}
+	if sp.N*sp.R > maxScryptNR {
+		return fmt.Errorf("scrypt N*R must be at most %d but N is %d and R is %d", maxScryptNR, sp.N, sp.R)
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // json encodings
 
@@ -42,6 +78,8 @@ type keyJSON struct {
 	PublicKey   string
 	AddressHash string
 	PrivateKey  privateKeyJSON
+	// BIP32 path from which the key was derived from a stored seed
+	DerivationPath string `json:",omitempty"`
 }
 
 type privateKeyJSON struct {
@@ -50,15 +88,18 @@ type privateKeyJSON struct {
 	Salt       []byte `json:",omitempty"`
 	Nonce      []byte `json:",omitempty"`
 	CipherText []byte `json:",omitempty"`
+	// Absent for keys encrypted before parameters were recorded
+	Scrypt *ScryptParams `json:",omitempty"`
 }
 
 func (k *Key) MarshalJSON() (j []byte, err error) {
 	jStruct := keyJSON{
-		CurveType:   k.CurveType.String(),
-		Address:     hex.EncodeUpperToString(k.Address[:]),
-		PublicKey:   hex.EncodeUpperToString(k.Pubkey()),
-		AddressHash: k.PublicKey.AddressHashType(),
-		PrivateKey:  privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(k.PrivateKey.RawBytes())},
+		CurveType:      k.CurveType.String(),
+		Address:        hex.EncodeUpperToString(k.Address[:]),
+		PublicKey:      hex.EncodeUpperToString(k.Pubkey()),
+		AddressHash:    k.PublicKey.AddressHashType(),
+		PrivateKey:     privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(k.PrivateKey.RawBytes())},
+		DerivationPath: k.DerivationPath,
 	}
 	j, err = json.Marshal(jStruct)
 	return j, err
@@ -90,6 +131,7 @@ func (k *Key) UnmarshalJSON(j []byte) (err error) {
 	k.CurveType = curveType
 	k.PublicKey = k2.PrivateKey.GetPublicKey()
 	k.PrivateKey = k2.PrivateKey
+	k.DerivationPath = keyJ.DerivationPath
 
 	return nil
 }
@@ -106,18 +148,63 @@ func IsValidKeyJson(j []byte) []byte {
 }
 
 func NewKeyStore(dir string, AllowBadFilePermissions bool, logger *logging.Logger) *KeyStore {
+	return NewKeyStoreWithBackend(dir, NewDirectoryBackend(dir, AllowBadFilePermissions, logger), logger)
+}
+
+// NewKeyStoreWithBackend returns a KeyStore holding its keys in backend and its names and seeds in dir
+func NewKeyStoreWithBackend(dir string, backend Backend, logger *logging.Logger) *KeyStore {
 	return &KeyStore{
-		keysDirPath:             dir,
-		AllowBadFilePermissions: AllowBadFilePermissions,
-		logger:                  logger.With(structure.ComponentKey, "keys").WithScope("NewKeyStore"),
+		keysDirPath: dir,
+		backend:     backend,
+		scrypt:      DefaultScryptParams(),
+		sessions:    newSessions(),
+		logger:      logger.With(structure.ComponentKey, "keys").WithScope("NewKeyStore"),
+	}
+}
+
+// NewKeyStoreFromConfig returns a KeyStore using the backend selected by conf
+func NewKeyStoreFromConfig(conf *KeysConfig, logger *logging.Logger) (*KeyStore, error) {
+	backend, err := NewBackend(conf, logger)
+	if err != nil {
+		return nil, err
+	}
+	ks := NewKeyStoreWithBackend(conf.KeysDirectory, backend, logger)
+	ks.scrypt, err = conf.ScryptParams()
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.Policies) > 0 {
+		ks.policies = newPolicyEnforcer(conf.KeysDirectory, conf.Policies)
+	}
+	if conf.AuditLog != "" {
+		auditPath := conf.AuditLog
+		if !path.IsAbs(auditPath) {
+			auditPath = path.Join(conf.KeysDirectory, auditPath)
+		}
+		ks.audit, err = openAuditLog(auditPath)
+		if err != nil {
+			return nil, err
+		}
 	}
+	return ks, nil
 }
 
 type KeyStore struct {
 	sync.Mutex
-	AllowBadFilePermissions bool
-	keysDirPath             string
-	logger                  *logging.Logger
+	keysDirPath string
+	backend     Backend
+	// Parameters with which to encrypt seeds
+	scrypt   ScryptParams
+	sessions *sessions
+	// Optional restrictions on signing
+	policies *policyEnforcer
+	// Optional record of sign requests
+	audit  *auditLog
+	logger *logging.Logger
+}
+
+func (ks *KeyStore) Backend() Backend {
+	return ks.backend
 }
 
 func (ks *KeyStore) Gen(passphrase string, curveType crypto.CurveType) (key *Key, err error) {
@@ -126,46 +213,19 @@ func (ks *KeyStore) Gen(passphrase string, curveType crypto.CurveType) (key *Key
 			err = fmt.Errorf("GenerateNewKey error: %v", r)
 		}
 	}()
-	key, err = NewKey(curveType)
-	if err != nil {
-		return nil, err
-	}
-	err = ks.StoreKey(passphrase, key)
-	return key, err
+	return ks.backend.Generate(passphrase, curveType)
 }
 
 func (ks *KeyStore) GetKey(passphrase string, keyAddr []byte) (*Key, error) {
-	ks.Lock()
-	defer ks.Unlock()
-	dataDirPath, err := returnDataDir(ks.keysDirPath)
+	address, err := crypto.AddressFromBytes(keyAddr)
 	if err != nil {
 		return nil, err
 	}
-	fileContent, err := ks.GetKeyFile(dataDirPath, keyAddr)
-	if err != nil {
-		return nil, err
-	}
-	key := new(keyJSON)
-	if err = json.Unmarshal(fileContent, key); err != nil {
-		return nil, err
-	}
-
-	if len(key.PrivateKey.CipherText) > 0 {
-		return DecryptKey(passphrase, key)
-	} else {
-		key := new(Key)
-		err = key.UnmarshalJSON(fileContent)
-		return key, err
-	}
+	return ks.backend.Export(passphrase, address)
 }
 
 func (ks *KeyStore) AllKeys() ([]*Key, error) {
-
-	dataDirPath, err := returnDataDir(ks.keysDirPath)
-	if err != nil {
-		return nil, err
-	}
-	addrs, err := GetAllAddresses(dataDirPath)
+	addrs, err := ks.backend.Addresses()
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +233,7 @@ func (ks *KeyStore) AllKeys() ([]*Key, error) {
 	var list []*Key
 
 	for _, addr := range addrs {
-		k, err := ks.GetKey("", addr)
+		k, err := ks.backend.Export("", addr)
 		if err != nil {
 			return nil, err
 		}
@@ -184,32 +244,15 @@ func (ks *KeyStore) AllKeys() ([]*Key, error) {
 }
 
 func DecryptKey(passphrase string, keyProtected *keyJSON) (*Key, error) {
-	salt := keyProtected.PrivateKey.Salt
-	nonce := keyProtected.PrivateKey.Nonce
-	cipherText := keyProtected.PrivateKey.CipherText
-
 	curveType, err := crypto.CurveTypeFromString(keyProtected.CurveType)
 	if err != nil {
 		return nil, err
 	}
-	authArray := []byte(passphrase)
-	derivedKey, err := scrypt.Key(authArray, salt, scryptN, scryptr, scryptp, scryptdkLen)
-	if err != nil {
-		return nil, err
-	}
-	aesBlock, err := aes.NewCipher(derivedKey)
-	if err != nil {
-		return nil, err
-	}
-	gcm, err := cipher.NewGCM(aesBlock)
-	if err != nil {
-		return nil, err
-	}
 	pubKey, err := hex.DecodeString(keyProtected.PublicKey)
 	if err != nil {
 		return nil, err
 	}
-	plainText, err := gcm.Open(nil, nonce, cipherText, nil)
+	plainText, err := decryptPrivate(passphrase, keyProtected.PrivateKey)
 	if err != nil {
 		pkey, _ := NewKeyFromPub(curveType, pubKey)
 		return pkey, err
@@ -225,127 +268,158 @@ func DecryptKey(passphrase string, keyProtected *keyJSON) (*Key, error) {
 	if address != k.Address {
 		return nil, fmt.Errorf("address does not match")
 	}
+	k.DerivationPath = keyProtected.DerivationPath
 	return k, nil
 }
 
 func (ks *KeyStore) GetAllAddresses() (addresses [][]byte, err error) {
-	ks.Lock()
-	defer ks.Unlock()
-	return GetAllAddresses(ks.keysDirPath)
+	addrs, err := ks.backend.Addresses()
+	if err != nil {
+		return nil, err
+	}
+	addresses = make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.Bytes()
+	}
+	return addresses, nil
 }
 
 func (ks *KeyStore) StoreKey(passphrase string, key *Key) error {
-	ks.Lock()
-	defer ks.Unlock()
-	if passphrase != "" {
-		return ks.StoreKeyEncrypted(passphrase, key)
-	} else {
-		return ks.StoreKeyPlain(key)
-	}
+	return ks.backend.Import(passphrase, key)
 }
 
-func (ks *KeyStore) StoreKeyPlain(key *Key) (err error) {
-	keyJSON, err := json.Marshal(key)
+func (ks *KeyStore) DeleteKey(passphrase string, keyAddr []byte) (err error) {
+	address, err := crypto.AddressFromBytes(keyAddr)
 	if err != nil {
 		return err
 	}
-	dataDirPath, err := returnDataDir(ks.keysDirPath)
+	ks.LockKeyAt(address)
+	return ks.backend.Delete(passphrase, address)
+}
+
+// Serialises key with its private key encrypted by passphrase, or in plain text if passphrase is empty
+func marshalKey(passphrase string, key *Key, params ScryptParams) ([]byte, error) {
+	if passphrase == "" {
+		return json.Marshal(key)
+	}
+	cipherStruct, err := encryptPrivate(passphrase, key.PrivateKey.RawBytes(), params)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return json.Marshal(keyJSON{
+		CurveType:      key.CurveType.String(),
+		Address:        hex.EncodeUpperToString(key.Address[:]),
+		PublicKey:      hex.EncodeUpperToString(key.Pubkey()),
+		AddressHash:    key.PublicKey.AddressHashType(),
+		PrivateKey:     cipherStruct,
+		DerivationPath: key.DerivationPath,
+	})
+}
+
+// Reads a key serialised by marshalKey
+func unmarshalKey(passphrase string, bs []byte) (*Key, error) {
+	keyJ := new(keyJSON)
+	if err := json.Unmarshal(bs, keyJ); err != nil {
+		return nil, err
+	}
+	if len(keyJ.PrivateKey.CipherText) > 0 {
+		return DecryptKey(passphrase, keyJ)
+	}
+	key := new(Key)
+	err := key.UnmarshalJSON(bs)
+	return key, err
+}
+
+// Reads the public key of a key serialised by marshalKey without decrypting it
+func unmarshalPublicKey(bs []byte) (crypto.PublicKey, error) {
+	keyJ := new(keyJSON)
+	if err := json.Unmarshal(bs, keyJ); err != nil {
+		return crypto.PublicKey{}, err
+	}
+	curveType, err := crypto.CurveTypeFromString(keyJ.CurveType)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	pubKey, err := hex.DecodeString(keyJ.PublicKey)
+	if err != nil {
+		return crypto.PublicKey{}, err
 	}
-	err = WriteKeyFile(key.Address[:], dataDirPath, keyJSON)
-	return err
+	return crypto.PublicKeyFromBytes(pubKey, curveType)
 }
 
-func (ks *KeyStore) StoreKeyEncrypted(passphrase string, key *Key) error {
+// Encrypts secret with a key derived from passphrase using params
+func encryptPrivate(passphrase string, secret []byte, params ScryptParams) (privateKeyJSON, error) {
 	authArray := []byte(passphrase)
 	salt := make([]byte, 32)
 	_, err := rand.Read(salt)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
-	derivedKey, err := scrypt.Key(authArray, salt, scryptN, scryptr, scryptp, scryptdkLen)
+	derivedKey, err := scrypt.Key(authArray, salt, params.N, params.R, params.P, scryptdkLen)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
-	toEncrypt := key.PrivateKey.RawBytes()
-
 	AES256Block, err := aes.NewCipher(derivedKey)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
 	gcm, err := cipher.NewGCM(AES256Block)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
 	// XXX: a GCM nonce may only be used once per key ever!
 	nonce := make([]byte, gcm.NonceSize())
 	_, err = rand.Read(nonce)
 	if err != nil {
-		return err
+		return privateKeyJSON{}, err
 	}
 
 	// (dst, nonce, plaintext, extradata)
-	cipherText := gcm.Seal(nil, nonce, toEncrypt, nil)
+	cipherText := gcm.Seal(nil, nonce, secret, nil)
 
-	cipherStruct := privateKeyJSON{
-		Crypto: CryptoAESGCM, Salt: salt, Nonce: nonce, CipherText: cipherText,
-	}
-	keyStruct := keyJSON{
-		CurveType:   key.CurveType.String(),
-		Address:     hex.EncodeUpperToString(key.Address[:]),
-		PublicKey:   hex.EncodeUpperToString(key.Pubkey()),
-		AddressHash: key.PublicKey.AddressHashType(),
-		PrivateKey:  cipherStruct,
+	return privateKeyJSON{
+		Crypto: CryptoAESGCM, Salt: salt, Nonce: nonce, CipherText: cipherText, Scrypt: &params,
+	}, nil
+}
+
+// Decrypts the secret in privateJSON, which may be stored in plain text
+func decryptPrivate(passphrase string, privateJSON privateKeyJSON) ([]byte, error) {
+	if len(privateJSON.CipherText) == 0 {
+		return hex.DecodeString(privateJSON.Plain)
 	}
-	keyJSON, err := json.Marshal(keyStruct)
-	if err != nil {
-		return err
+	params := legacyScryptParams
+	if privateJSON.Scrypt != nil {
+		params = *privateJSON.Scrypt
+		err := params.Validate()
+		if err != nil {
+			return nil, err
+		}
 	}
-	dataDirPath, err := returnDataDir(ks.keysDirPath)
+	derivedKey, err := scrypt.Key([]byte(passphrase), privateJSON.Salt, params.N, params.R, params.P, scryptdkLen)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return WriteKeyFile(key.Address[:], dataDirPath, keyJSON)
-}
-
-func (ks *KeyStore) DeleteKey(passphrase string, keyAddr []byte) (err error) {
-	dataDirPath, err := returnDataDir(ks.keysDirPath)
+	aesBlock, err := aes.NewCipher(derivedKey)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	keyDirPath := path.Join(dataDirPath, strings.ToUpper(hex.EncodeToString(keyAddr))+".json")
-	return os.Remove(keyDirPath)
-}
-
-func (ks *KeyStore) GetKeyFile(dataDirPath string, keyAddr []byte) (fileContent []byte, err error) {
-	filename := path.Join(dataDirPath, strings.ToUpper(hex.EncodeToString(keyAddr))+".json")
-	fileInfo, err := os.Stat(filename)
+	gcm, err := cipher.NewGCM(aesBlock)
 	if err != nil {
 		return nil, err
 	}
-	if (uint32(fileInfo.Mode()) & 0077) != 0 {
-		ks.logger.InfoMsg("file should be accessible by user only", "file", filename)
-		if !ks.AllowBadFilePermissions {
-			return nil, fmt.Errorf("file %s should be accessible by user only", filename)
-		}
-	}
-	return ioutil.ReadFile(filename)
+	return gcm.Open(nil, privateJSON.Nonce, privateJSON.CipherText, nil)
 }
 
 func WriteKeyFile(addr []byte, dataDirPath string, content []byte) (err error) {
-	addrHex := strings.ToUpper(hex.EncodeToString(addr))
-	keyFilePath := path.Join(dataDirPath, addrHex+".json")
 	err = os.MkdirAll(dataDirPath, 0700) // read, write and dir search for user
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(keyFilePath, content, 0600) // read, write for user
+	return ioutil.WriteFile(keyFilePath(dataDirPath, addr), content, 0600) // read, write for user
 }
 
 func (ks *KeyStore) GetAllNames() (map[string]string, error) {
@@ -374,6 +448,8 @@ type Key struct {
 	Address    crypto.Address
 	PublicKey  crypto.PublicKey
 	PrivateKey crypto.PrivateKey
+	// Set if the key was derived from a stored seed
+	DerivationPath string
 }
 
 func NewKey(typ crypto.CurveType) (*Key, error) {
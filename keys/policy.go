@@ -0,0 +1,226 @@
+package keys
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/tmthrgd/go-hex"
+	"golang.org/x/crypto/ripemd160"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// The payload type of messages that are not the sign bytes of a transaction
+	PayloadTypeRaw = "Raw"
+	// A SigningPolicy with this Address applies to keys without their own policy
+	PolicyAnyKey = "*"
+	// The identity of callers in the same process as the KeyStore
+	CallerLocal = "local"
+)
+
+// The canonical sign bytes of a transaction are its JSON serialisation (see txs.Tx.SignBytes), which we decode here
+// only as far as we need to describe it since txs depends on this package
+type txSignBytes struct {
+	ChainID string
+	Type    string
+	Payload json.RawMessage
+}
+
+// Payloads have either a single Input or a list of Inputs
+type txInputsJSON struct {
+	Input  *txInputJSON
+	Inputs []*txInputJSON
+}
+
+type txInputJSON struct {
+	Address string
+}
+
+// What we know of a message presented for signing
+type messageSummary struct {
+	PayloadType string
+	ChainID     string
+	TxHash      string
+	Inputs      []string
+}
+
+func summariseMessage(message []byte) messageSummary {
+	raw := messageSummary{PayloadType: PayloadTypeRaw}
+	tx := new(txSignBytes)
+	if json.Unmarshal(message, tx) != nil || tx.ChainID == "" || tx.Type == "" || len(tx.Payload) == 0 {
+		return raw
+	}
+	inputs := new(txInputsJSON)
+	if json.Unmarshal(tx.Payload, inputs) != nil {
+		return raw
+	}
+	if inputs.Input != nil {
+		inputs.Inputs = append(inputs.Inputs, inputs.Input)
+	}
+	// Transaction hashes are the RIPEMD160 of the sign bytes (see txs.Tx.Rehash)
+	hasher := ripemd160.New()
+	hasher.Write(message)
+	summary := messageSummary{
+		PayloadType: tx.Type,
+		ChainID:     tx.ChainID,
+		TxHash:      hex.EncodeUpperToString(hasher.Sum(nil)),
+	}
+	for _, input := range inputs.Inputs {
+		if input != nil {
+			summary.Inputs = append(summary.Inputs, input.Address)
+		}
+	}
+	return summary
+}
+
+// Enforces SigningPolicies
+type policyEnforcer struct {
+	sync.Mutex
+	keysDirPath string
+	policies    []*SigningPolicy
+	// Times of signatures within the last minute by key
+	recent map[crypto.Address][]time.Time
+}
+
+func newPolicyEnforcer(keysDirPath string, policies []*SigningPolicy) *policyEnforcer {
+	return &policyEnforcer{
+		keysDirPath: keysDirPath,
+		policies:    policies,
+		recent:      make(map[crypto.Address][]time.Time),
+	}
+}
+
+// Returns an error if the policy for the key at address does not permit caller to sign a message described by summary
+func (pe *policyEnforcer) authorise(caller string, address crypto.Address, summary messageSummary,
+	now time.Time) error {
+	policy := pe.policyFor(address)
+	if policy == nil {
+		return nil
+	}
+	if len(policy.AllowedCallers) > 0 && !contains(policy.AllowedCallers, caller) {
+		return fmt.Errorf("caller '%s' may not sign with key %v", caller, address)
+	}
+	if len(policy.AllowedPayloadTypes) > 0 && !contains(policy.AllowedPayloadTypes, summary.PayloadType) {
+		return fmt.Errorf("key %v may not sign %s messages", address, summary.PayloadType)
+	}
+	if policy.MaxSignaturesPerMinute > 0 {
+		pe.Lock()
+		defer pe.Unlock()
+		var recent []time.Time
+		for _, t := range pe.recent[address] {
+			if now.Sub(t) < time.Minute {
+				recent = append(recent, t)
+			}
+		}
+		if len(recent) >= policy.MaxSignaturesPerMinute {
+			pe.recent[address] = recent
+			return fmt.Errorf("key %v has reached its limit of %d signatures per minute", address,
+				policy.MaxSignaturesPerMinute)
+		}
+		pe.recent[address] = append(recent, now)
+	}
+	return nil
+}
+
+func (pe *policyEnforcer) policyFor(address crypto.Address) *SigningPolicy {
+	var anyKey *SigningPolicy
+	for _, policy := range pe.policies {
+		switch {
+		case policy.Address == PolicyAnyKey:
+			anyKey = policy
+		case policy.Address != "":
+			if policyAddress, err := crypto.AddressFromHexString(policy.Address); err == nil && policyAddress == address {
+				return policy
+			}
+		case policy.KeyName != "":
+			// Resolve names as we go since they may be reassigned
+			if addr, err := coreNameGet(pe.keysDirPath, policy.KeyName); err == nil {
+				if policyAddress, err := crypto.AddressFromHexString(addr); err == nil && policyAddress == address {
+					return policy
+				}
+			}
+		}
+	}
+	return anyKey
+}
+
+// Identifies the caller of a Keys RPC by the common name of its verified TLS client certificate, or failing that its
+// IP address
+func callerIdentity(ctx context.Context) string {
+	if ctx == nil {
+		return CallerLocal
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return CallerLocal
+	}
+	// Only trust certificates that were verified against our client CAs during the handshake
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+		if cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName; cn != "" {
+			return cn
+		}
+	}
+	client := p.Addr.String()
+	if host, _, err := net.SplitHostPort(client); err == nil {
+		return host
+	}
+	return client
+}
+
+func contains(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// Signs message subject to any SigningPolicy for the key at address, recording the request in the audit log if
+// there is one
+func (ks *KeyStore) authorisedSign(caller, passphrase string, address crypto.Address,
+	message []byte) (crypto.Signature, error) {
+	if ks.policies == nil && ks.audit == nil {
+		return ks.signWith(passphrase, address, message)
+	}
+	now := time.Now()
+	summary := summariseMessage(message)
+	var sig crypto.Signature
+	var err error
+	if ks.policies != nil {
+		err = ks.policies.authorise(caller, address, summary, now)
+	}
+	if err == nil {
+		sig, err = ks.signWith(passphrase, address, message)
+	}
+	if ks.audit != nil {
+		messageHash := sha256.Sum256(message)
+		record := &AuditRecord{
+			Time:        now.UTC().Format(time.RFC3339Nano),
+			Caller:      caller,
+			Address:     address.String(),
+			MessageHash: hex.EncodeUpperToString(messageHash[:]),
+			PayloadType: summary.PayloadType,
+			ChainID:     summary.ChainID,
+			TxHash:      summary.TxHash,
+			Inputs:      summary.Inputs,
+			Signed:      err == nil,
+		}
+		if err != nil {
+			record.Reason = err.Error()
+		}
+		auditErr := ks.audit.append(record)
+		if auditErr != nil {
+			// We must not release a signature we have not recorded
+			return crypto.Signature{}, fmt.Errorf("could not record sign request in audit log: %v", auditErr)
+		}
+	}
+	return sig, err
+}
@@ -0,0 +1,159 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummariseMessage(t *testing.T) {
+	summary := summariseMessage(txSignBytesFor("SendTx", "Inputs", "ABCD", "EF01"))
+	assert.Equal(t, "SendTx", summary.PayloadType)
+	assert.Equal(t, "policy-chain", summary.ChainID)
+	assert.Equal(t, []string{"ABCD", "EF01"}, summary.Inputs)
+	assert.Len(t, summary.TxHash, 40)
+
+	summary = summariseMessage(txSignBytesFor("CallTx", "Input", "ABCD"))
+	assert.Equal(t, "CallTx", summary.PayloadType)
+	assert.Equal(t, []string{"ABCD"}, summary.Inputs)
+
+	assert.Equal(t, PayloadTypeRaw, summariseMessage([]byte("not a transaction")).PayloadType)
+	assert.Equal(t, PayloadTypeRaw, summariseMessage([]byte(`{"@chain_id":"vote","@type":"vote"}`)).PayloadType)
+}
+
+func TestSigningPolicies(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	conf := DefaultKeysConfig()
+	conf.KeysDirectory = dir
+	conf.AuditLog = "audit.log"
+	ks, err := NewKeyStoreFromConfig(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	hot, err := ks.Gen("", crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+	named, err := ks.GenerateKey(context.Background(), &GenRequest{CurveType: "ed25519", KeyName: "named"})
+	require.NoError(t, err)
+	cold, err := ks.Gen("", crypto.CurveTypeEd25519)
+	require.NoError(t, err)
+
+	ks.policies = newPolicyEnforcer(dir, []*SigningPolicy{
+		{
+			Address:                hot.Address.String(),
+			AllowedCallers:         []string{"trader"},
+			AllowedPayloadTypes:    []string{"CallTx"},
+			MaxSignaturesPerMinute: 2,
+		},
+		{
+			KeyName:             "named",
+			AllowedPayloadTypes: []string{PayloadTypeRaw},
+		},
+		{
+			Address:        PolicyAnyKey,
+			AllowedCallers: []string{CallerLocal},
+		},
+	})
+
+	callTx := txSignBytesFor("CallTx", "Input", hot.Address.String())
+	_, err = ks.authorisedSign("trader", "", hot.Address, callTx)
+	require.NoError(t, err)
+	_, err = ks.authorisedSign("intruder", "", hot.Address, callTx)
+	assert.Error(t, err, "caller not allowed")
+	_, err = ks.authorisedSign("trader", "", hot.Address, txSignBytesFor("SendTx", "Inputs", hot.Address.String()))
+	assert.Error(t, err, "payload type not allowed")
+	_, err = ks.authorisedSign("trader", "", hot.Address, callTx)
+	require.NoError(t, err)
+	_, err = ks.authorisedSign("trader", "", hot.Address, callTx)
+	assert.Error(t, err, "rate limit exceeded")
+
+	namedAddress, err := crypto.AddressFromHexString(named.Address)
+	require.NoError(t, err)
+	_, err = ks.authorisedSign("anyone", "", namedAddress, []byte("a vote"))
+	require.NoError(t, err)
+	_, err = ks.authorisedSign("anyone", "", namedAddress, callTx)
+	assert.Error(t, err)
+
+	// Falls back to the policy for any key
+	_, err = ks.authorisedSign(CallerLocal, "", cold.Address, callTx)
+	require.NoError(t, err)
+	_, err = ks.authorisedSign("trader", "", cold.Address, callTx)
+	assert.Error(t, err)
+
+	resp, err := ks.AuditLog(context.Background(), &AuditLogRequest{Address: hot.Address.String()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), resp.Length)
+	require.Len(t, resp.Records, 5)
+	signed := resp.Records[0]
+	assert.True(t, signed.Signed)
+	assert.Equal(t, "trader", signed.Caller)
+	assert.Equal(t, "CallTx", signed.PayloadType)
+	assert.Equal(t, summariseMessage(callTx).TxHash, signed.TxHash)
+	assert.Equal(t, []string{hot.Address.String()}, signed.Inputs)
+	refused := resp.Records[1]
+	assert.False(t, refused.Signed)
+	assert.Equal(t, "intruder", refused.Caller)
+	assert.Contains(t, refused.Reason, "may not sign")
+
+	resp, err = ks.AuditLog(context.Background(), &AuditLogRequest{FromIndex: 8, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	assert.Equal(t, uint64(8), resp.Records[0].Index)
+}
+
+func TestAuditLogTamperEvidence(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	auditPath := path.Join(dir, "audit.log")
+	al, err := openAuditLog(auditPath)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, al.append(&AuditRecord{Address: fmt.Sprintf("%040d", i), Signed: true}))
+	}
+	require.NoError(t, al.Close())
+
+	// Reopening continues the chain
+	al, err = openAuditLog(auditPath)
+	require.NoError(t, err)
+	require.NoError(t, al.append(&AuditRecord{Signed: true}))
+	require.NoError(t, al.Close())
+	length, headHash, err := VerifyAuditLog(auditPath)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), length)
+	assert.Equal(t, al.headHash, headHash)
+
+	bs, err := ioutil.ReadFile(auditPath)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(bs), "\n")
+
+	// Altering a record
+	tampered := strings.Replace(string(bs), `"Signed":true`, `"Signed":false`, 1)
+	require.NoError(t, ioutil.WriteFile(auditPath, []byte(tampered), 0600))
+	_, _, err = VerifyAuditLog(auditPath)
+	assert.Error(t, err)
+	_, err = openAuditLog(auditPath)
+	assert.Error(t, err)
+
+	// Removing a record
+	require.NoError(t, ioutil.WriteFile(auditPath, []byte(lines[0]+lines[2]+lines[3]), 0600))
+	_, _, err = VerifyAuditLog(auditPath)
+	assert.Error(t, err)
+}
+
+func txSignBytesFor(payloadType, inputsField string, addresses ...string) []byte {
+	inputs := make([]string, len(addresses))
+	for i, address := range addresses {
+		inputs[i] = fmt.Sprintf(`{"Address":"%s","Amount":1,"Sequence":1}`, address)
+	}
+	payload := fmt.Sprintf(`{"%s":[%s]}`, inputsField, strings.Join(inputs, ","))
+	if inputsField == "Input" {
+		payload = fmt.Sprintf(`{"Input":%s,"Data":"00"}`, inputs[0])
+	}
+	return []byte(fmt.Sprintf(`{"ChainID":"policy-chain","Type":"%s","Payload":%s}`, payloadType, payload))
+}
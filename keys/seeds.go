@@ -0,0 +1,124 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys/hd"
+	"github.com/tmthrgd/go-hex"
+)
+
+// Name of the seed used when none is given
+const DefaultSeedName = "default"
+
+// A BIP39 seed from which keys can be derived
+type seedJSON struct {
+	Name string
+	Seed privateKeyJSON
+}
+
+// StoreSeed stores the BIP39 seed derived from mnemonic and mnemonicPassphrase under name, encrypted with
+// passphrase. An existing seed is never overwritten.
+func (ks *KeyStore) StoreSeed(passphrase, name, mnemonic, mnemonicPassphrase string) error {
+	seed, err := hd.Seed(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return err
+	}
+	return ks.storeSeed(passphrase, name, seed)
+}
+
+func (ks *KeyStore) storeSeed(passphrase, name string, seed []byte) error {
+	ks.Lock()
+	defer ks.Unlock()
+	filename, err := ks.seedFile(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filename); err == nil {
+		return fmt.Errorf("seed '%s' already exists", name)
+	}
+	var secret privateKeyJSON
+	if passphrase != "" {
+		secret, err = encryptPrivate(passphrase, seed, ks.scrypt)
+		if err != nil {
+			return err
+		}
+	} else {
+		secret = privateKeyJSON{Crypto: CryptoNone, Plain: hex.EncodeUpperToString(seed)}
+	}
+	bs, err := json.Marshal(seedJSON{Name: name, Seed: secret})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, bs, 0600)
+}
+
+// GetSeed returns the seed stored under name
+func (ks *KeyStore) GetSeed(passphrase, name string) ([]byte, error) {
+	ks.Lock()
+	defer ks.Unlock()
+	filename, err := ks.seedFile(name)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read seed '%s': %v", name, err)
+	}
+	seed := new(seedJSON)
+	err = json.Unmarshal(bs, seed)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := decryptPrivate(passphrase, seed.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt seed '%s': %v", name, err)
+	}
+	return secret, nil
+}
+
+// Derive derives the key at path from the seed stored under seedName and stores it encrypted with passphrase
+// recording its derivation path
+func (ks *KeyStore) Derive(passphrase, seedName string, path hd.Path, curveType crypto.CurveType) (*Key, error) {
+	seed, err := ks.GetSeed(passphrase, seedName)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := hd.DerivePrivateKey(seed, path, curveType)
+	if err != nil {
+		return nil, err
+	}
+	key, err := NewKeyFromPriv(curveType, privateKey.RawBytes())
+	if err != nil {
+		return nil, err
+	}
+	key.DerivationPath = path.String()
+	err = ks.StoreKey(passphrase, key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (ks *KeyStore) seedFile(name string) (string, error) {
+	if name == "" {
+		name = DefaultSeedName
+	}
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid seed name '%s'", name)
+	}
+	dir, err := filepath.Abs(path.Join(ks.keysDirPath, "seeds"))
+	if err != nil {
+		return "", err
+	}
+	err = checkMakeDataDir(dir)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(dir, name+".json"), nil
+}
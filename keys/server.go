@@ -12,8 +12,10 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/keys/hd"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ripemd160"
@@ -24,13 +26,19 @@ import (
 // all cli commands pass through the http KeyStore
 // the KeyStore process also maintains the unlocked accounts
 
-func StartStandAloneServer(keysDir, host, port string, AllowBadFilePermissions bool, logger *logging.Logger) (err error) {
+func StartStandAloneServer(keysDir, host, port string, AllowBadFilePermissions bool, logger *logging.Logger,
+	opts ...grpc.ServerOption) (err error) {
+	return StartKeyStoreServer(NewKeyStore(keysDir, AllowBadFilePermissions, logger), host, port, opts...)
+}
+
+// StartKeyStoreServer serves the Keys service for ks until interrupted
+func StartKeyStoreServer(ks *KeyStore, host, port string, opts ...grpc.ServerOption) (err error) {
 	listen, err := net.Listen("tcp", host+":"+port)
 	if err != nil {
 		return err
 	}
-	grpcServer := grpc.NewServer()
-	RegisterKeysServer(grpcServer, NewKeyStore(keysDir, AllowBadFilePermissions, logger))
+	grpcServer := grpc.NewServer(opts...)
+	RegisterKeysServer(grpcServer, ks)
 
 	go func() {
 		err = grpcServer.Serve(listen)
@@ -84,6 +92,19 @@ func (k *KeyStore) Export(ctx context.Context, in *ExportRequest) (*ExportRespon
 		return nil, err
 	}
 
+	if in.GetFormat() != "" {
+		keyJSON, err := EncodeKey(key, in.GetPassphrase(), in.GetFormat(), k.scrypt)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportResponse{
+			Address:   addrB[:],
+			CurveType: key.CurveType.String(),
+			Publickey: key.PublicKey.PublicKey[:],
+			JSON:      string(keyJSON),
+		}, nil
+	}
+
 	return &ExportResponse{
 		Address:    addrB[:],
 		CurveType:  key.CurveType.String(),
@@ -103,13 +124,12 @@ func (k *KeyStore) PublicKey(ctx context.Context, in *PubRequest) (*PubResponse,
 		return nil, err
 	}
 
-	// No phrase needed for public key. I hope.
-	key, err := k.GetKey("", addrB.Bytes())
-	if key == nil {
+	publicKey, err := k.backend.PublicKey(addrB)
+	if err != nil {
 		return nil, err
 	}
 
-	return &PubResponse{CurveType: key.CurveType.String(), PublicKey: key.Pubkey()}, nil
+	return &PubResponse{CurveType: publicKey.CurveType.String(), PublicKey: publicKey.PublicKey}, nil
 }
 
 func (k *KeyStore) Sign(ctx context.Context, in *SignRequest) (*SignResponse, error) {
@@ -123,14 +143,12 @@ func (k *KeyStore) Sign(ctx context.Context, in *SignRequest) (*SignResponse, er
 		return nil, err
 	}
 
-	key, err := k.GetKey(in.GetPassphrase(), addrB[:])
+	sig, err := k.authorisedSign(callerIdentity(ctx), in.GetPassphrase(), addrB, in.GetMessage())
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := key.Sign(in.GetMessage())
-
-	return &SignResponse{Signature: sig, CurveType: key.CurveType.String()}, nil
+	return &SignResponse{Signature: sig.RawBytes(), CurveType: sig.CurveType.String()}, nil
 }
 
 func (k *KeyStore) Verify(ctx context.Context, in *VerifyRequest) (*VerifyResponse, error) {
@@ -183,9 +201,37 @@ func (k *KeyStore) Hash(ctx context.Context, in *HashRequest) (*HashResponse, er
 
 func (k *KeyStore) ImportJSON(ctx context.Context, in *ImportJSONRequest) (*ImportResponse, error) {
 	keyJSON := []byte(in.GetJSON())
+	format, err := DetectKeyFormat(keyJSON)
+	if err != nil {
+		return nil, err
+	}
+	if format != KeyFormatBurrow {
+		key, err := DecodeKey(in.GetPassphrase(), keyJSON)
+		if err != nil {
+			return nil, err
+		}
+		err = k.StoreKey(in.GetPassphrase(), key)
+		if err != nil {
+			return nil, err
+		}
+		return &ImportResponse{Address: key.Address.String()}, nil
+	}
 	addr := IsValidKeyJson(keyJSON)
 	if addr != nil {
-		_, err := writeKey(k.keysDirPath, addr, keyJSON)
+		address, err := crypto.AddressFromBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+		if db, ok := k.backend.(*directoryBackend); ok {
+			err = db.ImportJSON(address, keyJSON)
+		} else {
+			// Other backends store keys their own way
+			var key *Key
+			key, err = unmarshalKey(in.GetPassphrase(), keyJSON)
+			if err == nil {
+				err = k.StoreKey(in.GetPassphrase(), key)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -288,3 +334,185 @@ func (k *KeyStore) AddName(ctx context.Context, in *AddNameRequest) (*AddNameRes
 
 	return &AddNameResponse{}, coreNameAdd(k.keysDirPath, in.GetKeyname(), strings.ToUpper(in.GetAddress()))
 }
+
+func (k *KeyStore) GenerateFromSeed(ctx context.Context, in *GenerateFromSeedRequest) (*GenerateFromSeedResponse, error) {
+	mnemonic := in.GetMnemonic()
+	generated := mnemonic == ""
+	if generated {
+		entropyBits := int(in.GetEntropyBits())
+		if entropyBits == 0 {
+			entropyBits = hd.DefaultEntropyBits
+		}
+		var err error
+		mnemonic, err = hd.NewMnemonic(entropyBits)
+		if err != nil {
+			return nil, err
+		}
+	}
+	seedName := in.GetSeedName()
+	if seedName == "" {
+		seedName = DefaultSeedName
+	}
+	err := k.StoreSeed(in.GetPassphrase(), seedName, mnemonic, in.GetMnemonicPassphrase())
+	if err != nil {
+		return nil, err
+	}
+	resp := &GenerateFromSeedResponse{SeedName: seedName}
+	if generated {
+		resp.Mnemonic = mnemonic
+	}
+	return resp, nil
+}
+
+func (k *KeyStore) DeriveKey(ctx context.Context, in *DeriveKeyRequest) (*DeriveKeyResponse, error) {
+	curveT, err := crypto.CurveTypeFromString(in.GetCurveType())
+	if err != nil {
+		return nil, err
+	}
+	path := hd.DefaultPath(curveT)
+	if in.GetPath() != "" {
+		path, err = hd.ParsePath(in.GetPath())
+		if err != nil {
+			return nil, err
+		}
+	}
+	key, err := k.Derive(in.GetPassphrase(), in.GetSeedName(), path, curveT)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving key at %v: %v", path, err)
+	}
+	addrH := key.Address.String()
+	if in.GetKeyName() != "" {
+		err = coreNameAdd(k.keysDirPath, in.GetKeyName(), addrH)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &DeriveKeyResponse{Address: addrH, Path: key.DerivationPath}, nil
+}
+
+func (k *KeyStore) UnlockKey(ctx context.Context, in *UnlockRequest) (*UnlockResponse, error) {
+	address, err := k.requestAddress(in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := k.UnlockKeyFor(in.GetPassphrase(), address, time.Duration(in.GetTimeout())*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &UnlockResponse{Address: address.String(), Timeout: uint64(timeout / time.Second)}, nil
+}
+
+func (k *KeyStore) LockKey(ctx context.Context, in *LockRequest) (*LockResponse, error) {
+	if in.GetName() == "" && in.GetAddress() == "" {
+		k.LockAllKeys()
+		return &LockResponse{}, nil
+	}
+	address, err := k.requestAddress(in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	k.LockKeyAt(address)
+	return &LockResponse{}, nil
+}
+
+func (k *KeyStore) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
+	address, err := k.requestAddress(in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &ChangePassphraseResponse{}, k.ChangeKeyPassphrase(in.GetPassphrase(), in.GetNewPassphrase(), address)
+}
+
+func (k *KeyStore) ReEncrypt(ctx context.Context, in *ReEncryptRequest) (*ReEncryptResponse, error) {
+	address, err := k.requestAddress(in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &ReEncryptResponse{}, k.ReEncryptKey(in.GetPassphrase(), address)
+}
+
+// Resolves the key identified by name or address in a request
+func (k *KeyStore) requestAddress(name, address string) (crypto.Address, error) {
+	addr, err := getNameAddr(k.keysDirPath, name, address)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	return crypto.AddressFromHexString(addr)
+}
+
+func (k *KeyStore) AuditLog(ctx context.Context, in *AuditLogRequest) (*AuditLogResponse, error) {
+	if k.audit == nil {
+		return nil, fmt.Errorf("keys server is not keeping an audit log")
+	}
+	var address string
+	if in.GetName() != "" || in.GetAddress() != "" {
+		addr, err := k.requestAddress(in.GetName(), in.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		address = addr.String()
+	}
+	resp := new(AuditLogResponse)
+	// Hold the log still while we read it, verifying the whole chain
+	k.audit.Lock()
+	defer k.audit.Unlock()
+	err := k.audit.scan(func(record *AuditRecord) error {
+		if record.Index < in.GetFromIndex() || address != "" && record.Address != address {
+			return nil
+		}
+		if in.GetLimit() == 0 || uint64(len(resp.Records)) < in.GetLimit() {
+			resp.Records = append(resp.Records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("audit log failed verification: %v", err)
+	}
+	resp.Length, resp.HeadHash = k.audit.length, k.audit.headHash
+	return resp, nil
+}
+
+func (k *KeyStore) Backup(ctx context.Context, in *BackupRequest) (*BackupResponse, error) {
+	var shares []*KeyShare
+	var err error
+	if in.GetSeedName() != "" {
+		shares, err = k.BackupSeed(in.GetPassphrase(), in.GetSeedName(), int(in.GetShares()), int(in.GetThreshold()))
+	} else {
+		var address crypto.Address
+		address, err = k.requestAddress(in.GetName(), in.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		shares, err = k.BackupKey(in.GetPassphrase(), address, int(in.GetShares()), int(in.GetThreshold()))
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &BackupResponse{Shares: make([]string, len(shares))}
+	for i, share := range shares {
+		resp.Shares[i], err = share.Encode()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (k *KeyStore) Recover(ctx context.Context, in *RecoverRequest) (*RecoverResponse, error) {
+	shares := make([]*KeyShare, len(in.GetShares()))
+	for i, encoded := range in.GetShares() {
+		share, err := DecodeKeyShare(encoded)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+	recovered, err := k.RecoverFromShares(in.GetPassphrase(), shares)
+	if err != nil {
+		return nil, err
+	}
+	if recovered.Kind == ShareOfSeed {
+		return &RecoverResponse{SeedName: recovered.Name}, nil
+	}
+	return &RecoverResponse{Address: recovered.Address, Name: recovered.Name}, nil
+}
@@ -2,8 +2,12 @@ package keys
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,8 +30,10 @@ var hashData = map[string]hashInfo{
 }
 
 var (
-	KEY_TYPES  = []string{"ed25519", "secp256k1"}
+	KEY_TYPES  = []string{"ed25519", "secp256k1", "bls12-381"}
 	HASH_TYPES = []string{"sha256", "ripemd160"}
+	// Curves supported by hierarchical deterministic derivation
+	HD_KEY_TYPES = []string{"ed25519", "secp256k1"}
 )
 
 // start the server
@@ -149,6 +155,124 @@ func TestServerHash(t *testing.T) {
 	}
 }
 
+func TestServerGenerateFromSeedAndDerive(t *testing.T) {
+	c := grpcKeysClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	genresp, err := c.GenerateFromSeed(ctx, &GenerateFromSeedRequest{SeedName: "generated", EntropyBits: 128})
+	require.NoError(t, err)
+	assert.Len(t, strings.Fields(genresp.Mnemonic), 12)
+
+	_, err = c.GenerateFromSeed(ctx, &GenerateFromSeedRequest{SeedName: "generated"})
+	assert.Error(t, err, "should not overwrite existing seed")
+
+	// Importing the mnemonic should derive the same keys
+	importresp, err := c.GenerateFromSeed(ctx, &GenerateFromSeedRequest{SeedName: "imported",
+		Mnemonic: genresp.Mnemonic})
+	require.NoError(t, err)
+	assert.Empty(t, importresp.Mnemonic)
+
+	for _, typ := range HD_KEY_TYPES {
+		derived, err := c.DeriveKey(ctx, &DeriveKeyRequest{SeedName: "generated", CurveType: typ})
+		require.NoError(t, err)
+		reDerived, err := c.DeriveKey(ctx, &DeriveKeyRequest{SeedName: "imported", CurveType: typ,
+			Path: derived.Path})
+		require.NoError(t, err)
+		assert.Equal(t, derived.Address, reDerived.Address)
+
+		other, err := c.DeriveKey(ctx, &DeriveKeyRequest{SeedName: "generated", CurveType: typ,
+			Path: "m/44'/60'/1'/0'/0'"})
+		require.NoError(t, err)
+		assert.NotEqual(t, derived.Address, other.Address)
+
+		// Derived keys are usable like any other
+		hash := sha3.Sha3([]byte("the hash of something!"))
+		_, err = c.Sign(ctx, &SignRequest{Address: derived.Address, Message: hash})
+		require.NoError(t, err)
+	}
+}
+
+func TestServerUnlockAndChangePassphrase(t *testing.T) {
+	c := grpcKeysClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	genresp, err := c.GenerateKey(ctx, &GenRequest{CurveType: "ed25519", Passphrase: "old"})
+	require.NoError(t, err)
+	address := genresp.Address
+	hash := sha3.Sha3([]byte("the hash of something!"))
+
+	_, err = c.Sign(ctx, &SignRequest{Address: address, Message: hash})
+	assert.Error(t, err, "locked key needs passphrase")
+
+	_, err = c.UnlockKey(ctx, &UnlockRequest{Address: address, Passphrase: "wrong"})
+	assert.Error(t, err)
+	unlockresp, err := c.UnlockKey(ctx, &UnlockRequest{Address: address, Passphrase: "old"})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(DefaultUnlockTimeout/time.Second), unlockresp.Timeout)
+	_, err = c.Sign(ctx, &SignRequest{Address: address, Message: hash})
+	require.NoError(t, err, "unlocked key should sign without passphrase")
+
+	_, err = c.LockKey(ctx, &LockRequest{Address: address})
+	require.NoError(t, err)
+	_, err = c.Sign(ctx, &SignRequest{Address: address, Message: hash})
+	assert.Error(t, err, "key should be locked again")
+
+	_, err = c.ChangePassphrase(ctx, &ChangePassphraseRequest{Address: address, Passphrase: "wrong",
+		NewPassphrase: "new"})
+	assert.Error(t, err)
+	_, err = c.ChangePassphrase(ctx, &ChangePassphraseRequest{Address: address, Passphrase: "old",
+		NewPassphrase: "new"})
+	require.NoError(t, err)
+	_, err = c.Sign(ctx, &SignRequest{Address: address, Passphrase: "old", Message: hash})
+	assert.Error(t, err)
+	_, err = c.Sign(ctx, &SignRequest{Address: address, Passphrase: "new", Message: hash})
+	require.NoError(t, err)
+}
+
+func TestKeyStoreReEncryptAndUnlockTimeout(t *testing.T) {
+	dir, cleanup := backendTestDir(t)
+	defer cleanup()
+	conf := DefaultKeysConfig()
+	conf.KeysDirectory = dir
+	conf.Scrypt = &ScryptParams{N: 1 << 10, R: 8, P: 1}
+	ks, err := NewKeyStoreFromConfig(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	key, err := ks.Gen("passphrase", crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	assert.Equal(t, 1<<10, storedScryptParams(t, dir, key.Address).N)
+
+	// Raise the cost and re-encrypt
+	conf.Scrypt = &ScryptParams{N: 1 << 12, R: 8, P: 1}
+	ks, err = NewKeyStoreFromConfig(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.Error(t, ks.ReEncryptKey("wrong", key.Address))
+	require.NoError(t, ks.ReEncryptKey("passphrase", key.Address))
+	assert.Equal(t, 1<<12, storedScryptParams(t, dir, key.Address).N)
+
+	msg := []byte("sign me")
+	_, err = ks.UnlockKeyFor("passphrase", key.Address, 50*time.Millisecond)
+	require.NoError(t, err)
+	sig, err := ks.signWith("", key.Address, msg)
+	require.NoError(t, err)
+	assert.NoError(t, key.PublicKey.Verify(msg, sig))
+	time.Sleep(100 * time.Millisecond)
+	_, err = ks.signWith("", key.Address, msg)
+	assert.Error(t, err, "unlock should have expired")
+
+	conf.Scrypt = &ScryptParams{N: 1000, R: 8, P: 1}
+	_, err = NewKeyStoreFromConfig(conf, logging.NewNoopLogger())
+	assert.Error(t, err, "N must be a power of two")
+}
+
+func storedScryptParams(t *testing.T, dir string, address crypto.Address) ScryptParams {
+	bs, err := ioutil.ReadFile(keyFilePath(path.Join(dir, "data"), address[:]))
+	require.NoError(t, err)
+	keyJ := new(keyJSON)
+	require.NoError(t, json.Unmarshal(bs, keyJ))
+	require.NotNil(t, keyJ.PrivateKey.Scrypt)
+	return *keyJ.PrivateKey.Scrypt
+}
+
 //---------------------------------------------------------------------------------
 
 func checkErrs(t *testing.T, errS string, err error) {
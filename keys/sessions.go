@@ -0,0 +1,122 @@
+package keys
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+const DefaultUnlockTimeout = 5 * time.Minute
+
+// Keys unlocked for a limited time so that they can sign without a passphrase
+type sessions struct {
+	sync.Mutex
+	unlocked map[crypto.Address]*session
+}
+
+type session struct {
+	key   *Key
+	timer *time.Timer
+}
+
+func newSessions() *sessions {
+	return &sessions{
+		unlocked: make(map[crypto.Address]*session),
+	}
+}
+
+func (ss *sessions) unlock(key *Key, timeout time.Duration) {
+	ss.Lock()
+	defer ss.Unlock()
+	ss.lock(key.Address)
+	address := key.Address
+	s := &session{key: key}
+	s.timer = time.AfterFunc(timeout, func() {
+		ss.Lock()
+		defer ss.Unlock()
+		// We may have been unlocked again since this timer was set
+		if ss.unlocked[address] == s {
+			ss.lock(address)
+		}
+	})
+	ss.unlocked[address] = s
+}
+
+// Must hold lock
+func (ss *sessions) lock(address crypto.Address) {
+	if s, ok := ss.unlocked[address]; ok {
+		s.timer.Stop()
+		delete(ss.unlocked, address)
+	}
+}
+
+func (ss *sessions) lockAll() {
+	ss.Lock()
+	defer ss.Unlock()
+	for address := range ss.unlocked {
+		ss.lock(address)
+	}
+}
+
+func (ss *sessions) get(address crypto.Address) *Key {
+	ss.Lock()
+	defer ss.Unlock()
+	if s, ok := ss.unlocked[address]; ok {
+		return s.key
+	}
+	return nil
+}
+
+// UnlockKeyFor decrypts the key at address and holds it in memory for timeout (or DefaultUnlockTimeout if timeout is
+// zero) during which it can sign without a passphrase
+func (ks *KeyStore) UnlockKeyFor(passphrase string, address crypto.Address,
+	timeout time.Duration) (time.Duration, error) {
+	key, err := ks.backend.Export(passphrase, address)
+	if err != nil {
+		return 0, fmt.Errorf("could not unlock key %v: %v", address, err)
+	}
+	if timeout == 0 {
+		timeout = DefaultUnlockTimeout
+	}
+	ks.sessions.unlock(key, timeout)
+	return timeout, nil
+}
+
+// LockKeyAt forgets the unlocked key at address, if there is one
+func (ks *KeyStore) LockKeyAt(address crypto.Address) {
+	ks.sessions.Lock()
+	defer ks.sessions.Unlock()
+	ks.sessions.lock(address)
+}
+
+// LockAllKeys forgets all unlocked keys
+func (ks *KeyStore) LockAllKeys() {
+	ks.sessions.lockAll()
+}
+
+// Signs with an unlocked key if there is one and no passphrase is given, otherwise asks the backend to sign
+func (ks *KeyStore) signWith(passphrase string, address crypto.Address, message []byte) (crypto.Signature, error) {
+	if passphrase == "" {
+		if key := ks.sessions.get(address); key != nil {
+			return key.PrivateKey.Sign(message)
+		}
+	}
+	return ks.backend.Sign(passphrase, address, message)
+}
+
+// ChangeKeyPassphrase re-encrypts the key at address under newPassphrase
+func (ks *KeyStore) ChangeKeyPassphrase(passphrase, newPassphrase string, address crypto.Address) error {
+	key, err := ks.backend.Export(passphrase, address)
+	if err != nil {
+		return fmt.Errorf("could not decrypt key %v: %v", address, err)
+	}
+	return ks.backend.Import(newPassphrase, key)
+}
+
+// ReEncryptKey re-encrypts the key at address under the same passphrase but with the current encryption parameters
+// of the backend, for example after scrypt parameters have been raised in KeysConfig
+func (ks *KeyStore) ReEncryptKey(passphrase string, address crypto.Address) error {
+	return ks.ChangeKeyPassphrase(passphrase, passphrase, address)
+}
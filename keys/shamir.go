@@ -0,0 +1,116 @@
+package keys
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// Shamir's secret sharing over GF(2^8) with the AES reducing polynomial x^8 + x^4 + x^3 + x + 1. Each byte of the
+// secret is the constant term of its own random polynomial of degree threshold - 1 and share i holds the evaluation of
+// every polynomial at x = i.
+
+var gfExp, gfLog = gfTables()
+
+func gfTables() (exp [510]byte, log [256]byte) {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		exp[i+255] = x
+		log[x] = byte(i)
+		// Multiply by the generator 3
+		x ^= gfDouble(x)
+	}
+	return
+}
+
+func gfDouble(a byte) byte {
+	if a&0x80 != 0 {
+		return (a << 1) ^ 0x1b
+	}
+	return a << 1
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// Splits secret into shares of which any threshold suffice to recover it. Share i (counting from zero) is to be
+// combined with x coordinate i + 1.
+func splitSecret(secret []byte, shares, threshold int) ([][]byte, error) {
+	if threshold < 2 {
+		return nil, fmt.Errorf("threshold must be at least 2 but is %d", threshold)
+	}
+	if shares < threshold {
+		return nil, fmt.Errorf("number of shares (= %d) must be at least the threshold (= %d)", shares, threshold)
+	}
+	if shares > 255 {
+		return nil, fmt.Errorf("at most 255 shares are supported but %d were requested", shares)
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("cannot split an empty secret")
+	}
+	ys := make([][]byte, shares)
+	for i := range ys {
+		ys[i] = make([]byte, len(secret))
+	}
+	coefficients := make([]byte, threshold)
+	for j, b := range secret {
+		coefficients[0] = b
+		_, err := rand.Read(coefficients[1:])
+		if err != nil {
+			return nil, err
+		}
+		for i := range ys {
+			// Horner's method
+			x := byte(i + 1)
+			var y byte
+			for k := threshold - 1; k >= 0; k-- {
+				y = gfMul(y, x) ^ coefficients[k]
+			}
+			ys[i][j] = y
+		}
+	}
+	return ys, nil
+}
+
+// Recovers the secret from shares keyed by their x coordinate by Lagrange interpolation at zero. With fewer shares
+// than the threshold with which they were split the result is meaningless.
+func combineShares(shares map[byte][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("at least 2 shares are required but %d were provided", len(shares))
+	}
+	length := -1
+	for x, y := range shares {
+		if x == 0 {
+			return nil, fmt.Errorf("share x coordinate may not be zero")
+		}
+		if length >= 0 && len(y) != length {
+			return nil, fmt.Errorf("shares have differing lengths")
+		}
+		length = len(y)
+	}
+	secret := make([]byte, length)
+	for xi, yi := range shares {
+		// Lagrange basis polynomial for xi evaluated at zero
+		basis := byte(1)
+		for xj := range shares {
+			if xj != xi {
+				basis = gfMul(basis, gfDiv(xj, xj^xi))
+			}
+		}
+		for k := range secret {
+			secret[k] ^= gfMul(basis, yi[k])
+		}
+	}
+	return secret, nil
+}
@@ -0,0 +1,204 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hyperledger/burrow/logging"
+)
+
+// Prefix given to the method names of the info (JSON-RPC) server so they can be distinguished from GRPC methods in
+// the ACL, for example 'info/account'
+const InfoMethodPrefix = "info/"
+
+// Matches every method in an ACL entry
+const AllMethods = "*"
+
+type AuthConfig struct {
+	Enabled bool
+	// Bearer tokens accepted in the 'authorization' header or GRPC metadata and the identities they authenticate
+	Tokens []*TokenConfig `json:",omitempty" toml:",omitempty"`
+	// Authenticate callers presenting a verified TLS client certificate as the certificate's subject common name
+	ClientCertificates bool
+	// Identity assumed by callers who present no credentials, if empty such callers are rejected
+	AnonymousIdentity string `json:",omitempty" toml:",omitempty"`
+	// Methods each identity may call
+	ACL []*ACLEntry `json:",omitempty" toml:",omitempty"`
+}
+
+type TokenConfig struct {
+	Identity string
+	// Hex-encoded SHA256 hash of the token so that the token itself need not be stored in config
+	TokenHash string
+}
+
+type ACLEntry struct {
+	Identity string
+	// Fully qualified GRPC methods (e.g. 'rpcquery.Query/GetAccount') or prefixed info methods
+	// (e.g. 'info/account') which may include path.Match patterns such as 'rpcquery.Query/*' or be '*' for all methods
+	Methods []string
+}
+
+func DefaultAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		Enabled: false,
+	}
+}
+
+// Credentials presented by a caller
+type Credentials struct {
+	BearerToken string
+	// Verified TLS client certificate chain, leaf first
+	PeerCertificates []*x509.Certificate
+}
+
+// Authenticator establishes the identity of a caller from their credentials
+type Authenticator interface {
+	Authenticate(creds *Credentials) (identity string, ok bool)
+}
+
+type AuthError struct {
+	// True if the caller could not be authenticated, false if authenticated but not permitted to call the method
+	Unauthenticated bool
+	Identity        string
+	Method          string
+}
+
+func (err *AuthError) Error() string {
+	if err.Unauthenticated {
+		return fmt.Sprintf("could not authenticate caller of %s", err.Method)
+	}
+	return fmt.Sprintf("identity '%s' is not permitted to call %s", err.Identity, err.Method)
+}
+
+// Auth authenticates callers and authorises them against an ACL
+type Auth struct {
+	authenticators    []Authenticator
+	anonymousIdentity string
+	acl               map[string][]string
+	logger            *logging.Logger
+}
+
+func NewAuth(conf *AuthConfig, logger *logging.Logger, authenticators ...Authenticator) (*Auth, error) {
+	auth := &Auth{
+		anonymousIdentity: conf.AnonymousIdentity,
+		acl:               make(map[string][]string),
+		logger:            logger.WithScope("Auth"),
+	}
+	if len(conf.Tokens) > 0 {
+		tokenAuthenticator, err := NewTokenAuthenticator(conf.Tokens...)
+		if err != nil {
+			return nil, err
+		}
+		auth.authenticators = append(auth.authenticators, tokenAuthenticator)
+	}
+	if conf.ClientCertificates {
+		auth.authenticators = append(auth.authenticators, CertificateAuthenticator{})
+	}
+	auth.authenticators = append(auth.authenticators, authenticators...)
+	for _, entry := range conf.ACL {
+		for _, method := range entry.Methods {
+			if _, err := path.Match(method, ""); err != nil {
+				return nil, fmt.Errorf("invalid method pattern '%s' in ACL for '%s': %v", method, entry.Identity, err)
+			}
+		}
+		auth.acl[entry.Identity] = append(auth.acl[entry.Identity], entry.Methods...)
+	}
+	return auth, nil
+}
+
+// Authorize returns the identity of the caller presenting creds if they are permitted to call method otherwise an
+// *AuthError. Denied calls are logged to provide an audit trail.
+func (auth *Auth) Authorize(creds *Credentials, method string) (string, error) {
+	method = strings.TrimPrefix(method, "/")
+	identity, ok := auth.authenticate(creds)
+	if !ok {
+		auth.logger.InfoMsg("Denied unauthenticated RPC call", "method", method)
+		return "", &AuthError{Unauthenticated: true, Method: method}
+	}
+	if !auth.permitted(identity, method) {
+		auth.logger.InfoMsg("Denied unauthorised RPC call", "identity", identity, "method", method)
+		return identity, &AuthError{Identity: identity, Method: method}
+	}
+	auth.logger.TraceMsg("Authorised RPC call", "identity", identity, "method", method)
+	return identity, nil
+}
+
+func (auth *Auth) authenticate(creds *Credentials) (string, bool) {
+	if creds != nil {
+		for _, authenticator := range auth.authenticators {
+			if identity, ok := authenticator.Authenticate(creds); ok {
+				return identity, true
+			}
+		}
+	}
+	if auth.anonymousIdentity != "" {
+		return auth.anonymousIdentity, true
+	}
+	return "", false
+}
+
+func (auth *Auth) permitted(identity, method string) bool {
+	for _, pattern := range auth.acl[identity] {
+		if pattern == AllMethods {
+			return true
+		}
+		if matched, _ := path.Match(pattern, method); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses the value of an 'authorization' header returning the bearer token if there is one
+func BearerToken(authorization string) string {
+	const prefix = "bearer "
+	if len(authorization) > len(prefix) && strings.ToLower(authorization[:len(prefix)]) == prefix {
+		return strings.TrimSpace(authorization[len(prefix):])
+	}
+	return ""
+}
+
+type TokenAuthenticator map[[sha256.Size]byte]string
+
+func NewTokenAuthenticator(tokens ...*TokenConfig) (TokenAuthenticator, error) {
+	ta := make(TokenAuthenticator, len(tokens))
+	for _, token := range tokens {
+		bs, err := hex.DecodeString(token.TokenHash)
+		if err != nil || len(bs) != sha256.Size {
+			return nil, fmt.Errorf("TokenHash for '%s' should be a hex-encoded SHA256 hash", token.Identity)
+		}
+		var hash [sha256.Size]byte
+		copy(hash[:], bs)
+		ta[hash] = token.Identity
+	}
+	return ta, nil
+}
+
+func (ta TokenAuthenticator) Authenticate(creds *Credentials) (string, bool) {
+	if creds.BearerToken == "" {
+		return "", false
+	}
+	hash := sha256.Sum256([]byte(creds.BearerToken))
+	for tokenHash, identity := range ta {
+		if subtle.ConstantTimeCompare(hash[:], tokenHash[:]) == 1 {
+			return identity, true
+		}
+	}
+	return "", false
+}
+
+// CertificateAuthenticator identifies callers by the subject common name of their verified client certificate
+type CertificateAuthenticator struct{}
+
+func (CertificateAuthenticator) Authenticate(creds *Credentials) (string, bool) {
+	if len(creds.PeerCertificates) == 0 || creds.PeerCertificates[0].Subject.CommonName == "" {
+		return "", false
+	}
+	return creds.PeerCertificates[0].Subject.CommonName, true
+}
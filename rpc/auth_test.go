@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuth_Authorize(t *testing.T) {
+	auth, err := NewAuth(&AuthConfig{
+		Enabled: true,
+		Tokens: []*TokenConfig{
+			{Identity: "admin", TokenHash: tokenHash("admin-token")},
+			{Identity: "reader", TokenHash: tokenHash("reader-token")},
+		},
+		ClientCertificates: true,
+		ACL: []*ACLEntry{
+			{Identity: "admin", Methods: []string{AllMethods}},
+			{Identity: "reader", Methods: []string{"rpcquery.Query/*", "info/account"}},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	identity, err := auth.Authorize(&Credentials{BearerToken: "admin-token"}, "/rpctransact.Transact/SignTx")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", identity)
+
+	_, err = auth.Authorize(&Credentials{BearerToken: "reader-token"}, "/rpcquery.Query/GetAccount")
+	require.NoError(t, err)
+	_, err = auth.Authorize(&Credentials{BearerToken: "reader-token"}, "info/account")
+	require.NoError(t, err)
+
+	_, err = auth.Authorize(&Credentials{BearerToken: "reader-token"}, "/rpctransact.Transact/SignTx")
+	require.Error(t, err)
+	assert.False(t, err.(*AuthError).Unauthenticated)
+
+	_, err = auth.Authorize(&Credentials{BearerToken: "wrong-token"}, "/rpcquery.Query/GetAccount")
+	require.Error(t, err)
+	assert.True(t, err.(*AuthError).Unauthenticated)
+
+	identity, err = auth.Authorize(&Credentials{PeerCertificates: []*x509.Certificate{
+		{Subject: pkix.Name{CommonName: "admin"}},
+	}}, "/rpctransact.Transact/SignTx")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", identity)
+}
+
+func TestAuth_AnonymousIdentity(t *testing.T) {
+	auth, err := NewAuth(&AuthConfig{
+		Enabled:           true,
+		AnonymousIdentity: "public",
+		ACL:               []*ACLEntry{{Identity: "public", Methods: []string{"info/status"}}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	_, err = auth.Authorize(nil, "info/status")
+	require.NoError(t, err)
+	_, err = auth.Authorize(&Credentials{}, "info/account")
+	require.Error(t, err)
+}
+
+func TestNewAuth(t *testing.T) {
+	_, err := NewAuth(&AuthConfig{Tokens: []*TokenConfig{{Identity: "foo", TokenHash: "not hex"}}},
+		logging.NewNoopLogger())
+	assert.Error(t, err)
+
+	_, err = NewAuth(&AuthConfig{ACL: []*ACLEntry{{Identity: "foo", Methods: []string{"[bad"}}}},
+		logging.NewNoopLogger())
+	assert.Error(t, err)
+}
+
+func TestUnaryInterceptorAuth(t *testing.T) {
+	auth, err := NewAuth(&AuthConfig{
+		Enabled: true,
+		Tokens:  []*TokenConfig{{Identity: "reader", TokenHash: tokenHash("reader-token")}},
+		ACL:     []*ACLEntry{{Identity: "reader", Methods: []string{"rpcquery.Query/*"}}},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	interceptor := unaryInterceptor(logging.NewNoopLogger(), auth, nil)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "called", nil
+	}
+	call := func(authorization, method string) (interface{}, error) {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	resp, err := call("Bearer reader-token", "/rpcquery.Query/GetAccount")
+	require.NoError(t, err)
+	assert.Equal(t, "called", resp)
+
+	_, err = call("Bearer reader-token", "/rpctransact.Transact/SignTx")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call("", "/rpcquery.Query/GetAccount")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestBearerToken(t *testing.T) {
+	assert.Equal(t, "abc", BearerToken("Bearer abc"))
+	assert.Equal(t, "abc", BearerToken("bearer  abc"))
+	assert.Equal(t, "", BearerToken("Basic abc"))
+	assert.Equal(t, "", BearerToken("Bearer "))
+}
+
+func tokenHash(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
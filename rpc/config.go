@@ -1,6 +1,10 @@
 package rpc
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
+)
 
 // 'localhost' gets interpreted as ipv6
 // TODO: revisit this
@@ -11,11 +15,16 @@ type RPCConfig struct {
 	Profiler *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
+	// Authentication and authorisation of calls to the Info and GRPC servers
+	Auth *AuthConfig `json:",omitempty" toml:",omitempty"`
+	// Rate limits and quotas applied to clients of the Info and GRPC servers
+	Limits *LimitsConfig `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
 	Enabled       bool
 	ListenAddress string
+	TLS           *tlsconfig.ServerConfig `json:",omitempty" toml:",omitempty"`
 }
 
 type ProfilerConfig struct {
@@ -33,6 +42,7 @@ type MetricsConfig struct {
 	ListenAddress   string
 	MetricsPath     string
 	BlockSampleSize uint64
+	TLS             *tlsconfig.ServerConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultRPCConfig() *RPCConfig {
@@ -41,6 +51,8 @@ func DefaultRPCConfig() *RPCConfig {
 		Profiler: DefaultProfilerConfig(),
 		GRPC:     DefaultGRPCConfig(),
 		Metrics:  DefaultMetricsConfig(),
+		Auth:     DefaultAuthConfig(),
+		Limits:   DefaultLimitsConfig(),
 	}
 }
 
@@ -48,6 +60,7 @@ func DefaultInfoConfig() *ServerConfig {
 	return &ServerConfig{
 		Enabled:       true,
 		ListenAddress: fmt.Sprintf("tcp://%s:26658", localhost),
+		TLS:           tlsconfig.DefaultServerConfig(),
 	}
 }
 
@@ -55,6 +68,7 @@ func DefaultGRPCConfig() *ServerConfig {
 	return &ServerConfig{
 		Enabled:       true,
 		ListenAddress: fmt.Sprintf("%s:10997", localhost),
+		TLS:           tlsconfig.DefaultServerConfig(),
 	}
 }
 
@@ -71,5 +85,6 @@ func DefaultMetricsConfig() *MetricsConfig {
 		ListenAddress:   fmt.Sprintf("tcp://%s:9102", localhost),
 		MetricsPath:     "/metrics",
 		BlockSampleSize: 100,
+		TLS:             tlsconfig.DefaultServerConfig(),
 	}
 }
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/permission"
+)
+
+// Matcher returns a predicate selecting the accounts described by the filter. A nil filter matches every account.
+func (filter *AccountFilter) Matcher() (func(acm.Account) bool, error) {
+	if filter == nil {
+		return func(acm.Account) bool { return true }, nil
+	}
+	var perms permission.PermFlag
+	if len(filter.Permissions) > 0 {
+		var err error
+		perms, err = permission.PermFlagFromStringList(filter.Permissions)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return func(acc acm.Account) bool {
+		if perms != 0 {
+			granted, err := acc.Permissions().Base.Get(perms)
+			if err != nil || !granted {
+				return false
+			}
+		}
+		for _, role := range filter.Roles {
+			if !acc.Permissions().HasRole(role) {
+				return false
+			}
+		}
+		hasCode := len(acc.Code()) > 0
+		if filter.HasCode && !hasCode || filter.NoCode && hasCode {
+			return false
+		}
+		if acc.Balance() < filter.MinBalance || filter.MaxBalance > 0 && acc.Balance() > filter.MaxBalance {
+			return false
+		}
+		return true
+	}, nil
+}
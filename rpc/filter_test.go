@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountFilter_Matcher(t *testing.T) {
+	user := acm.ConcreteAccount{
+		Balance:     100,
+		Permissions: permission.NewAccountPermissions(permission.Send | permission.Call),
+	}
+	user.Permissions.AddRole("admin")
+	contract := acm.ConcreteAccount{
+		Balance: 5,
+		Code:    acm.Bytecode{0x60, 0x00},
+	}
+
+	matches := func(filter *AccountFilter, acc acm.ConcreteAccount) bool {
+		matcher, err := filter.Matcher()
+		require.NoError(t, err)
+		return matcher(acc.Account())
+	}
+
+	var noFilter *AccountFilter
+	assert.True(t, matches(noFilter, user))
+	assert.True(t, matches(&AccountFilter{Permissions: []string{"send", "call"}}, user))
+	assert.False(t, matches(&AccountFilter{Permissions: []string{"send", "createContract"}}, user))
+	assert.False(t, matches(&AccountFilter{Permissions: []string{"send"}}, contract))
+	assert.True(t, matches(&AccountFilter{Roles: []string{"admin"}}, user))
+	assert.False(t, matches(&AccountFilter{Roles: []string{"admin", "root"}}, user))
+	assert.True(t, matches(&AccountFilter{HasCode: true}, contract))
+	assert.False(t, matches(&AccountFilter{HasCode: true}, user))
+	assert.True(t, matches(&AccountFilter{NoCode: true}, user))
+	assert.True(t, matches(&AccountFilter{MinBalance: 10, MaxBalance: 100}, user))
+	assert.False(t, matches(&AccountFilter{MinBalance: 10, MaxBalance: 100}, contract))
+	assert.False(t, matches(&AccountFilter{MaxBalance: 99}, user))
+
+	_, err := (&AccountFilter{Permissions: []string{"fly"}}).Matcher()
+	assert.Error(t, err)
+}
+
+func TestStorageRange(t *testing.T) {
+	start, end := StorageRange([]byte{0x11}, nil)
+	assert.Equal(t, []byte{0x11}, start)
+	assert.Equal(t, []byte{0x12}, end)
+
+	start, end = StorageRange([]byte{0x11}, []byte{0x11, 0x05})
+	assert.Equal(t, []byte{0x11, 0x05}, start)
+	assert.Equal(t, []byte{0x12}, end)
+
+	start, end = StorageRange(nil, []byte{0x05})
+	assert.Equal(t, []byte{0x05}, start)
+	assert.Nil(t, end)
+}
@@ -2,20 +2,28 @@ package rpc
 
 import (
 	"fmt"
+	"net"
 	"runtime/debug"
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
-func NewGRPCServer(logger *logging.Logger) *grpc.Server {
-	return grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor(logger)),
-		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"))))
+// NewGRPCServer returns a server whose calls are authenticated and authorised by auth, or left open if auth is nil,
+// and subject to the limits of limiter, or unlimited if limiter is nil
+func NewGRPCServer(logger *logging.Logger, auth *Auth, limiter *Limiter, opts ...grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(append(opts, grpc.UnaryInterceptor(unaryInterceptor(logger, auth, limiter)),
+		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"), auth, limiter)))...)
 }
 
-func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
+func unaryInterceptor(logger *logging.Logger, auth *Auth, limiter *Limiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
@@ -27,12 +35,29 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 				err = fmt.Errorf("panic in GRPC unary call %s: %v: %s", info.FullMethod, r, debug.Stack())
 			}
 		}()
+		identity, err := authorizeGRPC(ctx, auth, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		err = limitGRPC(ctx, limiter, identity, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		release, err := limiter.AcquireSimulation(info.FullMethod)
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		defer release()
 		logger.TraceMsg("GRPC unary call")
-		return handler(ctx, req)
+		resp, err = handler(ctx, req)
+		if limitErr, ok := err.(*LimitError); ok {
+			return nil, status.Error(codes.ResourceExhausted, limitErr.Error())
+		}
+		return resp, err
 	}
 }
 
-func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
+func streamInterceptor(logger *logging.Logger, auth *Auth, limiter *Limiter) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
 		logger = logger.With("method", info.FullMethod,
@@ -45,7 +70,88 @@ func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 				err = fmt.Errorf("panic in GRPC stream %s: %v: %s", info.FullMethod, r, debug.Stack())
 			}
 		}()
+		identity, err := authorizeGRPC(ss.Context(), auth, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		err = limitGRPC(ss.Context(), limiter, identity, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := limiter.StreamContext(ss.Context())
+		defer cancel()
 		logger.TraceMsg("GRPC stream call")
-		return handler(srv, ss)
+		err = handler(srv, &limitedServerStream{ServerStream: ss, ctx: ctx})
+		if limitErr, ok := err.(*LimitError); ok {
+			return status.Error(codes.ResourceExhausted, limitErr.Error())
+		}
+		if err != nil && ctx.Err() == context.DeadlineExceeded {
+			return status.Error(codes.DeadlineExceeded, "stream exceeded the maximum duration allowed by server")
+		}
+		return err
 	}
 }
+
+func authorizeGRPC(ctx context.Context, auth *Auth, method string) (string, error) {
+	if auth == nil {
+		return "", nil
+	}
+	identity, err := auth.Authorize(grpcCredentials(ctx), method)
+	if err != nil {
+		if authErr, ok := err.(*AuthError); ok && authErr.Unauthenticated {
+			return "", status.Error(codes.Unauthenticated, err.Error())
+		}
+		return "", status.Error(codes.PermissionDenied, err.Error())
+	}
+	return identity, nil
+}
+
+// Clients are limited by their authenticated identity if they have one, otherwise by their IP address
+func limitGRPC(ctx context.Context, limiter *Limiter, identity, method string) error {
+	if limiter == nil {
+		return nil
+	}
+	client := identity
+	if client == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			client = p.Addr.String()
+			if host, _, err := net.SplitHostPort(client); err == nil {
+				client = host
+			}
+		}
+	}
+	err := limiter.Allow(client, method)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
+// Replaces the stream's context with one bounded by the maximum stream duration
+type limitedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (lss *limitedServerStream) Context() context.Context {
+	return lss.ctx
+}
+
+func grpcCredentials(ctx context.Context) *Credentials {
+	creds := new(Credentials)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, authorization := range md["authorization"] {
+			if token := BearerToken(authorization); token != "" {
+				creds.BearerToken = token
+				break
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		// Only trust certificates that were verified against our client CAs during the handshake
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			creds.PeerCertificates = tlsInfo.State.VerifiedChains[0]
+		}
+	}
+	return creds
+}
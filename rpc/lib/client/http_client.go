@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -50,11 +51,17 @@ func makeHTTPDialer(remoteAddr string) (string, func(string, string) (net.Conn,
 
 // We overwrite the http.Client.Dial so we can do http over tcp or unix.
 // remoteAddr should be fully featured (eg. with tcp:// or unix://)
-func makeHTTPClient(remoteAddr string) (string, *http.Client) {
+// If tlsConfig is not nil https is used
+func makeHTTPClient(remoteAddr string, tlsConfig *tls.Config) (string, *http.Client) {
 	address, dialer := makeHTTPDialer(remoteAddr)
-	return "http://" + address, &http.Client{
+	scheme := "http://"
+	if tlsConfig != nil {
+		scheme = "https://"
+	}
+	return scheme + address, &http.Client{
 		Transport: &http.Transport{
-			Dial: dialer,
+			Dial:            dialer,
+			TLSClientConfig: tlsConfig,
 		},
 	}
 }
@@ -69,7 +76,12 @@ type JSONRPCClient struct {
 
 // NewJSONRPCClient returns a JSONRPCClient pointed at the given address.
 func NewJSONRPCClient(remote string) *JSONRPCClient {
-	address, client := makeHTTPClient(remote)
+	return NewJSONRPCClientTLS(remote, nil)
+}
+
+// NewJSONRPCClientTLS returns a JSONRPCClient pointed at the given address that connects using tlsConfig if not nil
+func NewJSONRPCClientTLS(remote string, tlsConfig *tls.Config) *JSONRPCClient {
+	address, client := makeHTTPClient(remote, tlsConfig)
 	return &JSONRPCClient{
 		address: address,
 		client:  client,
@@ -111,7 +123,12 @@ type URIClient struct {
 }
 
 func NewURIClient(remote string) *URIClient {
-	address, client := makeHTTPClient(remote)
+	return NewURIClientTLS(remote, nil)
+}
+
+// NewURIClientTLS returns a URIClient pointed at the given address that connects using tlsConfig if not nil
+func NewURIClientTLS(remote string, tlsConfig *tls.Config) *URIClient {
+	address, client := makeHTTPClient(remote, tlsConfig)
 	return &URIClient{
 		address: address,
 		client:  client,
@@ -388,8 +388,15 @@ type wsConnection struct {
 
 	// object that is used to subscribe / unsubscribe from events
 	eventSub types.EventSubscriber
+
+	// admits each call made over the connection
+	gate CallGate
 }
 
+// CallGate is asked to admit each call made over a websocket connection. It returns an error if the call is refused
+// otherwise a function to call once the call has returned.
+type CallGate func(method string) (done func(), err error)
+
 // NewWSConnection wraps websocket.Conn.
 //
 // See the commentary on the func(*wsConnection) functions for a detailed
@@ -425,6 +432,14 @@ func EventSubscriber(eventSub types.EventSubscriber) func(*wsConnection) {
 	}
 }
 
+// Gate sets the CallGate that must admit each call made over the connection.
+// It should only be used in the constructor - not Goroutine-safe.
+func Gate(gate CallGate) func(*wsConnection) {
+	return func(wsc *wsConnection) {
+		wsc.gate = gate
+	}
+}
+
 // WriteWait sets the amount of time to wait before a websocket write times out.
 // It should only be used in the constructor - not Goroutine-safe.
 func WriteWait(writeWait time.Duration) func(*wsConnection) {
@@ -590,7 +605,18 @@ func (wsc *wsConnection) readRoutine() {
 				wsc.WriteRPCResponse(types.RPCInternalError(request.ID, errors.Wrap(err, "Error converting json params to arguments")))
 				continue
 			}
-			returns := rpcFunc.f.Call(args)
+			done := func() {}
+			if wsc.gate != nil {
+				done, err = wsc.gate(request.Method)
+				if err != nil {
+					wsc.WriteRPCResponse(types.RPCServerError(request.ID, err))
+					continue
+				}
+			}
+			returns := func() []reflect.Value {
+				defer done()
+				return rpcFunc.f.Call(args)
+			}()
 
 			// TODO: Need to encode args/returns to string if we want to log them
 			wsc.Logger.Info("WSJSONRPC", "method", request.Method)
@@ -700,6 +726,13 @@ func NewWebsocketManager(funcMap map[string]*RPCFunc, logger *logging.Logger,
 
 // WebsocketHandler upgrades the request/response (via http.Hijack) and starts the wsConnection.
 func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Request) {
+	wm.ServeWebsocket(w, r)
+}
+
+// ServeWebsocket is WebsocketHandler for a connection that takes options in addition to those of the manager.
+func (wm *WebsocketManager) ServeWebsocket(w http.ResponseWriter, r *http.Request,
+	wsConnOptions ...func(*wsConnection)) {
+
 	wsConn, err := wm.Upgrade(w, r, nil)
 	if err != nil {
 		// TODO - return http error
@@ -708,7 +741,8 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 	}
 
 	// register connection
-	con := NewWSConnection(wsConn, wm.funcMap, wm.logger, wm.wsConnOptions...)
+	options := append(append([]func(*wsConnection){}, wm.wsConnOptions...), wsConnOptions...)
+	con := NewWSConnection(wsConn, wm.funcMap, wm.logger, options...)
 	wm.logger.InfoMsg("New websocket connection", "remote_address", con.remoteAddr)
 	err = con.Start() // Blocking
 	if err != nil {
@@ -2,12 +2,14 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc/lib/types"
 	"github.com/stretchr/testify/assert"
@@ -90,3 +92,40 @@ func TestRPCNotification(t *testing.T) {
 	require.Nil(t, err, "reading from the body should not give back an error")
 	require.Equal(t, len(blob), 0, "a notification SHOULD NOT be responded to by the server")
 }
+
+func TestWebsocketGate(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewRPCFunc(func() (string, error) { return "foo", nil }, ""),
+		"d": NewRPCFunc(func() (string, error) { return "bar", nil }, ""),
+	}
+	wm := NewWebsocketManager(funcMap, logging.NewNoopLogger())
+	var admitted, done []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wm.ServeWebsocket(w, r, Gate(func(method string) (func(), error) {
+			if method == "d" {
+				return nil, fmt.Errorf("refused")
+			}
+			admitted = append(admitted, method)
+			return func() { done = append(done, method) }, nil
+		}))
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	for _, method := range []string{"c", "d"} {
+		require.NoError(t, conn.WriteJSON(types.RPCRequest{JSONRPC: "2.0", ID: "0", Method: method}))
+		recv := new(types.RPCResponse)
+		require.NoError(t, conn.ReadJSON(recv))
+		if method == "d" {
+			require.NotNil(t, recv.Error)
+			assert.Contains(t, recv.Error.Data, "refused")
+		} else {
+			assert.Nil(t, recv.Error)
+		}
+	}
+	assert.Equal(t, []string{"c"}, admitted)
+	assert.Equal(t, []string{"c"}, done)
+}
@@ -3,6 +3,7 @@ package server
 
 import (
 	"bufio"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -18,6 +19,13 @@ import (
 )
 
 func StartHTTPServer(listenAddr string, handler http.Handler, logger *logging.Logger) (*http.Server, error) {
+	return StartHTTPServerTLS(listenAddr, handler, nil, logger)
+}
+
+// StartHTTPServerTLS serves HTTPS using tlsConfig or plain HTTP if tlsConfig is nil
+func StartHTTPServerTLS(listenAddr string, handler http.Handler, tlsConfig *tls.Config,
+	logger *logging.Logger) (*http.Server, error) {
+
 	var proto, addr string
 	parts := strings.SplitN(listenAddr, "://", 2)
 	if len(parts) != 2 {
@@ -30,6 +38,9 @@ func StartHTTPServer(listenAddr string, handler http.Handler, logger *logging.Lo
 	if err != nil {
 		return nil, errors.Errorf("Failed to listen on %v: %v", listenAddr, err)
 	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
 
 	server := &http.Server{Handler: RecoverAndLogHandler(handler, logger)}
 
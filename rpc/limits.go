@@ -0,0 +1,334 @@
+package rpc
+
+import (
+	"container/list"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"golang.org/x/net/context"
+)
+
+// Methods that execute transactions against a copy of state and so are subject to MaxConcurrentSimulations
+var SimulationMethods = []string{
+	"rpctransact.Transact/CallTxSim",
+	"rpctransact.Transact/CallCodeSim",
+	InfoMethodPrefix + "call",
+	InfoMethodPrefix + "call_code",
+}
+
+// The longest idle client rate limiters are forgotten once we are tracking this many
+const maxTrackedBuckets = 10000
+
+type LimitsConfig struct {
+	Enabled bool
+	// Requests each client may make per second across all methods (0 for no limit)
+	RequestsPerSecond float64
+	// Number of requests a client may make in a burst above RequestsPerSecond
+	Burst int
+	// Additional per-client limits for particular methods
+	Methods []*MethodLimitConfig `json:",omitempty" toml:",omitempty"`
+	// Maximum number of seconds a streaming call may last (0 for no limit)
+	MaxStreamSeconds uint64
+	// Maximum number of results returned by listing methods such as ListAccounts, ListNames, and DumpStorage
+	// (0 for no limit)
+	MaxResults int
+	// Maximum number of simulated calls (such as CallTxSim) that may execute at once (0 for no limit)
+	MaxConcurrentSimulations int
+}
+
+type MethodLimitConfig struct {
+	// A method or path.Match pattern named as in the ACL of AuthConfig, for example 'rpcquery.Query/ListAccounts'
+	Method            string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+func DefaultLimitsConfig() *LimitsConfig {
+	return &LimitsConfig{
+		Enabled:                  false,
+		RequestsPerSecond:        50,
+		Burst:                    100,
+		MaxStreamSeconds:         0,
+		MaxResults:               10000,
+		MaxConcurrentSimulations: 8,
+	}
+}
+
+type LimitError struct {
+	Reason string
+	Method string
+}
+
+func (err *LimitError) Error() string {
+	return fmt.Sprintf("request to %s refused: %s", err.Method, err.Reason)
+}
+
+// LimiterStats counts the requests refused by the Limiter
+type LimiterStats struct {
+	// Requests refused for exceeding a rate limit by method
+	RateLimited map[string]uint64
+	// Simulations refused for exceeding MaxConcurrentSimulations by method
+	SimulationsRefused map[string]uint64
+	// Results truncated by method
+	ResultsLimited map[string]uint64
+	// Number of simulations currently executing
+	SimulationsInFlight int
+}
+
+// Limiter enforces the rate, concurrency, and result size limits of LimitsConfig. A nil *Limiter imposes no limits.
+type Limiter struct {
+	sync.Mutex
+	conf    *LimitsConfig
+	buckets map[bucketKey]*tokenBucket
+	// Keys of buckets from the most to the least recently used
+	recent      *list.List
+	simulations chan struct{}
+	stats       LimiterStats
+	logger      *logging.Logger
+}
+
+type bucketKey struct {
+	client string
+	// Pattern of the MethodLimitConfig or empty for the client's overall limit
+	pattern string
+}
+
+func NewLimiter(conf *LimitsConfig, logger *logging.Logger) (*Limiter, error) {
+	for _, ml := range conf.Methods {
+		if _, err := path.Match(ml.Method, ""); err != nil {
+			return nil, fmt.Errorf("invalid method pattern '%s' in limits: %v", ml.Method, err)
+		}
+	}
+	limiter := &Limiter{
+		conf:    conf,
+		buckets: make(map[bucketKey]*tokenBucket),
+		recent:  list.New(),
+		stats: LimiterStats{
+			RateLimited:        make(map[string]uint64),
+			SimulationsRefused: make(map[string]uint64),
+			ResultsLimited:     make(map[string]uint64),
+		},
+		logger: logger.WithScope("Limiter"),
+	}
+	if conf.MaxConcurrentSimulations > 0 {
+		limiter.simulations = make(chan struct{}, conf.MaxConcurrentSimulations)
+	}
+	return limiter, nil
+}
+
+// Allow returns a *LimitError if client has exceeded a rate limit applying to method
+func (l *Limiter) Allow(client, method string) error {
+	if l == nil {
+		return nil
+	}
+	method = strings.TrimPrefix(method, "/")
+	now := time.Now()
+	l.Lock()
+	defer l.Unlock()
+	var buckets []*tokenBucket
+	if l.conf.RequestsPerSecond > 0 {
+		buckets = append(buckets, l.bucket(bucketKey{client: client}, l.conf.RequestsPerSecond, l.conf.Burst, now))
+	}
+	for _, ml := range l.conf.Methods {
+		if matched, _ := path.Match(ml.Method, method); matched {
+			buckets = append(buckets, l.bucket(bucketKey{client: client, pattern: ml.Method}, ml.RequestsPerSecond,
+				ml.Burst, now))
+		}
+	}
+	// Buckets just used are the most recent so are kept
+	l.forgetIdle()
+	// A refused request spends nothing so only take once every limit allows it
+	for _, bucket := range buckets {
+		if !bucket.available(now) {
+			return l.rateLimited(client, method)
+		}
+	}
+	for _, bucket := range buckets {
+		bucket.take(now)
+	}
+	return nil
+}
+
+// AcquireSimulation reserves one of the MaxConcurrentSimulations slots if method is a simulation returning a function
+// to release it or a *LimitError if they are all in use
+func (l *Limiter) AcquireSimulation(method string) (release func(), err error) {
+	method = strings.TrimPrefix(method, "/")
+	if l == nil || l.simulations == nil || !isSimulation(method) {
+		return func() {}, nil
+	}
+	select {
+	case l.simulations <- struct{}{}:
+		return func() { <-l.simulations }, nil
+	default:
+		l.Lock()
+		l.stats.SimulationsRefused[method]++
+		l.Unlock()
+		l.logger.TraceMsg("Refused simulation", "method", method)
+		return nil, &LimitError{Reason: "too many concurrent simulations", Method: method}
+	}
+}
+
+// StreamContext bounds the duration of a streaming call by MaxStreamSeconds
+func (l *Limiter) StreamContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if l == nil || l.conf.MaxStreamSeconds == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(l.conf.MaxStreamSeconds)*time.Second)
+}
+
+// MaxResults returns the maximum number of results listing methods should return or 0 if there is no limit
+func (l *Limiter) MaxResults() int {
+	if l == nil {
+		return 0
+	}
+	return l.conf.MaxResults
+}
+
+// ResultLimitExceeded records that method tried to return more than MaxResults and returns the error to report
+func (l *Limiter) ResultLimitExceeded(method string) error {
+	l.Lock()
+	l.stats.ResultsLimited[method]++
+	l.Unlock()
+	return &LimitError{
+		Reason: fmt.Sprintf("more than the maximum of %d results would be returned, please refine the request",
+			l.conf.MaxResults),
+		Method: method,
+	}
+}
+
+// Stats returns a copy of the Limiter's current statistics
+func (l *Limiter) Stats() *LimiterStats {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	defer l.Unlock()
+	stats := &LimiterStats{
+		RateLimited:         copyCounts(l.stats.RateLimited),
+		SimulationsRefused:  copyCounts(l.stats.SimulationsRefused),
+		ResultsLimited:      copyCounts(l.stats.ResultsLimited),
+		SimulationsInFlight: len(l.simulations),
+	}
+	return stats
+}
+
+func (l *Limiter) bucket(key bucketKey, rate float64, burst int, now time.Time) *tokenBucket {
+	bucket, ok := l.buckets[key]
+	if ok {
+		l.recent.MoveToFront(bucket.used)
+		return bucket
+	}
+	bucket = newTokenBucket(rate, burst, now)
+	bucket.used = l.recent.PushFront(key)
+	l.buckets[key] = bucket
+	return bucket
+}
+
+func (l *Limiter) rateLimited(client, method string) error {
+	l.stats.RateLimited[method]++
+	l.logger.TraceMsg("Rate limited request", "client", client, "method", method)
+	return &LimitError{Reason: "rate limit exceeded", Method: method}
+}
+
+// Drops the least recently used buckets beyond maxTrackedBuckets, those of clients idle long enough are full so
+// behave identically to new ones
+func (l *Limiter) forgetIdle() {
+	for l.recent.Len() > maxTrackedBuckets {
+		delete(l.buckets, l.recent.Remove(l.recent.Back()).(bucketKey))
+	}
+}
+
+func isSimulation(method string) bool {
+	for _, sim := range SimulationMethods {
+		if method == sim {
+			return true
+		}
+	}
+	return false
+}
+
+func copyCounts(counts map[string]uint64) map[string]uint64 {
+	cp := make(map[string]uint64, len(counts))
+	for k, v := range counts {
+		cp[k] = v
+	}
+	return cp
+}
+
+type tokenBucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+	// Element of Limiter.recent
+	used *list.Element
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	capacity := float64(burst)
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &tokenBucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+	if tb.tokens > tb.capacity {
+		tb.tokens = tb.capacity
+	}
+	tb.last = now
+}
+
+func (tb *tokenBucket) available(now time.Time) bool {
+	tb.refill(now)
+	return tb.tokens >= 1
+}
+
+func (tb *tokenBucket) take(now time.Time) bool {
+	if !tb.available(now) {
+		return false
+	}
+	tb.tokens--
+	return true
+}
+
+// Page counts the results of a listing against the limit requested by the client and the maximum imposed by the
+// Limiter
+type Page struct {
+	limit   int
+	count   int
+	limiter *Limiter
+	method  string
+}
+
+// NewPage starts a listing by method of at most limit results, a limit of 0 requests every result
+func (l *Limiter) NewPage(method string, limit uint64) *Page {
+	return &Page{
+		limit:   int(limit),
+		limiter: l,
+		method:  method,
+	}
+}
+
+// Add counts another result returning false if the page is already full, in which case the result begins the next
+// page, or an error if returning it would exceed MaxResults
+func (p *Page) Add() (bool, error) {
+	if p.limit > 0 && p.count == p.limit {
+		return false, nil
+	}
+	if maxResults := p.limiter.MaxResults(); maxResults > 0 && p.count == maxResults {
+		return false, p.limiter.ResultLimitExceeded(p.method)
+	}
+	p.count++
+	return true, nil
+}
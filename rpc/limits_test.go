@@ -0,0 +1,160 @@
+package rpc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter, err := NewLimiter(&LimitsConfig{
+		Enabled:           true,
+		RequestsPerSecond: 1,
+		Burst:             3,
+		Methods: []*MethodLimitConfig{
+			{Method: "rpcquery.Query/List*", RequestsPerSecond: 1, Burst: 1},
+		},
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, limiter.Allow("alice", "/rpcquery.Query/ListAccounts"))
+	assert.Error(t, limiter.Allow("alice", "/rpcquery.Query/ListNames"))
+	// The refused method-limited request did not consume from the client's overall allowance
+	require.NoError(t, limiter.Allow("alice", "/rpcquery.Query/GetAccount"))
+	require.NoError(t, limiter.Allow("alice", "/rpcquery.Query/GetAccount"))
+	assert.Error(t, limiter.Allow("alice", "/rpcquery.Query/GetAccount"))
+	// Other clients are unaffected
+	require.NoError(t, limiter.Allow("bob", "/rpcquery.Query/ListAccounts"))
+
+	stats := limiter.Stats()
+	assert.Equal(t, uint64(1), stats.RateLimited["rpcquery.Query/ListNames"])
+	assert.Equal(t, uint64(1), stats.RateLimited["rpcquery.Query/GetAccount"])
+
+	_, err = NewLimiter(&LimitsConfig{Methods: []*MethodLimitConfig{{Method: "[bad"}}}, logging.NewNoopLogger())
+	assert.Error(t, err)
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	tb := newTokenBucket(2, 2, now)
+	assert.True(t, tb.take(now))
+	assert.True(t, tb.take(now))
+	assert.False(t, tb.take(now))
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, tb.take(now))
+	assert.False(t, tb.take(now))
+	// But never refills beyond its burst
+	tb.refill(now.Add(time.Second))
+	assert.Equal(t, float64(2), tb.tokens)
+}
+
+func TestLimiter_ForgetIdle(t *testing.T) {
+	limiter, err := NewLimiter(&LimitsConfig{Enabled: true, RequestsPerSecond: 0.01, Burst: 3},
+		logging.NewNoopLogger())
+	require.NoError(t, err)
+	// More active clients than we track each partly drain their bucket
+	for i := 0; i < maxTrackedBuckets+100; i++ {
+		require.NoError(t, limiter.Allow(fmt.Sprint(i), "/rpcquery.Query/GetAccount"))
+	}
+	assert.Len(t, limiter.buckets, maxTrackedBuckets)
+	assert.Equal(t, maxTrackedBuckets, limiter.recent.Len())
+	// The longest idle are forgotten
+	assert.NotContains(t, limiter.buckets, bucketKey{client: "0"})
+	assert.NotContains(t, limiter.buckets, bucketKey{client: "99"})
+	// While a recent client keeps its partly drained bucket and stays tracked when it is used again
+	require.NoError(t, limiter.Allow("100", "/rpcquery.Query/GetAccount"))
+	require.NoError(t, limiter.Allow(fmt.Sprint(maxTrackedBuckets+100), "/rpcquery.Query/GetAccount"))
+	require.NoError(t, limiter.Allow("100", "/rpcquery.Query/GetAccount"))
+	assert.Error(t, limiter.Allow("100", "/rpcquery.Query/GetAccount"))
+	assert.NotContains(t, limiter.buckets, bucketKey{client: "101"})
+	assert.Len(t, limiter.buckets, maxTrackedBuckets)
+}
+
+func TestLimiter_AcquireSimulation(t *testing.T) {
+	limiter, err := NewLimiter(&LimitsConfig{Enabled: true, MaxConcurrentSimulations: 1}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	release, err := limiter.AcquireSimulation("/rpctransact.Transact/CallTxSim")
+	require.NoError(t, err)
+	_, err = limiter.AcquireSimulation("/rpctransact.Transact/CallCodeSim")
+	assert.Error(t, err)
+	// Non-simulation methods are not limited
+	_, err = limiter.AcquireSimulation("/rpctransact.Transact/SignTx")
+	require.NoError(t, err)
+	assert.Equal(t, 1, limiter.Stats().SimulationsInFlight)
+	release()
+	release, err = limiter.AcquireSimulation("/rpctransact.Transact/CallCodeSim")
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, uint64(1), limiter.Stats().SimulationsRefused["rpctransact.Transact/CallCodeSim"])
+}
+
+func TestUnaryInterceptorLimits(t *testing.T) {
+	limiter, err := NewLimiter(&LimitsConfig{
+		Enabled:                  true,
+		RequestsPerSecond:        1,
+		Burst:                    1,
+		MaxResults:               10,
+		MaxConcurrentSimulations: 1,
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	interceptor := unaryInterceptor(logging.NewNoopLogger(), nil, limiter)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, limiter.ResultLimitExceeded("rpcquery.Query/ListAccounts")
+	}
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("/rpcquery.Query/ListAccounts")))
+	err = call("/rpcquery.Query/ListAccounts")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "rate limit exceeded")
+}
+
+func TestNilLimiter(t *testing.T) {
+	var limiter *Limiter
+	require.NoError(t, limiter.Allow("alice", "/rpcquery.Query/ListAccounts"))
+	release, err := limiter.AcquireSimulation("/rpctransact.Transact/CallTxSim")
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, 0, limiter.MaxResults())
+	assert.Nil(t, limiter.Stats())
+	ctx, cancel := limiter.StreamContext(context.Background())
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+}
+
+func TestPage(t *testing.T) {
+	var limiter *Limiter
+	page := limiter.NewPage("rpcquery.Query/ListAccounts", 2)
+	for i := 0; i < 2; i++ {
+		ok, err := page.Add()
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+	ok, err := page.Add()
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	limiter, err = NewLimiter(&LimitsConfig{Enabled: true, MaxResults: 1}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	page = limiter.NewPage("rpcquery.Query/ListAccounts", 0)
+	ok, err = page.Add()
+	require.NoError(t, err)
+	assert.True(t, ok)
+	_, err = page.Add()
+	assert.Error(t, err)
+	assert.Equal(t, uint64(1), limiter.Stats().ResultsLimited["rpcquery.Query/ListAccounts"])
+}
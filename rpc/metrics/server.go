@@ -14,6 +14,7 @@
 package metrics
 
 import (
+	"crypto/tls"
 	"net/http"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -51,7 +52,7 @@ type Datum struct {
 	TimePerBlockBuckets map[float64]float64
 }
 
-func StartServer(service *rpc.Service, pattern, listenAddress string, blockSampleSize uint64,
+func StartServer(service *rpc.Service, pattern, listenAddress string, blockSampleSize uint64, tlsConfig *tls.Config,
 	logger *logging.Logger) (*http.Server, error) {
 
 	// instantiate metrics and variables we do not expect to change during runtime
@@ -73,7 +74,7 @@ func StartServer(service *rpc.Service, pattern, listenAddress string, blockSampl
 	mux := http.NewServeMux()
 	mux.Handle(pattern, server.RecoverAndLogHandler(prometheus.Handler(), logger))
 
-	srv, err := server.StartHTTPServer(listenAddress, mux, logger)
+	srv, err := server.StartHTTPServerTLS(listenAddress, mux, tlsConfig, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +120,26 @@ func AddMetrics() map[string]*prometheus.Desc {
 		"Current outbound peers",
 		[]string{"chain_id", "moniker"}, nil,
 	)
+	burrowMetrics["Rate Limited Requests"] = prometheus.NewDesc(
+		prometheus.BuildFQName("burrow", "limiter", "rate_limited_total"),
+		"Requests refused for exceeding a rate limit",
+		[]string{"chain_id", "moniker", "method"}, nil,
+	)
+	burrowMetrics["Refused Simulations"] = prometheus.NewDesc(
+		prometheus.BuildFQName("burrow", "limiter", "simulations_refused_total"),
+		"Simulated calls refused for exceeding the concurrency limit",
+		[]string{"chain_id", "moniker", "method"}, nil,
+	)
+	burrowMetrics["Result Limited Requests"] = prometheus.NewDesc(
+		prometheus.BuildFQName("burrow", "limiter", "results_limited_total"),
+		"Requests refused for exceeding the maximum number of results",
+		[]string{"chain_id", "moniker", "method"}, nil,
+	)
+	burrowMetrics["Simulations In Flight"] = prometheus.NewDesc(
+		prometheus.BuildFQName("burrow", "limiter", "simulations_in_flight"),
+		"Current number of simulated calls executing",
+		[]string{"chain_id", "moniker"}, nil,
+	)
 
 	return burrowMetrics
 }
@@ -192,6 +213,39 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.chainID,
 		e.validatorMoniker,
 	)
+	e.collectLimiterStats(ch)
 
 	e.logger.InfoMsg("All Metrics successfully collected")
 }
+
+// Exports the statistics of the service's limiter if it has one
+func (e *Exporter) collectLimiterStats(ch chan<- prometheus.Metric) {
+	stats := e.service.Limiter().Stats()
+	if stats == nil {
+		return
+	}
+	counters := map[string]map[string]uint64{
+		"Rate Limited Requests":   stats.RateLimited,
+		"Refused Simulations":     stats.SimulationsRefused,
+		"Result Limited Requests": stats.ResultsLimited,
+	}
+	for name, counts := range counters {
+		for method, count := range counts {
+			ch <- prometheus.MustNewConstMetric(
+				e.burrowMetrics[name],
+				prometheus.CounterValue,
+				float64(count),
+				e.chainID,
+				e.validatorMoniker,
+				method,
+			)
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(
+		e.burrowMetrics["Simulations In Flight"],
+		prometheus.GaugeValue,
+		float64(stats.SimulationsInFlight),
+		e.chainID,
+		e.validatorMoniker,
+	)
+}
@@ -48,10 +48,14 @@ type ResultStorage struct {
 type ResultAccounts struct {
 	BlockHeight uint64
 	Accounts    []*acm.ConcreteAccount
+	// Start of the next page of accounts if there are more
+	NextStart *crypto.Address `json:",omitempty"`
 }
 
 type ResultDumpStorage struct {
 	StorageItems []StorageItem
+	// Start of the next page of storage if there are more
+	NextKey binary.HexBytes `json:",omitempty"`
 }
 
 type StorageItem struct {
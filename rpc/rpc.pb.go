@@ -10,6 +10,7 @@
 	It has these top-level messages:
 		ResultStatus
 		SyncInfo
+		AccountFilter
 */
 package rpc
 
@@ -18,14 +19,14 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
-import tendermint "github.com/hyperledger/burrow/consensus/tendermint"
-import validator "github.com/hyperledger/burrow/acm/validator"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
+import validator "github.com/hyperledger/burrow/acm/validator"
+import tendermint "github.com/hyperledger/burrow/consensus/tendermint"
 
 import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 import time "time"
 
-import types "github.com/gogo/protobuf/types"
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 
 import io "io"
 
@@ -151,11 +152,79 @@ func (m *SyncInfo) GetCatchingUp() bool {
 func (*SyncInfo) XXX_MessageName() string {
 	return "rpc.SyncInfo"
 }
+
+// Selects accounts when listing them, fields left empty do not restrict the accounts selected
+type AccountFilter struct {
+	// Names of base permissions that must all be set and granted, for example 'send' or 'createContract'
+	Permissions []string `protobuf:"bytes,1,rep,name=Permissions" json:"Permissions,omitempty"`
+	// Roles the account must have all of
+	Roles []string `protobuf:"bytes,2,rep,name=Roles" json:"Roles,omitempty"`
+	// Only select contract accounts
+	HasCode bool `protobuf:"varint,3,opt,name=HasCode,proto3" json:"HasCode,omitempty"`
+	// Only select accounts without code
+	NoCode     bool   `protobuf:"varint,4,opt,name=NoCode,proto3" json:"NoCode,omitempty"`
+	MinBalance uint64 `protobuf:"varint,5,opt,name=MinBalance,proto3" json:"MinBalance,omitempty"`
+	// Maximum balance (inclusive), 0 for no maximum
+	MaxBalance uint64 `protobuf:"varint,6,opt,name=MaxBalance,proto3" json:"MaxBalance,omitempty"`
+}
+
+func (m *AccountFilter) Reset()                    { *m = AccountFilter{} }
+func (m *AccountFilter) String() string            { return proto.CompactTextString(m) }
+func (*AccountFilter) ProtoMessage()               {}
+func (*AccountFilter) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{2} }
+
+func (m *AccountFilter) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *AccountFilter) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *AccountFilter) GetHasCode() bool {
+	if m != nil {
+		return m.HasCode
+	}
+	return false
+}
+
+func (m *AccountFilter) GetNoCode() bool {
+	if m != nil {
+		return m.NoCode
+	}
+	return false
+}
+
+func (m *AccountFilter) GetMinBalance() uint64 {
+	if m != nil {
+		return m.MinBalance
+	}
+	return 0
+}
+
+func (m *AccountFilter) GetMaxBalance() uint64 {
+	if m != nil {
+		return m.MaxBalance
+	}
+	return 0
+}
+
+func (*AccountFilter) XXX_MessageName() string {
+	return "rpc.AccountFilter"
+}
 func init() {
 	proto.RegisterType((*ResultStatus)(nil), "rpc.ResultStatus")
 	golang_proto.RegisterType((*ResultStatus)(nil), "rpc.ResultStatus")
 	proto.RegisterType((*SyncInfo)(nil), "rpc.SyncInfo")
 	golang_proto.RegisterType((*SyncInfo)(nil), "rpc.SyncInfo")
+	proto.RegisterType((*AccountFilter)(nil), "rpc.AccountFilter")
+	golang_proto.RegisterType((*AccountFilter)(nil), "rpc.AccountFilter")
 }
 func (m *ResultStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -269,16 +338,16 @@ func (m *SyncInfo) MarshalTo(dAtA []byte) (int, error) {
 	i += n6
 	dAtA[i] = 0x22
 	i++
-	i = encodeVarintRpc(dAtA, i, uint64(types.SizeOfStdTime(m.LatestBlockTime)))
-	n7, err := types.StdTimeMarshalTo(m.LatestBlockTime, dAtA[i:])
+	i = encodeVarintRpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestBlockTime)))
+	n7, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LatestBlockTime, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
 	i += n7
 	dAtA[i] = 0x2a
 	i++
-	i = encodeVarintRpc(dAtA, i, uint64(types.SizeOfStdTime(m.LatestBlockSeenTime)))
-	n8, err := types.StdTimeMarshalTo(m.LatestBlockSeenTime, dAtA[i:])
+	i = encodeVarintRpc(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestBlockSeenTime)))
+	n8, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LatestBlockSeenTime, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
@@ -296,6 +365,84 @@ func (m *SyncInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *AccountFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.HasCode {
+		dAtA[i] = 0x18
+		i++
+		if m.HasCode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NoCode {
+		dAtA[i] = 0x20
+		i++
+		if m.NoCode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MinBalance != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.MinBalance))
+	}
+	if m.MaxBalance != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.MaxBalance))
+	}
+	return i, nil
+}
+
 func encodeVarintRpc(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -347,9 +494,9 @@ func (m *SyncInfo) Size() (n int) {
 	n += 1 + l + sovRpc(uint64(l))
 	l = m.LatestAppHash.Size()
 	n += 1 + l + sovRpc(uint64(l))
-	l = types.SizeOfStdTime(m.LatestBlockTime)
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestBlockTime)
 	n += 1 + l + sovRpc(uint64(l))
-	l = types.SizeOfStdTime(m.LatestBlockSeenTime)
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LatestBlockSeenTime)
 	n += 1 + l + sovRpc(uint64(l))
 	if m.CatchingUp {
 		n += 2
@@ -357,6 +504,36 @@ func (m *SyncInfo) Size() (n int) {
 	return n
 }
 
+func (m *AccountFilter) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovRpc(uint64(l))
+		}
+	}
+	if m.HasCode {
+		n += 2
+	}
+	if m.NoCode {
+		n += 2
+	}
+	if m.MinBalance != 0 {
+		n += 1 + sovRpc(uint64(m.MinBalance))
+	}
+	if m.MaxBalance != 0 {
+		n += 1 + sovRpc(uint64(m.MaxBalance))
+	}
+	return n
+}
+
 func sovRpc(x uint64) (n int) {
 	for {
 		n++
@@ -770,7 +947,7 @@ func (m *SyncInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := types.StdTimeUnmarshal(&m.LatestBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LatestBlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -800,7 +977,7 @@ func (m *SyncInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := types.StdTimeUnmarshal(&m.LatestBlockSeenTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LatestBlockSeenTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -845,6 +1022,192 @@ func (m *SyncInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasCode = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCode = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBalance", wireType)
+			}
+			m.MinBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBalance |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBalance", wireType)
+			}
+			m.MaxBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBalance |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRpc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -954,36 +1317,41 @@ func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 func init() { golang_proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7b, 0xf9, 0xd3, 0xa6, 0x97, 0x46, 0x85, 0xa3, 0x43, 0x94, 0xc1, 0x0e, 0x55, 0x87,
-	0x30, 0xe0, 0xa0, 0x20, 0x84, 0xc4, 0x86, 0x8b, 0x44, 0x2a, 0xa1, 0x0e, 0x4e, 0x09, 0x12, 0x0c,
-	0xc8, 0x76, 0xde, 0xda, 0x27, 0xec, 0xbb, 0xd3, 0xdd, 0x19, 0xc8, 0xb7, 0xe0, 0x23, 0x31, 0x46,
-	0x4c, 0x4c, 0x0c, 0x0c, 0x01, 0xa5, 0x1b, 0x9f, 0x02, 0xe5, 0x1c, 0x27, 0x4e, 0x41, 0x48, 0xa8,
-	0x9b, 0xdf, 0xe7, 0x79, 0xdf, 0xdf, 0x9d, 0x9f, 0x7b, 0xf1, 0xbe, 0x14, 0xa1, 0x23, 0x24, 0xd7,
-	0x9c, 0x54, 0xa5, 0x08, 0x3b, 0xf7, 0x23, 0xaa, 0xe3, 0x2c, 0x70, 0x42, 0x9e, 0xf6, 0x23, 0x1e,
-	0xf1, 0xbe, 0xf1, 0x82, 0xec, 0xd2, 0x54, 0xa6, 0x30, 0x5f, 0xf9, 0x4c, 0xe7, 0x96, 0x06, 0x36,
-	0x01, 0x99, 0x52, 0xa6, 0x57, 0xca, 0xe1, 0x7b, 0x3f, 0xa1, 0x13, 0x5f, 0x73, 0xb9, 0x12, 0xec,
-	0x88, 0xf3, 0x28, 0x81, 0x0d, 0x48, 0xd3, 0x14, 0x94, 0xf6, 0x53, 0x91, 0x37, 0x1c, 0x7f, 0xab,
-	0xe0, 0x03, 0x0f, 0x54, 0x96, 0xe8, 0x91, 0xf6, 0x75, 0xa6, 0x48, 0x1b, 0xef, 0x9d, 0xc6, 0x3e,
-	0x65, 0x67, 0xcf, 0xda, 0xa8, 0x8b, 0x7a, 0xfb, 0x5e, 0x51, 0x92, 0x23, 0x5c, 0xf7, 0xb2, 0xa5,
-	0x5e, 0x31, 0x7a, 0x5e, 0x90, 0x13, 0xdc, 0x72, 0x33, 0x29, 0xf9, 0x87, 0x31, 0x48, 0x45, 0x39,
-	0x6b, 0x57, 0x8d, 0xbb, 0x2d, 0x92, 0x57, 0xb8, 0xf9, 0x1c, 0x18, 0x28, 0xaa, 0x86, 0xbe, 0x8a,
-	0xdb, 0xb5, 0x2e, 0xea, 0x1d, 0xb8, 0x8f, 0x66, 0x73, 0x7b, 0xe7, 0xfb, 0xdc, 0x2e, 0xff, 0x76,
-	0x3c, 0x15, 0x20, 0x13, 0x98, 0x44, 0x20, 0xfb, 0x81, 0x41, 0xf4, 0x03, 0xca, 0x7c, 0x39, 0x75,
-	0x86, 0xf0, 0xd1, 0x9d, 0x6a, 0x50, 0x5e, 0x99, 0x44, 0x1e, 0xe0, 0xc6, 0x39, 0x9f, 0xc0, 0x19,
-	0xbb, 0xe4, 0xed, 0x7a, 0x17, 0xf5, 0x9a, 0x83, 0x23, 0xa7, 0x14, 0x4b, 0xe1, 0x79, 0xeb, 0x2e,
-	0x72, 0x0f, 0x37, 0x46, 0x53, 0x16, 0x9a, 0x89, 0x5d, 0x33, 0xd1, 0x72, 0x96, 0xef, 0x50, 0x88,
-	0xde, 0xda, 0x26, 0x4f, 0x70, 0x6b, 0x5c, 0x04, 0x6a, 0xfa, 0xf7, 0x56, 0x27, 0x6c, 0x62, 0x5e,
-	0xfb, 0xde, 0x76, 0xeb, 0xf1, 0x97, 0xea, 0xe6, 0x1c, 0x32, 0xc0, 0xb7, 0x5f, 0xf8, 0x1a, 0x94,
-	0x76, 0x13, 0x1e, 0xbe, 0x1b, 0x02, 0x8d, 0x62, 0x6d, 0xe2, 0xad, 0xb9, 0xb5, 0x5f, 0x73, 0x7b,
-	0xc7, 0xfb, 0xd3, 0x26, 0x6f, 0xf1, 0x61, 0x59, 0x5c, 0xc6, 0x56, 0xb9, 0x49, 0x6c, 0xd7, 0x69,
-	0xe4, 0x0d, 0x6e, 0xe5, 0xd2, 0x53, 0x21, 0x0c, 0xbe, 0x7a, 0x13, 0xfc, 0x36, 0x8b, 0x9c, 0x6f,
-	0xdd, 0xfe, 0x82, 0xa6, 0x60, 0x1e, 0xbd, 0x39, 0xe8, 0x38, 0xf9, 0x4a, 0x3a, 0xc5, 0x4a, 0x3a,
-	0x17, 0xc5, 0x4a, 0xba, 0x8d, 0xe5, 0xd1, 0x9f, 0x7e, 0xd8, 0xc8, 0xbb, 0x3e, 0x4c, 0xc6, 0xf8,
-	0x4e, 0x49, 0x1a, 0x01, 0x30, 0xc3, 0xac, 0xff, 0x07, 0xf3, 0x6f, 0x00, 0x72, 0x82, 0xf1, 0xa9,
-	0xaf, 0xc3, 0x98, 0xb2, 0xe8, 0xa5, 0x30, 0xfb, 0xd0, 0x58, 0x3d, 0x49, 0x49, 0x77, 0x1f, 0xcf,
-	0x16, 0x16, 0xfa, 0xba, 0xb0, 0xd0, 0xcf, 0x85, 0x85, 0x3e, 0x5f, 0x59, 0x68, 0x76, 0x65, 0xa1,
-	0xd7, 0x77, 0xff, 0x9d, 0x90, 0x14, 0x61, 0xb0, 0x6b, 0x6e, 0xf4, 0xf0, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x90, 0x42, 0x0c, 0xad, 0xea, 0x03, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x18, 0x9d, 0xdb, 0xae, 0xeb, 0xdc, 0x55, 0xfb, 0xfd, 0xcc, 0x84, 0xa2, 0x1d, 0xd2, 0x32, 0xed,
+	0x50, 0x0e, 0xa4, 0xa8, 0x08, 0x21, 0x71, 0x5b, 0x8a, 0xa0, 0x93, 0xa0, 0x42, 0xee, 0x28, 0x12,
+	0x1c, 0x90, 0x9b, 0x7a, 0x89, 0x45, 0x6a, 0x47, 0xb6, 0x03, 0xeb, 0x7f, 0xc1, 0xbf, 0xc3, 0x8d,
+	0x63, 0xc5, 0x89, 0x13, 0x07, 0x0e, 0x05, 0x75, 0x37, 0xfe, 0x0a, 0x14, 0x27, 0x69, 0xd3, 0x81,
+	0x90, 0xd0, 0x6e, 0x79, 0xef, 0x7d, 0xdf, 0x73, 0xfc, 0xbe, 0xcf, 0x70, 0x57, 0x46, 0x9e, 0x13,
+	0x49, 0xa1, 0x05, 0x2a, 0xcb, 0xc8, 0x3b, 0xbc, 0xe3, 0x33, 0x1d, 0xc4, 0x63, 0xc7, 0x13, 0xd3,
+	0x8e, 0x2f, 0x7c, 0xd1, 0x31, 0xda, 0x38, 0x3e, 0x37, 0xc8, 0x00, 0xf3, 0x95, 0xf6, 0x1c, 0xfe,
+	0xa7, 0x29, 0x9f, 0x50, 0x39, 0x65, 0x5c, 0x67, 0xcc, 0xfe, 0x3b, 0x12, 0xb2, 0x09, 0xd1, 0x42,
+	0x66, 0x44, 0xd3, 0x17, 0xc2, 0x0f, 0xe9, 0xda, 0x48, 0xb3, 0x29, 0x55, 0x9a, 0x4c, 0xa3, 0xb4,
+	0xe0, 0xe8, 0x6b, 0x09, 0xee, 0x61, 0xaa, 0xe2, 0x50, 0x0f, 0x35, 0xd1, 0xb1, 0x42, 0x16, 0xdc,
+	0xe9, 0x05, 0x84, 0xf1, 0xd3, 0x47, 0x16, 0x68, 0x81, 0xf6, 0x2e, 0xce, 0x21, 0x3a, 0x80, 0xdb,
+	0x38, 0x4e, 0xf8, 0x92, 0xe1, 0x53, 0x80, 0x8e, 0x61, 0xc3, 0x8d, 0xa5, 0x14, 0xef, 0x47, 0x54,
+	0x2a, 0x26, 0xb8, 0x55, 0x36, 0xea, 0x26, 0x89, 0x5e, 0xc2, 0xfa, 0x13, 0xca, 0xa9, 0x62, 0xaa,
+	0x4f, 0x54, 0x60, 0x55, 0x5a, 0xa0, 0xbd, 0xe7, 0xde, 0x9f, 0x2f, 0x9a, 0x5b, 0xdf, 0x16, 0xcd,
+	0xe2, 0xb5, 0x83, 0x59, 0x44, 0x65, 0x48, 0x27, 0x3e, 0x95, 0x9d, 0xb1, 0xb1, 0xe8, 0x8c, 0x19,
+	0x27, 0x72, 0xe6, 0xf4, 0xe9, 0x85, 0x3b, 0xd3, 0x54, 0xe1, 0xa2, 0x13, 0xba, 0x0b, 0x6b, 0x03,
+	0x31, 0xa1, 0xa7, 0xfc, 0x5c, 0x58, 0xdb, 0x2d, 0xd0, 0xae, 0x77, 0x0f, 0x9c, 0x42, 0x2c, 0xb9,
+	0x86, 0x57, 0x55, 0xe8, 0x36, 0xac, 0x0d, 0x67, 0xdc, 0x33, 0x1d, 0x55, 0xd3, 0xd1, 0x70, 0x92,
+	0x39, 0xe4, 0x24, 0x5e, 0xc9, 0xe8, 0x21, 0x6c, 0x8c, 0xf2, 0x40, 0x4d, 0xfd, 0x4e, 0x76, 0xc2,
+	0x3a, 0xe6, 0x95, 0x8e, 0x37, 0x4b, 0x8f, 0x3e, 0x97, 0xd7, 0xe7, 0xa0, 0x2e, 0xfc, 0xff, 0x29,
+	0xd1, 0x54, 0x69, 0x37, 0x14, 0xde, 0xdb, 0x3e, 0x65, 0x7e, 0xa0, 0x4d, 0xbc, 0x15, 0xb7, 0xf2,
+	0x73, 0xd1, 0xdc, 0xc2, 0xbf, 0xcb, 0xe8, 0x0d, 0xdc, 0x2f, 0x92, 0x49, 0x6c, 0xa5, 0xeb, 0xc4,
+	0x76, 0xd5, 0x0d, 0xbd, 0x86, 0x8d, 0x94, 0x3a, 0x89, 0x22, 0x63, 0x5f, 0xbe, 0x8e, 0xfd, 0xa6,
+	0x17, 0x1a, 0x6c, 0xfc, 0xfd, 0x19, 0x9b, 0x52, 0x33, 0xf4, 0x7a, 0xf7, 0xd0, 0x49, 0x57, 0xd2,
+	0xc9, 0x57, 0xd2, 0x39, 0xcb, 0x57, 0xd2, 0xad, 0x25, 0x47, 0x7f, 0xf8, 0xde, 0x04, 0xf8, 0x6a,
+	0x33, 0x1a, 0xc1, 0x1b, 0x05, 0x6a, 0x48, 0x29, 0x37, 0x9e, 0xdb, 0xff, 0xe0, 0xf9, 0x27, 0x03,
+	0x74, 0x0c, 0x61, 0x8f, 0x68, 0x2f, 0x60, 0xdc, 0x7f, 0x11, 0x99, 0x7d, 0xa8, 0x65, 0x23, 0x29,
+	0xf0, 0x47, 0x1f, 0x01, 0x6c, 0x9c, 0x78, 0x9e, 0x88, 0xb9, 0x7e, 0xcc, 0x42, 0x4d, 0x25, 0x6a,
+	0xc1, 0xfa, 0xf3, 0x64, 0xc7, 0x54, 0xb2, 0xde, 0xca, 0x02, 0xad, 0x72, 0x7b, 0x17, 0x17, 0x29,
+	0xf3, 0x5c, 0x44, 0x48, 0x95, 0x55, 0x32, 0x5a, 0x0a, 0x92, 0xe7, 0xd5, 0x27, 0xaa, 0x27, 0x26,
+	0xd4, 0xc4, 0x5d, 0xc3, 0x39, 0x44, 0x37, 0x61, 0x75, 0x20, 0x8c, 0x50, 0x31, 0x42, 0x86, 0x90,
+	0x0d, 0xe1, 0x33, 0xc6, 0x5d, 0x12, 0x12, 0xee, 0xa5, 0x17, 0xae, 0xe0, 0x02, 0x63, 0x74, 0x72,
+	0x91, 0xeb, 0xd5, 0x4c, 0x5f, 0x31, 0xee, 0x83, 0xf9, 0xd2, 0x06, 0x5f, 0x96, 0x36, 0xf8, 0xb1,
+	0xb4, 0xc1, 0xa7, 0x4b, 0x1b, 0xcc, 0x2f, 0x6d, 0xf0, 0xea, 0xd6, 0xdf, 0xa7, 0x2b, 0x23, 0x6f,
+	0x5c, 0x35, 0x69, 0xde, 0xfb, 0x35, 0x00, 0x31, 0x49, 0x07, 0x5b, 0xa6, 0x04, 0x00, 0x00,
 }
@@ -87,6 +87,17 @@ func (ees *executionEventsServer) GetBlocks(request *BlocksRequest, stream Execu
 }
 
 func (ees *executionEventsServer) GetTx(ctx context.Context, request *GetTxRequest) (*exec.TxExecution, error) {
+	var out <-chan interface{}
+	if request.Wait {
+		// Subscribe before looking in state so we cannot miss a transaction executed in between
+		subID := event.GenSubID()
+		var err error
+		out, err = ees.subscribable.Subscribe(ctx, subID, exec.QueryForTxExecution(request.TxHash), SubscribeBufferSize)
+		if err != nil {
+			return nil, err
+		}
+		defer ees.subscribable.UnsubscribeAll(ctx, subID)
+	}
 	txe, err := ees.eventsProvider.GetTx(request.TxHash)
 	if err != nil {
 		return nil, err
@@ -97,12 +108,6 @@ func (ees *executionEventsServer) GetTx(ctx context.Context, request *GetTxReque
 	if !request.Wait {
 		return nil, fmt.Errorf("transaction with hash %v not found in state", request.TxHash)
 	}
-	subID := event.GenSubID()
-	out, err := ees.subscribable.Subscribe(ctx, subID, exec.QueryForTxExecution(request.TxHash), SubscribeBufferSize)
-	if err != nil {
-		return nil, err
-	}
-	defer ees.subscribable.UnsubscribeAll(ctx, subID)
 	for msg := range out {
 		select {
 		case <-ctx.Done():
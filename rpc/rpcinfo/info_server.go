@@ -15,24 +15,156 @@
 package rpcinfo
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io/ioutil"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
+	"github.com/hyperledger/burrow/rpc/lib/types"
 )
 
-func StartServer(service *rpc.Service, pattern, listenAddress string, logger *logging.Logger) (*http.Server, error) {
+// StartServer starts the info server, if auth is not nil every request is authenticated and authorised by it and
+// if tlsConfig is not nil it is served over TLS. Requests are subject to the service's limiter if it has one.
+func StartServer(service *rpc.Service, pattern, listenAddress string, auth *rpc.Auth, tlsConfig *tls.Config,
+	logger *logging.Logger) (*http.Server, error) {
+
 	logger = logger.With(structure.ComponentKey, "RPC_Info")
 	routes := GetRoutes(service, logger)
 	mux := http.NewServeMux()
 	wm := server.NewWebsocketManager(routes, logger)
-	mux.HandleFunc(pattern, wm.WebsocketHandler)
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if gate, ok := r.Context().Value(callGateKey).(server.CallGate); ok {
+			wm.ServeWebsocket(w, r, server.Gate(gate))
+			return
+		}
+		wm.WebsocketHandler(w, r)
+	})
 	server.RegisterRPCFuncs(mux, routes, logger)
-	srv, err := server.StartHTTPServer(listenAddress, mux, logger)
+	var handler http.Handler = mux
+	if service.Limiter() != nil {
+		handler = LimitHandler(handler, service.Limiter(), pattern)
+	}
+	if auth != nil {
+		handler = AuthHandler(handler, auth, pattern)
+	}
+	srv, err := server.StartHTTPServerTLS(listenAddress, handler, tlsConfig, logger)
 	if err != nil {
 		return nil, err
 	}
 	return srv, nil
 }
+
+type requestKey int
+
+const (
+	// The identity AuthHandler authenticated
+	identityKey requestKey = iota
+	// The server.CallGate LimitHandler sets for calls over a websocket
+	callGateKey
+)
+
+// AuthHandler only passes requests to handler if auth permits the caller to call the info method requested. Since
+// websocket connections may call any method they require permission for every info method.
+func AuthHandler(handler http.Handler, auth *rpc.Auth, websocketPattern string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, err := infoMethod(r, websocketPattern)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		creds := &rpc.Credentials{
+			BearerToken: rpc.BearerToken(r.Header.Get("Authorization")),
+		}
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+			creds.PeerCertificates = r.TLS.VerifiedChains[0]
+		}
+		identity, err := auth.Authorize(creds, rpc.InfoMethodPrefix+method)
+		if err != nil {
+			if authErr, ok := err.(*rpc.AuthError); ok && authErr.Unauthenticated {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), identityKey, identity)))
+	})
+}
+
+// LimitHandler refuses requests that exceed the rate or simulation concurrency limits of limiter with
+// 429 Too Many Requests. Clients are identified by the identity AuthHandler authenticated if it ran first, otherwise by
+// their IP address. Each call over a websocket connection is subject to the limits of the method called.
+func LimitHandler(handler http.Handler, limiter *rpc.Limiter, websocketPattern string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, err := infoMethod(r, websocketPattern)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		client, _ := r.Context().Value(identityKey).(string)
+		if client == "" {
+			client, _, err = net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				client = r.RemoteAddr
+			}
+		}
+		if method == rpc.AllMethods {
+			gate := server.CallGate(func(method string) (func(), error) {
+				return limit(limiter, client, rpc.InfoMethodPrefix+method)
+			})
+			handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), callGateKey, gate)))
+			return
+		}
+		release, err := limit(limiter, client, rpc.InfoMethodPrefix+method)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		defer release()
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Checks the rate limits in the same order as the GRPC interceptors before acquiring a simulation slot
+func limit(limiter *rpc.Limiter, client, method string) (release func(), err error) {
+	err = limiter.Allow(client, method)
+	if err != nil {
+		return nil, err
+	}
+	return limiter.AcquireSimulation(method)
+}
+
+// Returns the info method requested either via URL path or in the body of a JSON-RPC request
+func infoMethod(r *http.Request, websocketPattern string) (string, error) {
+	if r.URL.Path == websocketPattern {
+		return rpc.AllMethods, nil
+	}
+	if r.URL.Path != "/" {
+		return strings.TrimPrefix(r.URL.Path, "/"), nil
+	}
+	bs, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	// Restore the body for the JSON-RPC handler
+	r.Body = ioutil.NopCloser(bytes.NewReader(bs))
+	if len(bs) == 0 {
+		// Lists the available endpoints
+		return "", nil
+	}
+	request := new(types.RPCRequest)
+	err = json.Unmarshal(bs, request)
+	if err != nil {
+		return "", err
+	}
+	return request.Method, nil
+}
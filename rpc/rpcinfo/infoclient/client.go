@@ -19,16 +19,37 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/lib/client"
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
 )
 
 type RPCClient interface {
 	Call(method string, params map[string]interface{}, result interface{}) (interface{}, error)
 }
 
+// NewJSONRPCClient returns a client for the info server at remote that connects over TLS if tlsConf is enabled
+func NewJSONRPCClient(remote string, tlsConf *tlsconfig.ClientConfig) (RPCClient, error) {
+	tlsConfig, err := tlsConf.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.NewJSONRPCClientTLS(remote, tlsConfig), nil
+}
+
+// NewURIClient returns a client for the info server at remote that connects over TLS if tlsConf is enabled
+func NewURIClient(remote string, tlsConf *tlsconfig.ClientConfig) (RPCClient, error) {
+	tlsConfig, err := tlsConf.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.NewURIClientTLS(remote, tlsConfig), nil
+}
+
 func Status(client RPCClient) (*rpc.ResultStatus, error) {
 	res := new(rpc.ResultStatus)
 	_, err := client.Call(rpcinfo.Status, pmap(), res)
@@ -60,9 +81,28 @@ func Account(client RPCClient, address crypto.Address) (acm.Account, error) {
 	return concreteAccount.Account(), nil
 }
 
+// ListAccounts returns up to limit accounts selected by filter (which may be nil) in address order from start
+func ListAccounts(client RPCClient, start crypto.Address, limit uint64,
+	filter *rpc.AccountFilter) (*rpc.ResultAccounts, error) {
+	res := new(rpc.ResultAccounts)
+	_, err := client.Call(rpcinfo.Accounts, pmap("start", start, "limit", limit, "filter", filter), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DumpStorage returns the storage of the account at address
 func DumpStorage(client RPCClient, address crypto.Address) (*rpc.ResultDumpStorage, error) {
+	return DumpStorageRange(client, address, nil, nil, 0)
+}
+
+// DumpStorageRange returns up to limit storage items with keys beginning with keyPrefix from start
+func DumpStorageRange(client RPCClient, address crypto.Address, keyPrefix, start []byte,
+	limit uint64) (*rpc.ResultDumpStorage, error) {
 	res := new(rpc.ResultDumpStorage)
-	_, err := client.Call(rpcinfo.DumpStorage, pmap("address", address), res)
+	_, err := client.Call(rpcinfo.DumpStorage, pmap("address", address, "key_prefix", binary.HexBytes(keyPrefix),
+		"start", binary.HexBytes(start), "limit", limit), res)
 	if err != nil {
 		return nil, err
 	}
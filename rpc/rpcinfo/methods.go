@@ -1,7 +1,6 @@
 package rpcinfo
 
 import (
-	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
@@ -48,15 +47,11 @@ func GetRoutes(service *rpc.Service, logger *logging.Logger) map[string]*server.
 		Network: server.NewRPCFunc(service.Network, ""),
 
 		// Accounts
-		Accounts: server.NewRPCFunc(func() (*rpc.ResultAccounts, error) {
-			return service.Accounts(func(acm.Account) bool {
-				return true
-			})
-		}, ""),
+		Accounts: server.NewRPCFunc(service.ListAccounts, "start,limit,filter"),
 
 		Account:         server.NewRPCFunc(service.Account, "address"),
 		Storage:         server.NewRPCFunc(service.Storage, "address,key"),
-		DumpStorage:     server.NewRPCFunc(service.DumpStorage, "address"),
+		DumpStorage:     server.NewRPCFunc(service.DumpStorage, "address,key_prefix,start,limit"),
 		GetAccountHuman: server.NewRPCFunc(service.AccountHumanReadable, "address"),
 
 		// Blockchain
@@ -2,10 +2,12 @@ package rpcquery
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/state"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/names"
@@ -18,18 +20,20 @@ type queryServer struct {
 	nameReg    names.IterableReader
 	blockchain bcm.BlockchainInfo
 	nodeView   *tendermint.NodeView
+	limiter    *rpc.Limiter
 	logger     *logging.Logger
 }
 
 var _ QueryServer = &queryServer{}
 
 func NewQueryServer(state state.IterableReader, nameReg names.IterableReader, blockchain bcm.BlockchainInfo,
-	nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
+	nodeView *tendermint.NodeView, limiter *rpc.Limiter, logger *logging.Logger) *queryServer {
 	return &queryServer{
 		accounts:   state,
 		nameReg:    nameReg,
 		blockchain: blockchain,
 		nodeView:   nodeView,
+		limiter:    limiter,
 		logger:     logger,
 	}
 }
@@ -50,9 +54,22 @@ func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (
 
 func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListAccountsServer) error {
 	qry, err := query.NewBuilder(param.Query).Query()
+	if err != nil {
+		return err
+	}
+	matches, err := param.Filter.Matcher()
+	if err != nil {
+		return err
+	}
+	page := qs.limiter.NewPage("rpcquery.Query/ListAccounts", param.Limit)
 	var streamErr error
-	_, err = qs.accounts.IterateAccounts(func(acc acm.Account) (stop bool) {
-		if qry.Matches(acc.Tagged()) {
+	_, err = qs.accounts.IterateAccountsFrom(param.Start, func(acc acm.Account) (stop bool) {
+		if qry.Matches(acc.Tagged()) && matches(acc) {
+			var ok bool
+			ok, streamErr = page.Add()
+			if !ok {
+				return true
+			}
 			streamErr = stream.Send(acm.AsConcreteAccount(acc))
 			if streamErr != nil {
 				return true
@@ -66,9 +83,36 @@ func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListA
 	return streamErr
 }
 
+func (qs *queryServer) ListStorage(param *ListStorageParam, stream Query_ListStorageServer) error {
+	start, end := rpc.StorageRange(param.KeyPrefix, param.Start)
+	page := qs.limiter.NewPage("rpcquery.Query/ListStorage", param.Limit)
+	var streamErr error
+	_, err := qs.accounts.IterateStorageRange(param.Address, start, end, func(key, value binary.Word256) (stop bool) {
+		var ok bool
+		ok, streamErr = page.Add()
+		if !ok {
+			return true
+		}
+		streamErr = stream.Send(&StorageItem{Key: key.Bytes(), Value: value.Bytes()})
+		return streamErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	return streamErr
+}
+
 // Name registry
 func (qs *queryServer) GetName(ctx context.Context, param *GetNameParam) (*names.Entry, error) {
-	return qs.nameReg.GetName(param.Name)
+	entry, err := qs.nameReg.GetName(param.Name)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		// A nil response cannot be marshalled
+		return nil, fmt.Errorf("name %s is not registered", param.Name)
+	}
+	return entry, nil
 }
 
 func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesServer) error {
@@ -77,8 +121,14 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 		return err
 	}
 	var streamErr error
-	_, err = qs.nameReg.IterateNames(func(entry *names.Entry) (stop bool) {
+	page := qs.limiter.NewPage("rpcquery.Query/ListNames", param.Limit)
+	_, err = qs.nameReg.IterateNamesFrom(param.Start, func(entry *names.Entry) (stop bool) {
 		if qry.Matches(entry.Tagged()) {
+			var ok bool
+			ok, streamErr = page.Add()
+			if !ok {
+				return true
+			}
 			streamErr = stream.Send(entry)
 			if streamErr != nil {
 				return true
@@ -11,6 +11,8 @@
 		StatusParam
 		GetAccountParam
 		ListAccountsParam
+		ListStorageParam
+		StorageItem
 		GetNameParam
 		ListNamesParam
 		GetValidatorSetParam
@@ -24,12 +26,13 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
-import names "github.com/hyperledger/burrow/execution/names"
 import acm "github.com/hyperledger/burrow/acm"
 import validator "github.com/hyperledger/burrow/acm/validator"
+import names "github.com/hyperledger/burrow/execution/names"
 import rpc "github.com/hyperledger/burrow/rpc"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
@@ -91,6 +94,12 @@ func (*GetAccountParam) XXX_MessageName() string {
 
 type ListAccountsParam struct {
 	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Accounts are listed in address order starting from this address (inclusive). To fetch the next page pass the
+	// address following the last one returned.
+	Start github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Start,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Start"`
+	// Maximum number of accounts to return, 0 for no limit
+	Limit  uint64             `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Filter *rpc.AccountFilter `protobuf:"bytes,4,opt,name=Filter" json:"Filter,omitempty"`
 }
 
 func (m *ListAccountsParam) Reset()                    { *m = ListAccountsParam{} }
@@ -105,10 +114,64 @@ func (m *ListAccountsParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListAccountsParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAccountsParam) GetFilter() *rpc.AccountFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 func (*ListAccountsParam) XXX_MessageName() string {
 	return "rpcquery.ListAccountsParam"
 }
 
+type ListStorageParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Only list keys beginning with these bytes
+	KeyPrefix github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=KeyPrefix,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"KeyPrefix"`
+	// Storage is listed in key order starting from this key (inclusive)
+	Start github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Start,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Start"`
+	// Maximum number of items to return, 0 for no limit
+	Limit uint64 `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (m *ListStorageParam) Reset()                    { *m = ListStorageParam{} }
+func (m *ListStorageParam) String() string            { return proto.CompactTextString(m) }
+func (*ListStorageParam) ProtoMessage()               {}
+func (*ListStorageParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{3} }
+
+func (m *ListStorageParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (*ListStorageParam) XXX_MessageName() string {
+	return "rpcquery.ListStorageParam"
+}
+
+type StorageItem struct {
+	Key   github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Key"`
+	Value github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Value"`
+}
+
+func (m *StorageItem) Reset()                    { *m = StorageItem{} }
+func (m *StorageItem) String() string            { return proto.CompactTextString(m) }
+func (*StorageItem) ProtoMessage()               {}
+func (*StorageItem) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{4} }
+
+func (*StorageItem) XXX_MessageName() string {
+	return "rpcquery.StorageItem"
+}
+
 type GetNameParam struct {
 	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 }
@@ -116,7 +179,7 @@ type GetNameParam struct {
 func (m *GetNameParam) Reset()                    { *m = GetNameParam{} }
 func (m *GetNameParam) String() string            { return proto.CompactTextString(m) }
 func (*GetNameParam) ProtoMessage()               {}
-func (*GetNameParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{3} }
+func (*GetNameParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{5} }
 
 func (m *GetNameParam) GetName() string {
 	if m != nil {
@@ -131,12 +194,16 @@ func (*GetNameParam) XXX_MessageName() string {
 
 type ListNamesParam struct {
 	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Names are listed in name order starting from this name (inclusive)
+	Start string `protobuf:"bytes,2,opt,name=Start,proto3" json:"Start,omitempty"`
+	// Maximum number of names to return, 0 for no limit
+	Limit uint64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
 }
 
 func (m *ListNamesParam) Reset()                    { *m = ListNamesParam{} }
 func (m *ListNamesParam) String() string            { return proto.CompactTextString(m) }
 func (*ListNamesParam) ProtoMessage()               {}
-func (*ListNamesParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{4} }
+func (*ListNamesParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{6} }
 
 func (m *ListNamesParam) GetQuery() string {
 	if m != nil {
@@ -145,6 +212,20 @@ func (m *ListNamesParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListNamesParam) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *ListNamesParam) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 func (*ListNamesParam) XXX_MessageName() string {
 	return "rpcquery.ListNamesParam"
 }
@@ -156,7 +237,7 @@ type GetValidatorSetParam struct {
 func (m *GetValidatorSetParam) Reset()                    { *m = GetValidatorSetParam{} }
 func (m *GetValidatorSetParam) String() string            { return proto.CompactTextString(m) }
 func (*GetValidatorSetParam) ProtoMessage()               {}
-func (*GetValidatorSetParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{5} }
+func (*GetValidatorSetParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{7} }
 
 func (m *GetValidatorSetParam) GetIncludeHistory() bool {
 	if m != nil {
@@ -178,7 +259,7 @@ type ValidatorSet struct {
 func (m *ValidatorSet) Reset()                    { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string            { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()               {}
-func (*ValidatorSet) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{6} }
+func (*ValidatorSet) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{8} }
 
 func (m *ValidatorSet) GetHeight() uint64 {
 	if m != nil {
@@ -212,7 +293,7 @@ type ValidatorSetDeltas struct {
 func (m *ValidatorSetDeltas) Reset()                    { *m = ValidatorSetDeltas{} }
 func (m *ValidatorSetDeltas) String() string            { return proto.CompactTextString(m) }
 func (*ValidatorSetDeltas) ProtoMessage()               {}
-func (*ValidatorSetDeltas) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{7} }
+func (*ValidatorSetDeltas) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{9} }
 
 func (m *ValidatorSetDeltas) GetValidators() []*validator.Validator {
 	if m != nil {
@@ -231,6 +312,10 @@ func init() {
 	golang_proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
 	proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
 	golang_proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
+	proto.RegisterType((*ListStorageParam)(nil), "rpcquery.ListStorageParam")
+	golang_proto.RegisterType((*ListStorageParam)(nil), "rpcquery.ListStorageParam")
+	proto.RegisterType((*StorageItem)(nil), "rpcquery.StorageItem")
+	golang_proto.RegisterType((*StorageItem)(nil), "rpcquery.StorageItem")
 	proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
 	golang_proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
 	proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
@@ -257,6 +342,7 @@ type QueryClient interface {
 	Status(ctx context.Context, in *StatusParam, opts ...grpc.CallOption) (*rpc.ResultStatus, error)
 	GetAccount(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*acm.ConcreteAccount, error)
 	ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error)
+	ListStorage(ctx context.Context, in *ListStorageParam, opts ...grpc.CallOption) (Query_ListStorageClient, error)
 	GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error)
 	ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error)
 	GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error)
@@ -320,6 +406,38 @@ func (x *queryListAccountsClient) Recv() (*acm.ConcreteAccount, error) {
 	return m, nil
 }
 
+func (c *queryClient) ListStorage(ctx context.Context, in *ListStorageParam, opts ...grpc.CallOption) (Query_ListStorageClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Query_serviceDesc.Streams[1], c.cc, "/rpcquery.Query/ListStorage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryListStorageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ListStorageClient interface {
+	Recv() (*StorageItem, error)
+	grpc.ClientStream
+}
+
+type queryListStorageClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryListStorageClient) Recv() (*StorageItem, error) {
+	m := new(StorageItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error) {
 	out := new(names.Entry)
 	err := grpc.Invoke(ctx, "/rpcquery.Query/GetName", in, out, c.cc, opts...)
@@ -330,7 +448,7 @@ func (c *queryClient) GetName(ctx context.Context, in *GetNameParam, opts ...grp
 }
 
 func (c *queryClient) ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Query_serviceDesc.Streams[1], c.cc, "/rpcquery.Query/ListNames", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Query_serviceDesc.Streams[2], c.cc, "/rpcquery.Query/ListNames", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -376,6 +494,7 @@ type QueryServer interface {
 	Status(context.Context, *StatusParam) (*rpc.ResultStatus, error)
 	GetAccount(context.Context, *GetAccountParam) (*acm.ConcreteAccount, error)
 	ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error
+	ListStorage(*ListStorageParam, Query_ListStorageServer) error
 	GetName(context.Context, *GetNameParam) (*names.Entry, error)
 	ListNames(*ListNamesParam, Query_ListNamesServer) error
 	GetValidatorSet(context.Context, *GetValidatorSetParam) (*ValidatorSet, error)
@@ -442,6 +561,27 @@ func (x *queryListAccountsServer) Send(m *acm.ConcreteAccount) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_ListStorage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListStorageParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ListStorage(m, &queryListStorageServer{stream})
+}
+
+type Query_ListStorageServer interface {
+	Send(*StorageItem) error
+	grpc.ServerStream
+}
+
+type queryListStorageServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryListStorageServer) Send(m *StorageItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_GetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNameParam)
 	if err := dec(in); err != nil {
@@ -526,6 +666,11 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_ListAccounts_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListStorage",
+			Handler:       _Query_ListStorage_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListNames",
 			Handler:       _Query_ListNames_Handler,
@@ -612,6 +757,110 @@ func (m *ListAccountsParam) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
 		i += copy(dAtA[i:], m.Query)
 	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Start.Size()))
+	n2, err := m.Start.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Limit))
+	}
+	if m.Filter != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Filter.Size()))
+		n3, err := m.Filter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *ListStorageParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStorageParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Address.Size()))
+	n4, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.KeyPrefix.Size()))
+	n5, err := m.KeyPrefix.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Start.Size()))
+	n6, err := m.Start.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *StorageItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Key.Size()))
+	n7, err := m.Key.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Value.Size()))
+	n8, err := m.Value.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
 	return i, nil
 }
 
@@ -660,6 +909,17 @@ func (m *ListNamesParam) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
 		i += copy(dAtA[i:], m.Query)
 	}
+	if len(m.Start) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Start)))
+		i += copy(dAtA[i:], m.Start)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Limit))
+	}
 	return i, nil
 }
 
@@ -806,6 +1066,40 @@ func (m *ListAccountsParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	l = m.Start.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListStorageParam) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.KeyPrefix.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Start.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *StorageItem) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
 	return n
 }
 
@@ -826,6 +1120,13 @@ func (m *ListNamesParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRpcquery(uint64(m.Limit))
+	}
 	return n
 }
 
@@ -1130,6 +1431,88 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &rpc.AccountFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -1151,7 +1534,7 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNameParam) Unmarshal(dAtA []byte) error {
+func (m *ListStorageParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,17 +1557,17 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNameParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListStorageParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListStorageParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -1194,7 +1577,276 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNameParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNameParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1288,6 +1940,54 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -1700,40 +2400,51 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptorRpcquery) }
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptorRpcquery) }
 
 var fileDescriptorRpcquery = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x8e, 0xd2, 0x40,
-	0x18, 0xb5, 0xb0, 0xc2, 0xf2, 0x41, 0x20, 0x3b, 0x22, 0xc1, 0x6a, 0xba, 0x9b, 0x5e, 0x10, 0x34,
-	0xda, 0x12, 0x5c, 0xbd, 0xd3, 0xb8, 0xac, 0x06, 0xd7, 0x98, 0x8d, 0x16, 0xa3, 0x89, 0x77, 0x65,
-	0x18, 0xa1, 0xb1, 0xed, 0xd4, 0xe9, 0x54, 0xc3, 0x0b, 0xf8, 0x5c, 0x5e, 0x72, 0xe9, 0xb5, 0x17,
-	0x1b, 0xc3, 0xbe, 0x81, 0x4f, 0x60, 0x3a, 0x9d, 0xd2, 0xf2, 0x13, 0xee, 0xbe, 0xf3, 0xcd, 0x39,
-	0xdf, 0xe9, 0xcc, 0x9c, 0x29, 0xd4, 0x59, 0x80, 0xbf, 0x45, 0x84, 0xcd, 0x8d, 0x80, 0x51, 0x4e,
-	0xd1, 0x61, 0x8a, 0xd5, 0x47, 0x53, 0x87, 0xcf, 0xa2, 0xb1, 0x81, 0xa9, 0x67, 0x4e, 0xe9, 0x94,
-	0x9a, 0x82, 0x30, 0x8e, 0xbe, 0x08, 0x24, 0x80, 0xa8, 0x12, 0xa1, 0x5a, 0xf5, 0x6d, 0x8f, 0x84,
-	0x12, 0x54, 0x6c, 0xec, 0xc9, 0xb2, 0xf1, 0xdd, 0x76, 0x9d, 0x89, 0xcd, 0x29, 0x4b, 0xd7, 0x58,
-	0x80, 0x93, 0x52, 0x77, 0xa0, 0x3a, 0xe2, 0x36, 0x8f, 0xc2, 0x77, 0x36, 0xb3, 0x3d, 0xd4, 0x85,
-	0xc6, 0xc0, 0xa5, 0xf8, 0xeb, 0x07, 0xc7, 0x23, 0x9f, 0x1c, 0x3e, 0x73, 0xfc, 0xb6, 0x72, 0xa2,
-	0x74, 0x2b, 0xd6, 0x66, 0x1b, 0xf5, 0xe0, 0x96, 0x68, 0x8d, 0x08, 0xf1, 0x73, 0xec, 0x82, 0x60,
-	0xef, 0x5a, 0xd2, 0x6d, 0x68, 0x0c, 0x09, 0x3f, 0xc3, 0x98, 0x46, 0x3e, 0x4f, 0xec, 0x2e, 0xa1,
-	0x7c, 0x36, 0x99, 0x30, 0x12, 0x86, 0xc2, 0xa6, 0x36, 0x38, 0x5d, 0x5c, 0x1d, 0xdf, 0xf8, 0x73,
-	0x75, 0xfc, 0x30, 0xb7, 0xf3, 0xd9, 0x3c, 0x20, 0xcc, 0x25, 0x93, 0x29, 0x61, 0xe6, 0x38, 0x62,
-	0x8c, 0xfe, 0x30, 0x31, 0x9b, 0x07, 0x9c, 0x1a, 0x52, 0x6b, 0xa5, 0x43, 0xf4, 0xfb, 0x70, 0xf4,
-	0xd6, 0x09, 0x53, 0x0f, 0xb9, 0xa7, 0x26, 0xdc, 0x7c, 0x1f, 0x1f, 0xa7, 0xdc, 0x49, 0x02, 0x74,
-	0x1d, 0x6a, 0x43, 0xc2, 0x2f, 0x6d, 0x8f, 0x24, 0x2c, 0x04, 0x07, 0x31, 0x90, 0x24, 0x51, 0xeb,
-	0x1d, 0xa8, 0xc7, 0xe3, 0xe2, 0x7a, 0xef, 0xac, 0xe7, 0xd0, 0x1c, 0x12, 0xfe, 0x31, 0x3d, 0xe5,
-	0x11, 0x91, 0xdb, 0xeb, 0x40, 0xfd, 0xc2, 0xc7, 0x6e, 0x34, 0x21, 0xaf, 0x9d, 0x90, 0x53, 0x29,
-	0x3b, 0xb4, 0x36, 0xba, 0xfa, 0x4f, 0x05, 0x6a, 0x79, 0x35, 0x6a, 0x41, 0x69, 0x46, 0x9c, 0xe9,
-	0x8c, 0x0b, 0xc1, 0x81, 0x25, 0x11, 0xea, 0x40, 0x71, 0x44, 0x78, 0xbb, 0x70, 0x52, 0xec, 0x56,
-	0xfb, 0x4d, 0x23, 0xbb, 0xd7, 0x95, 0xda, 0x8a, 0x09, 0xe8, 0x29, 0x94, 0x53, 0xc7, 0xa2, 0xe0,
-	0xde, 0x33, 0x56, 0x21, 0xcb, 0x1b, 0xbd, 0x24, 0x2e, 0xb7, 0x43, 0x2b, 0x25, 0xeb, 0x6f, 0x00,
-	0x6d, 0x2f, 0xa3, 0x53, 0x80, 0x55, 0x37, 0xdc, 0x6b, 0x9e, 0xe3, 0xf5, 0xff, 0x15, 0xe4, 0x59,
-	0xa1, 0x3e, 0x94, 0x92, 0x8c, 0xa1, 0xdb, 0xd9, 0x67, 0xe4, 0x52, 0xa7, 0x1e, 0xc5, 0x6d, 0xc3,
-	0x22, 0x61, 0xe4, 0x72, 0xc9, 0x7c, 0x06, 0x90, 0x85, 0x05, 0xdd, 0xc9, 0x74, 0x1b, 0x11, 0x52,
-	0x9b, 0x46, 0x1c, 0xf4, 0x73, 0xea, 0x63, 0x46, 0x38, 0x49, 0x05, 0xe7, 0x50, 0xcb, 0x07, 0x01,
-	0xdd, 0xcd, 0x06, 0x6c, 0x05, 0x64, 0xf7, 0x88, 0x9e, 0x82, 0x4c, 0x28, 0xcb, 0x88, 0xa0, 0xd6,
-	0xda, 0x07, 0xac, 0x52, 0xa3, 0xd6, 0x8c, 0xe4, 0xcd, 0xbd, 0xf2, 0x39, 0x9b, 0xa3, 0x27, 0x50,
-	0x59, 0xe5, 0x05, 0xb5, 0xd7, 0x2d, 0xb3, 0x10, 0xad, 0x8b, 0x7a, 0x0a, 0xba, 0x10, 0x0f, 0x63,
-	0x2d, 0x00, 0xda, 0x9a, 0xdf, 0x56, 0xb2, 0xd4, 0xd6, 0xee, 0xfb, 0x1c, 0xbc, 0x58, 0x2c, 0x35,
-	0xe5, 0xf7, 0x52, 0x53, 0xfe, 0x2e, 0x35, 0xe5, 0xd7, 0xb5, 0xa6, 0x2c, 0xae, 0x35, 0xe5, 0xf3,
-	0x83, 0xfd, 0xaf, 0x89, 0x05, 0xd8, 0x4c, 0xc7, 0x8d, 0x4b, 0xe2, 0xbf, 0xf0, 0xf8, 0x7f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xce, 0xc2, 0x7a, 0x96, 0x04, 0x00, 0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0xa6, 0x49, 0xd3, 0xe6, 0x26, 0x6a, 0xbf, 0x0e, 0xa1, 0x0a, 0x06, 0xa5, 0x95, 0x17,
+	0x55, 0x54, 0x51, 0x27, 0x0a, 0x2d, 0x3b, 0x10, 0x4d, 0x81, 0xf4, 0x4f, 0x55, 0x71, 0x50, 0x91,
+	0xd8, 0x39, 0xce, 0x6d, 0x32, 0xc2, 0x3f, 0x61, 0x3c, 0x86, 0xfa, 0x05, 0x78, 0x08, 0xb6, 0xbc,
+	0x03, 0x6c, 0x59, 0x76, 0xc9, 0x9a, 0x45, 0x85, 0xda, 0x17, 0x41, 0xb6, 0xc7, 0x89, 0x93, 0x56,
+	0xd9, 0x44, 0xec, 0xe6, 0xce, 0xdc, 0x7b, 0xce, 0xdc, 0xeb, 0x33, 0xc7, 0xb0, 0xc4, 0x07, 0xe6,
+	0x47, 0x1f, 0x79, 0xa0, 0x0d, 0xb8, 0x2b, 0x5c, 0xba, 0x98, 0xc4, 0xca, 0x56, 0x8f, 0x89, 0xbe,
+	0xdf, 0xd1, 0x4c, 0xd7, 0xae, 0xf5, 0xdc, 0x9e, 0x5b, 0x8b, 0x12, 0x3a, 0xfe, 0x79, 0x14, 0x45,
+	0x41, 0xb4, 0x8a, 0x0b, 0x95, 0x82, 0x63, 0xd8, 0xe8, 0xc9, 0x20, 0x6f, 0x98, 0xb6, 0x5c, 0x2e,
+	0x7f, 0x32, 0x2c, 0xd6, 0x35, 0x84, 0xcb, 0x93, 0x33, 0x3e, 0x30, 0xe3, 0xa5, 0xca, 0xa0, 0xd0,
+	0x16, 0x86, 0xf0, 0xbd, 0x53, 0x83, 0x1b, 0x36, 0xad, 0xc2, 0x72, 0xd3, 0x72, 0xcd, 0x0f, 0x6f,
+	0x99, 0x8d, 0xef, 0x98, 0xe8, 0x33, 0xa7, 0x4c, 0xd6, 0x49, 0x35, 0xaf, 0x4f, 0x6e, 0xd3, 0x3a,
+	0xdc, 0x8b, 0xb6, 0xda, 0x88, 0x4e, 0x2a, 0x7b, 0x2e, 0xca, 0xbe, 0xeb, 0x48, 0x35, 0x60, 0xb9,
+	0x85, 0x62, 0xd7, 0x34, 0x5d, 0xdf, 0x11, 0x31, 0xdd, 0x09, 0x2c, 0xec, 0x76, 0xbb, 0x1c, 0x3d,
+	0x2f, 0xa2, 0x29, 0x36, 0xb7, 0x2f, 0xaf, 0xd6, 0xfe, 0xfb, 0x7d, 0xb5, 0xf6, 0x38, 0xd5, 0x79,
+	0x3f, 0x18, 0x20, 0xb7, 0xb0, 0xdb, 0x43, 0x5e, 0xeb, 0xf8, 0x9c, 0xbb, 0x9f, 0x6b, 0x26, 0x0f,
+	0x06, 0xc2, 0xd5, 0x64, 0xad, 0x9e, 0x80, 0xa8, 0x3f, 0x08, 0xac, 0x1c, 0x33, 0x2f, 0x21, 0x91,
+	0x4d, 0x95, 0x60, 0xfe, 0x4d, 0x38, 0x4f, 0xd9, 0x4a, 0x1c, 0xd0, 0x43, 0x98, 0x6f, 0x0b, 0x83,
+	0x8b, 0xf2, 0xdc, 0x0c, 0xcc, 0x31, 0x44, 0xc8, 0x70, 0xcc, 0x6c, 0x26, 0xca, 0x99, 0x75, 0x52,
+	0xcd, 0xea, 0x71, 0x40, 0x37, 0x21, 0xf7, 0x9a, 0x59, 0x02, 0x79, 0x39, 0xbb, 0x4e, 0xaa, 0x85,
+	0x06, 0xd5, 0xc2, 0xb9, 0xcb, 0xbb, 0xc5, 0x27, 0xba, 0xcc, 0x50, 0xbf, 0xce, 0xc1, 0xff, 0xe1,
+	0xcd, 0xdb, 0xc2, 0xe5, 0x46, 0x0f, 0xff, 0xc9, 0x78, 0x68, 0x1b, 0xf2, 0x47, 0x18, 0x9c, 0x72,
+	0x3c, 0x67, 0x17, 0xb2, 0xed, 0x1d, 0x89, 0xb8, 0x35, 0x1d, 0xb1, 0xc3, 0x1c, 0x83, 0x07, 0xda,
+	0x3e, 0x5e, 0x34, 0x03, 0x81, 0x9e, 0x3e, 0xc2, 0xa1, 0x47, 0xc9, 0x1c, 0x33, 0xb3, 0x00, 0x4e,
+	0x0e, 0x32, 0x9b, 0x1a, 0xa4, 0xfa, 0x8d, 0x40, 0x41, 0x0e, 0xe6, 0x40, 0xa0, 0x4d, 0x5b, 0x90,
+	0x39, 0xc2, 0xa0, 0x4c, 0x66, 0x21, 0x0c, 0x11, 0xc2, 0xbb, 0x9f, 0x19, 0x96, 0x8f, 0xb3, 0x0d,
+	0x23, 0xc6, 0x50, 0x55, 0x28, 0xb6, 0x50, 0x9c, 0x18, 0xb6, 0xfc, 0x7a, 0x14, 0xb2, 0x61, 0x20,
+	0x55, 0x17, 0xad, 0x55, 0x1d, 0x96, 0xc2, 0xaf, 0x1c, 0xae, 0xa7, 0x8a, 0xb3, 0x94, 0x16, 0x67,
+	0x7e, 0xaa, 0xcc, 0xd4, 0xe7, 0x50, 0x6a, 0xa1, 0x38, 0x4b, 0xde, 0x78, 0x1b, 0xe5, 0xe3, 0xda,
+	0x80, 0xa5, 0x03, 0xc7, 0xb4, 0xfc, 0x2e, 0xee, 0x33, 0x4f, 0xb8, 0x92, 0x62, 0x51, 0x9f, 0xd8,
+	0x55, 0xbf, 0x10, 0x28, 0xa6, 0xab, 0xe9, 0x2a, 0xe4, 0xfa, 0xc8, 0x7a, 0x7d, 0x11, 0x15, 0x64,
+	0x75, 0x19, 0xd1, 0x0d, 0xc8, 0xb4, 0x31, 0xbc, 0x52, 0xa6, 0x5a, 0x68, 0x94, 0xb4, 0x91, 0xab,
+	0x0c, 0xab, 0xf5, 0x30, 0x81, 0x3e, 0x85, 0x85, 0x84, 0x31, 0x13, 0xe5, 0x3e, 0xd2, 0x86, 0x16,
+	0x97, 0x26, 0x7a, 0x89, 0x96, 0x30, 0x3c, 0x3d, 0x49, 0x56, 0x0f, 0x81, 0xde, 0x3e, 0xa6, 0xdb,
+	0x00, 0xc3, 0x5d, 0x6f, 0x2a, 0x79, 0x2a, 0xaf, 0xf1, 0x3d, 0x23, 0xe7, 0x4a, 0x1b, 0x90, 0x8b,
+	0x1d, 0x8e, 0xde, 0x1f, 0x5d, 0x23, 0xe5, 0x79, 0xca, 0x4a, 0xb8, 0xad, 0xe9, 0xe8, 0xf9, 0x96,
+	0x90, 0x99, 0xcf, 0x00, 0x46, 0x56, 0x45, 0x1f, 0x8c, 0xea, 0x26, 0x0c, 0x4c, 0x29, 0x69, 0xa1,
+	0xcd, 0xee, 0xb9, 0x8e, 0xc9, 0x51, 0x60, 0x52, 0xb0, 0x07, 0xc5, 0xb4, 0x0b, 0xd1, 0x87, 0x23,
+	0x80, 0x5b, 0xee, 0x74, 0x37, 0x44, 0x9d, 0xd0, 0x26, 0x14, 0x52, 0x86, 0x40, 0x95, 0x71, 0x8c,
+	0xb4, 0x4f, 0x28, 0x63, 0x8d, 0x0d, 0x9f, 0x49, 0x9d, 0xd0, 0x1a, 0x2c, 0x48, 0x49, 0xd2, 0xd5,
+	0xb1, 0x26, 0x86, 0x2a, 0x55, 0x8a, 0x5a, 0xfc, 0xd7, 0x78, 0xe5, 0x08, 0x1e, 0xd0, 0x1d, 0xc8,
+	0x0f, 0xf5, 0x49, 0xcb, 0xe3, 0x94, 0x23, 0xd1, 0x8e, 0x17, 0xd5, 0x09, 0x3d, 0x88, 0xac, 0x7d,
+	0x4c, 0x44, 0x95, 0x31, 0xbe, 0x5b, 0xea, 0x54, 0x56, 0xef, 0xd6, 0x44, 0xf3, 0xc5, 0xe5, 0x75,
+	0x85, 0xfc, 0xba, 0xae, 0x90, 0x3f, 0xd7, 0x15, 0xf2, 0xf3, 0xa6, 0x42, 0x2e, 0x6f, 0x2a, 0xe4,
+	0xfd, 0xe6, 0xf4, 0x17, 0xc9, 0x07, 0x66, 0x2d, 0x81, 0xeb, 0xe4, 0xa2, 0x3f, 0xdb, 0x93, 0xbf,
+	0x03, 0x00, 0x92, 0x2f, 0xd3, 0xb3, 0x58, 0x07, 0x00, 0x00,
 }
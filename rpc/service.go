@@ -15,6 +15,7 @@
 package rpc
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -49,19 +50,21 @@ type Service struct {
 	nameReg    names.IterableReader
 	blockchain bcm.BlockchainInfo
 	nodeView   *tendermint.NodeView
+	limiter    *Limiter
 	logger     *logging.Logger
 }
 
 // Service provides an internal query and information service with serialisable return types on which can accomodate
 // a number of transport front ends
 func NewService(state state.IterableReader, nameReg names.IterableReader, blockchain bcm.BlockchainInfo,
-	nodeView *tendermint.NodeView, logger *logging.Logger) *Service {
+	nodeView *tendermint.NodeView, limiter *Limiter, logger *logging.Logger) *Service {
 
 	return &Service{
 		state:      state,
 		nameReg:    nameReg,
 		blockchain: blockchain,
 		nodeView:   nodeView,
+		limiter:    limiter,
 		logger:     logger.With(structure.ComponentKey, "Service"),
 	}
 }
@@ -74,6 +77,11 @@ func (s *Service) BlockchainInfo() bcm.BlockchainInfo {
 	return s.blockchain
 }
 
+// Limiter returns the limits imposed on the service's clients, which may be nil if there are none
+func (s *Service) Limiter() *Limiter {
+	return s.limiter
+}
+
 func (s *Service) ChainID() string {
 	return s.blockchain.ChainID()
 }
@@ -156,17 +164,51 @@ func (s *Service) Account(address crypto.Address) (*ResultAccount, error) {
 }
 
 func (s *Service) Accounts(predicate func(acm.Account) bool) (*ResultAccounts, error) {
+	return s.accountsPage(crypto.Address{}, 0, predicate)
+}
+
+// ListAccounts returns up to limit accounts selected by filter in address order beginning at start. NextStart is set
+// in the result if there are further accounts.
+func (s *Service) ListAccounts(start crypto.Address, limit uint64, filter *AccountFilter) (*ResultAccounts, error) {
+	predicate, err := filter.Matcher()
+	if err != nil {
+		return nil, err
+	}
+	return s.accountsPage(start, limit, predicate)
+}
+
+func (s *Service) accountsPage(start crypto.Address, limit uint64,
+	predicate func(acm.Account) bool) (*ResultAccounts, error) {
 	accounts := make([]*acm.ConcreteAccount, 0)
-	s.state.IterateAccounts(func(account acm.Account) (stop bool) {
+	page := s.limiter.NewPage(InfoMethodPrefix+"accounts", limit)
+	var nextStart *crypto.Address
+	var pageErr error
+	_, err := s.state.IterateAccountsFrom(start, func(account acm.Account) (stop bool) {
 		if predicate(account) {
+			var ok bool
+			ok, pageErr = page.Add()
+			if !ok {
+				if pageErr == nil {
+					address := account.Address()
+					nextStart = &address
+				}
+				return true
+			}
 			accounts = append(accounts, acm.AsConcreteAccount(account))
 		}
 		return
 	})
+	if err != nil {
+		return nil, err
+	}
+	if pageErr != nil {
+		return nil, pageErr
+	}
 
 	return &ResultAccounts{
 		BlockHeight: s.blockchain.LastBlockHeight(),
 		Accounts:    accounts,
+		NextStart:   nextStart,
 	}, nil
 }
 
@@ -189,7 +231,10 @@ func (s *Service) Storage(address crypto.Address, key []byte) (*ResultStorage, e
 	return &ResultStorage{Key: key, Value: value.UnpadLeft()}, nil
 }
 
-func (s *Service) DumpStorage(address crypto.Address) (*ResultDumpStorage, error) {
+// DumpStorage returns up to limit storage items of the account at address with keys beginning with keyPrefix in key
+// order from start. NextKey is set in the result if there are further items.
+func (s *Service) DumpStorage(address crypto.Address, keyPrefix, start binary.HexBytes,
+	limit uint64) (*ResultDumpStorage, error) {
 	account, err := s.state.GetAccount(address)
 	if err != nil {
 		return nil, err
@@ -197,16 +242,45 @@ func (s *Service) DumpStorage(address crypto.Address) (*ResultDumpStorage, error
 	if account == nil {
 		return nil, fmt.Errorf("UnknownAddress: %X", address)
 	}
+	rangeStart, rangeEnd := StorageRange(keyPrefix, start)
 	var storageItems []StorageItem
-	s.state.IterateStorage(address, func(key, value binary.Word256) (stop bool) {
+	var nextKey binary.HexBytes
+	page := s.limiter.NewPage(InfoMethodPrefix+"dump_storage", limit)
+	var pageErr error
+	_, err = s.state.IterateStorageRange(address, rangeStart, rangeEnd, func(key, value binary.Word256) (stop bool) {
+		var ok bool
+		ok, pageErr = page.Add()
+		if !ok {
+			if pageErr == nil {
+				nextKey = key.Bytes()
+			}
+			return true
+		}
 		storageItems = append(storageItems, StorageItem{Key: key.UnpadLeft(), Value: value.UnpadLeft()})
 		return
 	})
+	if err != nil {
+		return nil, err
+	}
+	if pageErr != nil {
+		return nil, pageErr
+	}
 	return &ResultDumpStorage{
 		StorageItems: storageItems,
+		NextKey:      nextKey,
 	}, nil
 }
 
+// StorageRange returns the range of storage keys beginning with keyPrefix from start (inclusive) for use with
+// IterateStorageRange
+func StorageRange(keyPrefix, start []byte) (rangeStart, rangeEnd []byte) {
+	rangeStart, rangeEnd = state.KeyPrefixRange(keyPrefix)
+	if bytes.Compare(start, rangeStart) > 0 {
+		rangeStart = start
+	}
+	return
+}
+
 func (s *Service) AccountHumanReadable(address crypto.Address) (*ResultAccountHumanReadable, error) {
 	acc, err := s.state.GetAccount(address)
 	if err != nil {
@@ -250,12 +324,20 @@ func (s *Service) Name(name string) (*ResultName, error) {
 
 func (s *Service) Names(predicate func(*names.Entry) bool) (*ResultNames, error) {
 	var nms []*names.Entry
+	page := s.limiter.NewPage(InfoMethodPrefix+"names", 0)
+	var err error
 	s.nameReg.IterateNames(func(entry *names.Entry) (stop bool) {
 		if predicate(entry) {
+			if _, err = page.Add(); err != nil {
+				return true
+			}
 			nms = append(nms, entry)
 		}
 		return
 	})
+	if err != nil {
+		return nil, err
+	}
 	return &ResultNames{
 		BlockHeight: s.blockchain.LastBlockHeight(),
 		Names:       nms,
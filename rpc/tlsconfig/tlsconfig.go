@@ -0,0 +1,185 @@
+// Package tlsconfig builds TLS configuration for Burrow's servers and their clients from config
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// The ALPN protocol GRPC negotiates
+const GRPCProtocol = "h2"
+
+type ServerConfig struct {
+	Enabled  bool
+	CertFile string
+	KeyFile  string
+	// If set clients must present a certificate signed by one of the CAs in this PEM file
+	ClientCAFile string `json:",omitempty" toml:",omitempty"`
+	// One of 1.0, 1.1, 1.2, 1.3 (defaults to 1.2)
+	MinVersion string `json:",omitempty" toml:",omitempty"`
+}
+
+type ClientConfig struct {
+	Enabled bool
+	// PEM file of CAs used to verify the server, the system roots are used if empty
+	CAFile string `json:",omitempty" toml:",omitempty"`
+	// Certificate and key presented to servers requiring client certificates
+	CertFile string `json:",omitempty" toml:",omitempty"`
+	KeyFile  string `json:",omitempty" toml:",omitempty"`
+	// Overrides the name the server certificate is verified against (defaults to the host dialled)
+	ServerName string `json:",omitempty" toml:",omitempty"`
+	// One of 1.0, 1.1, 1.2, 1.3 (defaults to 1.2)
+	MinVersion string `json:",omitempty" toml:",omitempty"`
+}
+
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{
+		Enabled: false,
+	}
+}
+
+// Server holds the certificates for a server listener so they can be reloaded from disk without restarting it
+type Server struct {
+	sync.RWMutex
+	conf   *ServerConfig
+	config *tls.Config
+}
+
+// NewServer loads the server certificates described by conf returning nil if conf is nil or not enabled
+func NewServer(conf *ServerConfig) (*Server, error) {
+	if conf == nil || !conf.Enabled {
+		return nil, nil
+	}
+	server := &Server{conf: conf}
+	err := server.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// Reload reads the certificate, key, and client CAs from disk again. The new certificates are used for subsequent
+// connections. If they cannot be read the existing certificates remain in use.
+func (s *Server) Reload() error {
+	minVersion, err := parseVersion(s.conf.MinVersion)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(s.conf.CertFile, s.conf.KeyFile)
+	if err != nil {
+		return fmt.Errorf("could not load TLS certificate: %v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   minVersion,
+	}
+	if s.conf.ClientCAFile != "" {
+		config.ClientCAs, err = loadCertPool(s.conf.ClientCAFile)
+		if err != nil {
+			return err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.config = config
+	return nil
+}
+
+// TLSConfig returns a config that uses whatever certificates were most recently loaded for each handshake, or nil
+// for a nil Server. nextProtos are the ALPN protocols to offer.
+func (s *Server) TLSConfig(nextProtos ...string) *tls.Config {
+	if s == nil {
+		return nil
+	}
+	return &tls.Config{
+		NextProtos: nextProtos,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			s.RLock()
+			defer s.RUnlock()
+			config := s.config.Clone()
+			config.NextProtos = nextProtos
+			return config, nil
+		},
+	}
+}
+
+// GRPCServerOption returns the option needed to serve GRPC over TLS
+func (s *Server) GRPCServerOption() grpc.ServerOption {
+	return grpc.Creds(credentials.NewTLS(s.TLSConfig(GRPCProtocol)))
+}
+
+// TLSConfig returns the client TLS config described by conf or nil if conf is nil or not enabled
+func (conf *ClientConfig) TLSConfig() (*tls.Config, error) {
+	if conf == nil || !conf.Enabled {
+		return nil, nil
+	}
+	minVersion, err := parseVersion(conf.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		ServerName: conf.ServerName,
+		MinVersion: minVersion,
+	}
+	if conf.CAFile != "" {
+		config.RootCAs, err = loadCertPool(conf.CAFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load TLS client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// GRPCDialOption returns the transport security option for dialling a GRPC server, which is plaintext if conf is nil
+// or not enabled
+func (conf *ClientConfig) GRPCDialOption() (grpc.DialOption, error) {
+	config, err := conf.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return grpc.WithInsecure(), nil
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	bs, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA file: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bs) {
+		return nil, fmt.Errorf("no PEM certificates found in CA file %s", caFile)
+	}
+	return pool, nil
+}
+
+func parseVersion(version string) (uint16, error) {
+	switch version {
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("unknown TLS version '%s', should be one of 1.0, 1.1, 1.2, 1.3", version)
+	}
+}
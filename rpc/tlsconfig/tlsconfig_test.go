@@ -0,0 +1,197 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Reload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsconfig")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newCA(t, dir, "ca")
+	ca.issue(t, dir, "server", "127.0.0.1")
+	conf := &ServerConfig{
+		Enabled:  true,
+		CertFile: filepath.Join(dir, "server.crt"),
+		KeyFile:  filepath.Join(dir, "server.key"),
+	}
+	server, err := NewServer(conf)
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", server.TLSConfig())
+	require.NoError(t, err)
+	defer listener.Close()
+	go acceptHandshakes(listener)
+
+	clientConf := &ClientConfig{Enabled: true, CAFile: filepath.Join(dir, "ca.crt")}
+	serial := serverSerial(t, listener.Addr().String(), clientConf)
+
+	// Replace the certificate on disk and reload
+	ca.issue(t, dir, "server", "127.0.0.1")
+	assert.Equal(t, serial, serverSerial(t, listener.Addr().String(), clientConf))
+	require.NoError(t, server.Reload())
+	assert.NotEqual(t, serial, serverSerial(t, listener.Addr().String(), clientConf))
+
+	// A failed reload leaves the existing certificate in place
+	conf.KeyFile = filepath.Join(dir, "nonexistent.key")
+	require.Error(t, server.Reload())
+	serverSerial(t, listener.Addr().String(), clientConf)
+}
+
+func TestServer_ClientCA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsconfig")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newCA(t, dir, "ca")
+	ca.issue(t, dir, "server", "127.0.0.1")
+	ca.issue(t, dir, "client", "")
+	server, err := NewServer(&ServerConfig{
+		Enabled:      true,
+		CertFile:     filepath.Join(dir, "server.crt"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+		MinVersion:   "1.2",
+	})
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", server.TLSConfig())
+	require.NoError(t, err)
+	defer listener.Close()
+	go acceptHandshakes(listener)
+
+	clientConf := &ClientConfig{Enabled: true, CAFile: filepath.Join(dir, "ca.crt")}
+	_, err = handshake(listener.Addr().String(), clientConf)
+	assert.Error(t, err, "should require client certificate")
+
+	clientConf.CertFile = filepath.Join(dir, "client.crt")
+	clientConf.KeyFile = filepath.Join(dir, "client.key")
+	_, err = handshake(listener.Addr().String(), clientConf)
+	assert.NoError(t, err)
+}
+
+func TestDisabled(t *testing.T) {
+	server, err := NewServer(DefaultServerConfig())
+	require.NoError(t, err)
+	assert.Nil(t, server)
+	assert.Nil(t, server.TLSConfig())
+
+	var clientConf *ClientConfig
+	config, err := clientConf.TLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	_, err = NewServer(&ServerConfig{Enabled: true, MinVersion: "2.0"})
+	assert.Error(t, err)
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newCA(t *testing.T, dir, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := certTemplate(name)
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, dir, name, ip string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := certTemplate(name)
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	if ip != "" {
+		template.IPAddresses = []net.IP{net.ParseIP(ip)}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, name+".key"), "EC PRIVATE KEY", keyDER)
+}
+
+func certTemplate(name string) *x509.Certificate {
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+}
+
+func writePEM(t *testing.T, filename, typ string, der []byte) {
+	require.NoError(t, ioutil.WriteFile(filename, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600))
+}
+
+func acceptHandshakes(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			conn.(*tls.Conn).Handshake()
+			// Give the client a chance to observe the handshake result
+			conn.Read(make([]byte, 1))
+			conn.Close()
+		}()
+	}
+}
+
+func handshake(addr string, clientConf *ClientConfig) (*tls.ConnectionState, error) {
+	config, err := clientConf.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := tls.Dial("tcp", addr, config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// Under TLS 1.3 a rejected client certificate is only reported on the first read
+	conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	_, err = conn.Read(make([]byte, 1))
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state := conn.ConnectionState()
+	return &state, nil
+}
+
+func serverSerial(t *testing.T, addr string, clientConf *ClientConfig) *big.Int {
+	state, err := handshake(addr, clientConf)
+	require.NoError(t, err)
+	return state.PeerCertificates[0].SerialNumber
+}
@@ -80,3 +80,39 @@ func TestAminoTxEnvelope(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, txEnv, txEnvOut)
 }
+
+func TestAggregateSignatureEnvelope(t *testing.T) {
+	codec := NewAminoCodec()
+	var signers []acm.AddressableSigner
+	var inputs []*payload.TxInput
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeBLS12381, crypto.CurveTypeEd25519,
+		crypto.CurveTypeBLS12381, crypto.CurveTypeBLS12381} {
+		privateKey, err := crypto.GeneratePrivateKey(nil, curveType)
+		require.NoError(t, err)
+		signer := acm.PrivateAccountFromPrivateKey(privateKey)
+		signers = append(signers, signer)
+		inputs = append(inputs, &payload.TxInput{Address: signer.Address(), Amount: 1, Sequence: 1})
+	}
+	txEnv := Enclose("testChain", &payload.SendTx{Inputs: inputs})
+	require.NoError(t, txEnv.Sign(signers...))
+	require.NotNil(t, txEnv.AggregateSignature)
+	assert.Empty(t, txEnv.Signatories[0].Signature.Signature)
+	assert.NotEmpty(t, txEnv.Signatories[1].Signature.Signature)
+	require.NoError(t, txEnv.Verify(nil, "testChain"))
+
+	bs, err := codec.EncodeTx(txEnv)
+	require.NoError(t, err)
+	txEnvOut, err := codec.DecodeTx(bs)
+	require.NoError(t, err)
+	assert.Equal(t, txEnv.Signatories, txEnvOut.Signatories)
+	assert.Equal(t, txEnv.AggregateSignature, txEnvOut.AggregateSignature)
+	require.NoError(t, txEnvOut.Verify(nil, "testChain"))
+
+	// Aggregate must cover every BLS signatory without its own signature
+	signBytes, err := txEnv.Tx.SignBytes()
+	require.NoError(t, err)
+	partial, err := signers[0].Sign(signBytes)
+	require.NoError(t, err)
+	txEnv.AggregateSignature = &partial
+	assert.Error(t, txEnv.Verify(nil, "testChain"))
+}
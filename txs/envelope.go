@@ -1,6 +1,7 @@
 package txs
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/hyperledger/burrow/acm"
@@ -37,7 +38,8 @@ func (txEnv *Envelope) String() string {
 
 // Attempts to 'realise' the PublicKey and Address of a Signatory possibly referring to state
 // in the case where the Signatory contains an Address by no PublicKey. Checks consistency in other
-// cases, possibly generating the Address from the PublicKey
+// cases, possibly generating the Address from the PublicKey. The PublicKey of an account that has
+// been rekeyed is the key bound to it rather than one from which its Address is derived.
 func (s *Signatory) RealisePublicKey(getter state.AccountGetter) error {
 	const errPrefix = "could not realise public key for signatory"
 	if s.PublicKey == nil {
@@ -48,18 +50,23 @@ func (s *Signatory) RealisePublicKey(getter state.AccountGetter) error {
 		if err != nil {
 			return fmt.Errorf("%s: could not get account %v: %v", errPrefix, *s.Address, err)
 		}
+		if acc == nil {
+			return fmt.Errorf("%s: account %v does not exist", errPrefix, *s.Address)
+		}
 		publicKey := acc.PublicKey()
 		s.PublicKey = &publicKey
 	}
 	if !s.PublicKey.IsValid() {
 		return fmt.Errorf("%s: public key %v is invalid", errPrefix, *s.PublicKey)
 	}
-	address := s.PublicKey.Address()
 	if s.Address == nil {
+		address := s.PublicKey.Address()
 		s.Address = &address
-	} else if address != *s.Address {
-		return fmt.Errorf("address %v provided with signatory does not match address generated from "+
-			"public key %v", *s.Address, address)
+		return nil
+	}
+	err := s.verifyAccountKey(getter)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errPrefix, err)
 	}
 	return nil
 }
@@ -91,8 +98,35 @@ func (sig *Signatory) Validate() error {
 	return nil
 }
 
+// Checks the Signatory's PublicKey is the key bound to its account. That is the key a RekeyTx last bound to the
+// account if getter knows of one, and otherwise the key from which the Address is derived under the chain's address
+// derivation (see crypto.SetAddressDerivation).
+func (s *Signatory) verifyAccountKey(getter state.AccountGetter) error {
+	if getter != nil {
+		acc, err := getter.GetAccount(*s.Address)
+		if err != nil {
+			return fmt.Errorf("could not get account %v to verify signatory: %v", *s.Address, err)
+		}
+		if acc != nil && acc.PublicKey().IsSet() {
+			publicKey := acc.PublicKey()
+			if publicKey.CurveType != s.PublicKey.CurveType || !bytes.Equal(publicKey.PublicKey, s.PublicKey.PublicKey) {
+				return fmt.Errorf("signatory public key %v is not the key %v bound to account %v",
+					*s.PublicKey, publicKey, *s.Address)
+			}
+			return nil
+		}
+	}
+	address := s.PublicKey.Address()
+	if address != *s.Address {
+		return fmt.Errorf("address %v of signatory does not match address %v derived from public key %v "+
+			"with %s address derivation", *s.Address, address, *s.PublicKey, crypto.AddressDerivation())
+	}
+	return nil
+}
+
 // Verifies the validity of the Signatories' Signatures in the Envelope. The Signatories must
-// appear in the same order as the inputs as returned by Tx.GetInputs().
+// appear in the same order as the inputs as returned by Tx.GetInputs(), and each must hold the
+// key bound to its account. Without a getter only keys from which addresses are derived are known.
 func (txEnv *Envelope) Verify(getter state.AccountGetter, chainID string) error {
 	err := txEnv.Validate()
 	if err != nil {
@@ -112,17 +146,69 @@ func (txEnv *Envelope) Verify(getter state.AccountGetter, chainID string) error
 	if err != nil {
 		return fmt.Errorf("%s: could not generate SignBytes: %v", errPrefix, err)
 	}
+	// BLS12-381 signatories without their own signature are covered by the aggregate signature
+	var aggregatedKeys []crypto.PublicKey
+	var aggregatedMsgs [][]byte
 	// Expect order to match (we could build lookup but we want Verify to be quicker than Sign which does order sigs)
 	for i, s := range txEnv.Signatories {
 		if inputs[i].Address != *s.Address {
 			return fmt.Errorf("signatory %v has address %v but input %v has address %v",
 				i, *s.Address, i, inputs[i].Address)
 		}
+		err = s.verifyAccountKey(getter)
+		if err != nil {
+			return fmt.Errorf("%s: %v", errPrefix, err)
+		}
+		if txEnv.AggregateSignature != nil && s.PublicKey.CurveType == crypto.CurveTypeBLS12381 &&
+			len(s.Signature.Signature) == 0 {
+			aggregatedKeys = append(aggregatedKeys, *s.PublicKey)
+			aggregatedMsgs = append(aggregatedMsgs, signBytes)
+			continue
+		}
 		err = s.PublicKey.Verify(signBytes, s.Signature)
 		if err != nil {
 			return fmt.Errorf("invalid signature in signatory %v: %v", *s.Address, err)
 		}
 	}
+	if txEnv.AggregateSignature != nil {
+		if len(aggregatedKeys) == 0 {
+			return fmt.Errorf("%s: envelope has an aggregate signature but no BLS12-381 signatories without "+
+				"their own signature", errPrefix)
+		}
+		err = crypto.VerifyAggregateSignature(aggregatedKeys, aggregatedMsgs, *txEnv.AggregateSignature)
+		if err != nil {
+			return fmt.Errorf("%s: invalid aggregate signature: %v", errPrefix, err)
+		}
+	}
+	return nil
+}
+
+// Aggregate replaces the individual signatures of any BLS12-381 signatories with a single aggregate signature so that
+// they can be checked together. It does nothing unless there are at least two such signatories.
+func (txEnv *Envelope) Aggregate() error {
+	var sigs []crypto.Signature
+	var indices []int
+	for i, s := range txEnv.Signatories {
+		if s.Signature.CurveType == crypto.CurveTypeBLS12381 && len(s.Signature.Signature) > 0 {
+			sigs = append(sigs, s.Signature)
+			indices = append(indices, i)
+		}
+	}
+	if txEnv.AggregateSignature != nil {
+		// Fold in an existing aggregate
+		sigs = append(sigs, *txEnv.AggregateSignature)
+	}
+	if len(sigs) < 2 {
+		return nil
+	}
+	aggregate, err := crypto.AggregateSignatures(sigs...)
+	if err != nil {
+		return err
+	}
+	for _, i := range indices {
+		txEnv.Signatories[i].Signature = crypto.Signature{}
+	}
+	txEnv.AggregateSignature = &aggregate
 	return nil
 }
 
@@ -131,6 +217,7 @@ func (txEnv *Envelope) Verify(getter state.AccountGetter, chainID string) error
 func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	// Clear any existing
 	txEnv.Signatories = nil
+	txEnv.AggregateSignature = nil
 	signBytes, err := txEnv.Tx.SignBytes()
 	if err != nil {
 		return err
@@ -157,7 +244,56 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 			Signature: sig,
 		})
 	}
-	return nil
+	return txEnv.Aggregate()
+}
+
+// AddSignatures adds Signatories for the inputs belonging to signingAccounts, replacing any previous signature by the
+// same input. Unlike Sign it does not require every input to be signed so that an Envelope can be passed between
+// signatories who each add their own signature.
+func (txEnv *Envelope) AddSignatures(signingAccounts ...acm.AddressableSigner) error {
+	signBytes, err := txEnv.Tx.SignBytes()
+	if err != nil {
+		return err
+	}
+	inputs := txEnv.Tx.GetInputs()
+	signatories := make(map[crypto.Address]Signatory, len(inputs))
+	for _, s := range txEnv.Signatories {
+		if s.Address != nil {
+			signatories[*s.Address] = s
+		}
+	}
+	for _, sa := range signingAccounts {
+		address := sa.Address()
+		isInput := false
+		for _, in := range inputs {
+			isInput = isInput || in.Address == address
+		}
+		if !isInput {
+			return fmt.Errorf("account %v is not an input of transaction %X", address, txEnv.Tx.Hash())
+		}
+		if previous, ok := signatories[address]; ok && txEnv.AggregateSignature != nil &&
+			len(previous.Signature.Signature) == 0 {
+			return fmt.Errorf("cannot replace the signature of %v since it has been aggregated", address)
+		}
+		sig, err := sa.Sign(signBytes)
+		if err != nil {
+			return err
+		}
+		publicKey := sa.PublicKey()
+		signatories[address] = Signatory{
+			Address:   &address,
+			PublicKey: &publicKey,
+			Signature: sig,
+		}
+	}
+	// Keep signatories in the order of inputs as Verify expects
+	txEnv.Signatories = txEnv.Signatories[:0]
+	for _, in := range inputs {
+		if s, ok := signatories[in.Address]; ok {
+			txEnv.Signatories = append(txEnv.Signatories, s)
+		}
+	}
+	return txEnv.Aggregate()
 }
 
 func (txEnv *Envelope) Tagged() query.Tagged {
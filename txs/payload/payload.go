@@ -11,6 +11,7 @@ Account Txs:
  - SendTx         Send coins to address
  - CallTx         Send a msg to a contract that runs in the vm
  - NameTx	  Store some value under a name in the global namereg
+ - RekeyTx        Rotate the key that signs for an account
 
 Validation Txs:
  - BondTx         New validator posts a bond
@@ -26,9 +27,10 @@ type Type uint32
 const (
 	TypeUnknown = Type(0x00)
 	// Account transactions
-	TypeSend = Type(0x01)
-	TypeCall = Type(0x02)
-	TypeName = Type(0x03)
+	TypeSend  = Type(0x01)
+	TypeCall  = Type(0x02)
+	TypeName  = Type(0x03)
+	TypeRekey = Type(0x04)
 
 	// Validation transactions
 	TypeBond   = Type(0x11)
@@ -44,6 +46,7 @@ var nameFromType = map[Type]string{
 	TypeSend:        "SendTx",
 	TypeCall:        "CallTx",
 	TypeName:        "NameTx",
+	TypeRekey:       "RekeyTx",
 	TypeBond:        "BondTx",
 	TypeUnbond:      "UnbondTx",
 	TypePermissions: "PermsTx",
@@ -105,6 +108,8 @@ func New(txType Type) (Payload, error) {
 		return &CallTx{}, nil
 	case TypeName:
 		return &NameTx{}, nil
+	case TypeRekey:
+		return &RekeyTx{}, nil
 	case TypeBond:
 		return &BondTx{}, nil
 	case TypeUnbond:
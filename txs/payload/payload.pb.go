@@ -18,6 +18,7 @@
 		BondTx
 		UnbondTx
 		GovTx
+		RekeyTx
 */
 package payload
 
@@ -26,8 +27,9 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
-import permission "github.com/hyperledger/burrow/permission"
+import crypto "github.com/hyperledger/burrow/crypto"
 import spec "github.com/hyperledger/burrow/genesis/spec"
+import permission "github.com/hyperledger/burrow/permission"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
@@ -54,6 +56,7 @@ type Any struct {
 	GovTx    *GovTx    `protobuf:"bytes,5,opt,name=GovTx" json:"GovTx,omitempty"`
 	BondTx   *BondTx   `protobuf:"bytes,6,opt,name=BondTx" json:"BondTx,omitempty"`
 	UnbondTx *UnbondTx `protobuf:"bytes,7,opt,name=UnbondTx" json:"UnbondTx,omitempty"`
+	RekeyTx  *RekeyTx  `protobuf:"bytes,8,opt,name=RekeyTx" json:"RekeyTx,omitempty"`
 }
 
 func (m *Any) Reset()                    { *m = Any{} }
@@ -110,6 +113,13 @@ func (m *Any) GetUnbondTx() *UnbondTx {
 	return nil
 }
 
+func (m *Any) GetRekeyTx() *RekeyTx {
+	if m != nil {
+		return m.RekeyTx
+	}
+	return nil
+}
+
 func (*Any) XXX_MessageName() string {
 	return "payload.Any"
 }
@@ -346,6 +356,46 @@ func (*GovTx) Descriptor() ([]byte, []int) { return fileDescriptorPayload, []int
 func (*GovTx) XXX_MessageName() string {
 	return "payload.GovTx"
 }
+
+// Binds a new public key to the input account so that the account is thereafter signed for by that key rather than
+// the key from which its address was derived
+type RekeyTx struct {
+	// The account whose key is rotated, which must be signed for by its current key
+	Input *TxInput `protobuf:"bytes,1,opt,name=Input" json:"Input,omitempty"`
+	// The key that will sign for the account
+	PublicKey crypto.PublicKey `protobuf:"bytes,2,opt,name=PublicKey" json:"PublicKey"`
+	// Signature by PublicKey of the transaction's ProofBytes proving the key is held by whoever rekeys the account
+	Proof crypto.Signature `protobuf:"bytes,3,opt,name=Proof" json:"Proof"`
+}
+
+func (m *RekeyTx) Reset()                    { *m = RekeyTx{} }
+func (*RekeyTx) ProtoMessage()               {}
+func (*RekeyTx) Descriptor() ([]byte, []int) { return fileDescriptorPayload, []int{10} }
+
+func (m *RekeyTx) GetInput() *TxInput {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *RekeyTx) GetPublicKey() crypto.PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (m *RekeyTx) GetProof() crypto.Signature {
+	if m != nil {
+		return m.Proof
+	}
+	return crypto.Signature{}
+}
+
+func (*RekeyTx) XXX_MessageName() string {
+	return "payload.RekeyTx"
+}
 func init() {
 	proto.RegisterType((*Any)(nil), "payload.Any")
 	golang_proto.RegisterType((*Any)(nil), "payload.Any")
@@ -367,6 +417,8 @@ func init() {
 	golang_proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
 	proto.RegisterType((*GovTx)(nil), "payload.GovTx")
 	golang_proto.RegisterType((*GovTx)(nil), "payload.GovTx")
+	proto.RegisterType((*RekeyTx)(nil), "payload.RekeyTx")
+	golang_proto.RegisterType((*RekeyTx)(nil), "payload.RekeyTx")
 }
 func (m *Any) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -453,6 +505,16 @@ func (m *Any) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if m.RekeyTx != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.RekeyTx.Size()))
+		n8, err := m.RekeyTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	return i, nil
 }
 
@@ -474,11 +536,11 @@ func (m *TxInput) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n8, err := m.Address.MarshalTo(dAtA[i:])
+	n9, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	if m.Amount != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -510,11 +572,11 @@ func (m *TxOutput) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n9, err := m.Address.MarshalTo(dAtA[i:])
+	n10, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	if m.Amount != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -542,21 +604,21 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n10, err := m.Input.MarshalTo(dAtA[i:])
+		n11, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	if m.Address != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-		n11, err := m.Address.MarshalTo(dAtA[i:])
+		n12, err := m.Address.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	if m.GasLimit != 0 {
 		dAtA[i] = 0x18
@@ -571,11 +633,11 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Data.Size()))
-	n12, err := m.Data.MarshalTo(dAtA[i:])
+	n13, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	return i, nil
 }
 
@@ -640,20 +702,20 @@ func (m *PermsTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n13, err := m.Input.MarshalTo(dAtA[i:])
+		n14, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.PermArgs.Size()))
-	n14, err := m.PermArgs.MarshalTo(dAtA[i:])
+	n15, err := m.PermArgs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	return i, nil
 }
 
@@ -676,11 +738,11 @@ func (m *NameTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n15, err := m.Input.MarshalTo(dAtA[i:])
+		n16, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
@@ -763,20 +825,20 @@ func (m *UnbondTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n16, err := m.Input.MarshalTo(dAtA[i:])
+		n17, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n17, err := m.Address.MarshalTo(dAtA[i:])
+	n18, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	if m.Height != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -827,6 +889,50 @@ func (m *GovTx) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RekeyTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RekeyTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Input != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
+		n19, err := m.Input.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.PublicKey.Size()))
+	n20, err := m.PublicKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Proof.Size()))
+	n21, err := m.Proof.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	return i, nil
+}
+
 func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -867,6 +973,10 @@ func (m *Any) Size() (n int) {
 		l = m.UnbondTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.RekeyTx != nil {
+		l = m.RekeyTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	return n
 }
 
@@ -1019,6 +1129,20 @@ func (m *GovTx) Size() (n int) {
 	return n
 }
 
+func (m *RekeyTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
+	l = m.PublicKey.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	l = m.Proof.Size()
+	n += 1 + l + sovPayload(uint64(l))
+	return n
+}
+
 func sovPayload(x uint64) (n int) {
 	for {
 		n++
@@ -1292,6 +1416,39 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RekeyTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RekeyTx == nil {
+				m.RekeyTx = &RekeyTx{}
+			}
+			if err := m.RekeyTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -2454,6 +2611,149 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RekeyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RekeyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RekeyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &TxInput{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPayload(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2563,47 +2863,51 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptorPayload) }
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptorPayload) }
 
 var fileDescriptorPayload = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3b, 0x6f, 0xd4, 0x40,
-	0x10, 0xce, 0xe6, 0x9c, 0xbb, 0x63, 0x09, 0x21, 0xac, 0x00, 0x9d, 0xae, 0xb8, 0x43, 0x11, 0x82,
-	0xf0, 0x88, 0x0f, 0xf1, 0x2a, 0xd2, 0xa0, 0xbb, 0x20, 0x92, 0x20, 0x14, 0xd0, 0xc6, 0x69, 0xe8,
-	0xfc, 0x58, 0x1c, 0x8b, 0xb3, 0xd7, 0xd8, 0x6b, 0xb0, 0x3b, 0x4a, 0x7a, 0x1a, 0xca, 0x14, 0x54,
-	0xfc, 0x0a, 0xca, 0x94, 0xd4, 0x14, 0x11, 0x4a, 0x7e, 0x06, 0x0d, 0xda, 0xf1, 0xae, 0xef, 0x38,
-	0x20, 0xba, 0x80, 0x44, 0xb7, 0x33, 0xdf, 0xb7, 0x9e, 0x99, 0x6f, 0x66, 0xd6, 0xf8, 0x4c, 0x6c,
-	0x17, 0x43, 0x6e, 0x7b, 0x66, 0x9c, 0x70, 0xc1, 0x49, 0x43, 0x99, 0xed, 0x15, 0x3f, 0x10, 0xbb,
-	0x99, 0x63, 0xba, 0x3c, 0xec, 0xf9, 0xdc, 0xe7, 0x3d, 0xc0, 0x9d, 0xec, 0x05, 0x58, 0x60, 0xc0,
-	0xa9, 0xbc, 0xd7, 0x5e, 0x8c, 0x59, 0x12, 0x06, 0x69, 0x1a, 0xf0, 0x48, 0x79, 0x70, 0x1a, 0x33,
-	0xb7, 0x3c, 0x2f, 0x7d, 0x9a, 0xc5, 0xb5, 0x7e, 0x54, 0x90, 0xab, 0xb8, 0xbe, 0x66, 0x0f, 0x87,
-	0x56, 0xde, 0x42, 0x97, 0xd0, 0xf2, 0xe9, 0xdb, 0x67, 0x4d, 0x1d, 0xbd, 0x74, 0x53, 0x05, 0x4b,
-	0xe2, 0x36, 0x8b, 0x3c, 0x2b, 0x6f, 0xcd, 0x4e, 0x10, 0x4b, 0x37, 0x55, 0xb0, 0x24, 0x6e, 0xd9,
-	0x21, 0xb3, 0xf2, 0x56, 0x6d, 0x82, 0x58, 0xba, 0xa9, 0x82, 0xc9, 0x75, 0xdc, 0x78, 0xc6, 0x92,
-	0x30, 0xb5, 0xf2, 0x96, 0x01, 0xcc, 0xc5, 0x8a, 0xa9, 0xfc, 0x54, 0x13, 0xc8, 0x65, 0x3c, 0xb7,
-	0xce, 0x5f, 0x5b, 0x79, 0x6b, 0x0e, 0x98, 0x0b, 0x15, 0x13, 0xbc, 0xb4, 0x04, 0x65, 0xe8, 0x01,
-	0x87, 0x1c, 0xeb, 0x13, 0xa1, 0x4b, 0x37, 0x55, 0x30, 0x59, 0xc1, 0xcd, 0x9d, 0xc8, 0x29, 0xa9,
-	0x0d, 0xa0, 0x9e, 0xab, 0xa8, 0x1a, 0xa0, 0x15, 0x65, 0xe9, 0x3d, 0xc2, 0x0d, 0x2b, 0xdf, 0x8c,
-	0xe2, 0x4c, 0x90, 0x2d, 0xdc, 0xe8, 0x7b, 0x5e, 0xc2, 0xd2, 0x14, 0x14, 0x9b, 0x1f, 0xdc, 0xdd,
-	0x3f, 0xe8, 0xce, 0x7c, 0x3d, 0xe8, 0xde, 0x1c, 0x6b, 0xcf, 0x6e, 0x11, 0xb3, 0x64, 0xc8, 0x3c,
-	0x9f, 0x25, 0x3d, 0x27, 0x4b, 0x12, 0xfe, 0xa6, 0xe7, 0x26, 0x45, 0x2c, 0xb8, 0xa9, 0xee, 0x52,
-	0xfd, 0x11, 0x72, 0x11, 0xd7, 0xfb, 0x21, 0xcf, 0x22, 0x01, 0xba, 0x1a, 0x54, 0x59, 0xa4, 0x8d,
-	0x9b, 0xdb, 0xec, 0x55, 0xc6, 0x22, 0x97, 0x81, 0x90, 0x06, 0xad, 0xec, 0x55, 0xe3, 0xc3, 0x5e,
-	0x77, 0x66, 0x29, 0xc7, 0x4d, 0x2b, 0x7f, 0x9a, 0x89, 0xff, 0x98, 0x95, 0x8a, 0xfc, 0x1d, 0xe9,
-	0xa9, 0x21, 0x57, 0xf0, 0x1c, 0xe8, 0xa2, 0xc6, 0x67, 0xd4, 0x42, 0xa5, 0x17, 0x2d, 0x61, 0xf2,
-	0x78, 0x94, 0xe0, 0x2c, 0x24, 0x78, 0xeb, 0xef, 0x93, 0x6b, 0xe3, 0xe6, 0xba, 0x9d, 0x3e, 0x09,
-	0xc2, 0x40, 0x68, 0x69, 0xb4, 0x4d, 0x16, 0x71, 0xed, 0x11, 0x63, 0x30, 0x50, 0x06, 0x95, 0x47,
-	0xb2, 0x89, 0x8d, 0x87, 0xb6, 0xb0, 0x61, 0x72, 0xe6, 0x07, 0xf7, 0x94, 0x2e, 0x2b, 0xc7, 0x87,
-	0x76, 0x82, 0xc8, 0x4e, 0x0a, 0x73, 0x83, 0xe5, 0x83, 0x42, 0xb0, 0x94, 0xc2, 0x27, 0x54, 0xf5,
-	0x81, 0xde, 0x04, 0xb2, 0x8c, 0xeb, 0x50, 0x9d, 0x14, 0xbd, 0xf6, 0xdb, 0xea, 0x15, 0x4e, 0x6e,
-	0xe0, 0x46, 0xd9, 0x29, 0x59, 0x7e, 0xed, 0xa7, 0x79, 0xd3, 0x3d, 0xa4, 0x9a, 0xb1, 0xda, 0x7c,
-	0xb7, 0xd7, 0x9d, 0x81, 0x50, 0xbc, 0x5a, 0x91, 0xa9, 0x85, 0xbe, 0x8f, 0x9b, 0xf2, 0x4a, 0x3f,
-	0xf1, 0x53, 0xb5, 0xa9, 0xe7, 0xcd, 0xb1, 0x97, 0x40, 0x63, 0x03, 0x43, 0x0a, 0x41, 0x2b, 0xae,
-	0xaa, 0x2d, 0xd6, 0xcb, 0x3b, 0x75, 0x3c, 0x82, 0x0d, 0x79, 0x03, 0x62, 0x9d, 0xa2, 0x70, 0x96,
-	0x3e, 0x90, 0xbc, 0x56, 0xfa, 0xe4, 0xf9, 0xd7, 0xc6, 0xa8, 0x88, 0x2f, 0xf5, 0xce, 0x9e, 0x40,
-	0xcd, 0xd1, 0xfa, 0xf2, 0x3f, 0xcb, 0x59, 0x51, 0xc6, 0xf4, 0xfc, 0x88, 0x46, 0x8b, 0x3f, 0x75,
-	0x85, 0x5b, 0x93, 0xa3, 0xfb, 0xef, 0xbb, 0xb5, 0xc1, 0x02, 0x7f, 0x57, 0x0f, 0xaf, 0xb2, 0xc6,
-	0xd2, 0x7c, 0x8b, 0xd4, 0x73, 0x77, 0x02, 0x4d, 0xd6, 0xf0, 0x42, 0xdf, 0x75, 0xe5, 0x92, 0xee,
-	0xc4, 0x9e, 0x2d, 0x98, 0x1e, 0xb4, 0x0b, 0x26, 0xbc, 0xfa, 0x16, 0x0b, 0xe3, 0xa1, 0x2d, 0x98,
-	0xe2, 0x40, 0xfb, 0x11, 0x9d, 0xb8, 0x32, 0x4a, 0x61, 0xf0, 0x60, 0xff, 0xb0, 0x83, 0xbe, 0x1c,
-	0x76, 0xd0, 0xb7, 0xc3, 0x0e, 0xfa, 0x7c, 0xd4, 0x41, 0xfb, 0x47, 0x1d, 0xf4, 0xfc, 0xda, 0xf1,
-	0x15, 0x8b, 0x3c, 0xed, 0xa9, 0xfc, 0x9c, 0x3a, 0xfc, 0x67, 0xee, 0xfc, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xf8, 0x92, 0x62, 0xb5, 0xce, 0x06, 0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3d, 0x6f, 0xd4, 0x4c,
+	0x10, 0xce, 0xe6, 0x7c, 0x1f, 0xd9, 0x37, 0x6f, 0x48, 0x56, 0x80, 0xac, 0x2b, 0xee, 0x50, 0x84,
+	0x20, 0x7c, 0x9c, 0x0f, 0x01, 0xa1, 0x48, 0x83, 0xee, 0x82, 0x48, 0x02, 0x28, 0x44, 0x1b, 0xa7,
+	0xa1, 0xb3, 0x7d, 0x1b, 0xc7, 0xca, 0xd9, 0x6b, 0xfc, 0x01, 0x76, 0x47, 0x49, 0x4f, 0x43, 0x83,
+	0x94, 0x82, 0x1f, 0x42, 0x99, 0x92, 0x8a, 0x82, 0x22, 0x42, 0xc9, 0xcf, 0xa0, 0x41, 0x5e, 0xcf,
+	0xfa, 0x2e, 0x06, 0xa2, 0x0b, 0x48, 0x74, 0x3b, 0xf3, 0x3c, 0xe3, 0x99, 0x7d, 0x66, 0x66, 0x8d,
+	0xff, 0xf7, 0x8d, 0x74, 0xc8, 0x8d, 0x81, 0xe6, 0x07, 0x3c, 0xe2, 0xa4, 0x0e, 0x66, 0xb3, 0x63,
+	0x3b, 0xd1, 0x5e, 0x6c, 0x6a, 0x16, 0x77, 0xbb, 0x36, 0xb7, 0x79, 0x57, 0xe0, 0x66, 0xbc, 0x2b,
+	0x2c, 0x61, 0x88, 0x53, 0x1e, 0xd7, 0x9c, 0xf7, 0x59, 0xe0, 0x3a, 0x61, 0xe8, 0x70, 0x0f, 0x3c,
+	0xb3, 0x56, 0x90, 0xfa, 0x91, 0xc4, 0x71, 0xe8, 0x33, 0x2b, 0x3f, 0x2f, 0x7e, 0x99, 0xc6, 0x95,
+	0x9e, 0x97, 0x92, 0xeb, 0xb8, 0xb6, 0x6a, 0x0c, 0x87, 0x7a, 0xa2, 0xa2, 0x2b, 0x68, 0xe9, 0xbf,
+	0xbb, 0x17, 0x34, 0x59, 0x4b, 0xee, 0xa6, 0x00, 0x67, 0xc4, 0x6d, 0xe6, 0x0d, 0xf4, 0x44, 0x9d,
+	0x2e, 0x11, 0x73, 0x37, 0x05, 0x38, 0x23, 0x6e, 0x1a, 0x2e, 0xd3, 0x13, 0xb5, 0x52, 0x22, 0xe6,
+	0x6e, 0x0a, 0x30, 0xb9, 0x89, 0xeb, 0x5b, 0x2c, 0x70, 0x43, 0x3d, 0x51, 0x15, 0xc1, 0x9c, 0x2f,
+	0x98, 0xe0, 0xa7, 0x92, 0x40, 0xae, 0xe2, 0xea, 0x1a, 0x7f, 0xa5, 0x27, 0x6a, 0x55, 0x30, 0xe7,
+	0x0a, 0xa6, 0xf0, 0xd2, 0x1c, 0xcc, 0x52, 0xf7, 0xb9, 0xa8, 0xb1, 0x56, 0x4a, 0x9d, 0xbb, 0x29,
+	0xc0, 0xa4, 0x83, 0x1b, 0x3b, 0x9e, 0x99, 0x53, 0xeb, 0x82, 0xba, 0x50, 0x50, 0x25, 0x40, 0x0b,
+	0x4a, 0x56, 0x29, 0x65, 0xfb, 0x2c, 0xd5, 0x13, 0xb5, 0x51, 0xaa, 0x14, 0xfc, 0x54, 0x12, 0x16,
+	0xdf, 0x21, 0x5c, 0xd7, 0x93, 0x0d, 0xcf, 0x8f, 0x23, 0xb2, 0x89, 0xeb, 0xbd, 0xc1, 0x20, 0x60,
+	0x61, 0x28, 0xd4, 0x9d, 0xed, 0xdf, 0x3f, 0x3c, 0x6a, 0x4f, 0x7d, 0x3d, 0x6a, 0xdf, 0x1e, 0x6b,
+	0xec, 0x5e, 0xea, 0xb3, 0x60, 0xc8, 0x06, 0x36, 0x0b, 0xba, 0x66, 0x1c, 0x04, 0xfc, 0x75, 0x17,
+	0xba, 0x06, 0xb1, 0x54, 0x7e, 0x84, 0x5c, 0xc6, 0xb5, 0x9e, 0xcb, 0x63, 0x2f, 0x12, 0x3d, 0x50,
+	0x28, 0x58, 0xa4, 0x89, 0x1b, 0xdb, 0xec, 0x65, 0xcc, 0x3c, 0x8b, 0x09, 0xd1, 0x15, 0x5a, 0xd8,
+	0x2b, 0xca, 0xfb, 0x83, 0xf6, 0xd4, 0x62, 0x82, 0x1b, 0x7a, 0xf2, 0x3c, 0x8e, 0xfe, 0x61, 0x55,
+	0x90, 0xf9, 0x3b, 0x92, 0x13, 0x46, 0xae, 0xe1, 0xaa, 0xd0, 0x45, 0x45, 0x25, 0x11, 0x41, 0x2f,
+	0x9a, 0xc3, 0xe4, 0xc9, 0xa8, 0xc0, 0x69, 0x51, 0xe0, 0x9d, 0x3f, 0x2f, 0xae, 0x89, 0x1b, 0x6b,
+	0x46, 0xf8, 0xcc, 0x71, 0x9d, 0x48, 0x4a, 0x23, 0x6d, 0x32, 0x8f, 0x2b, 0x8f, 0x19, 0x13, 0xc3,
+	0xa7, 0xd0, 0xec, 0x48, 0x36, 0xb0, 0xf2, 0xc8, 0x88, 0x0c, 0x31, 0x65, 0xb3, 0xfd, 0x65, 0xd0,
+	0xa5, 0x73, 0x76, 0x6a, 0xd3, 0xf1, 0x8c, 0x20, 0xd5, 0xd6, 0x59, 0xd2, 0x4f, 0x23, 0x16, 0x52,
+	0xf1, 0x09, 0xb8, 0xbd, 0x23, 0xb7, 0x86, 0x2c, 0xe1, 0x9a, 0xb8, 0x5d, 0x26, 0x7a, 0xe5, 0x97,
+	0xb7, 0x07, 0x9c, 0xdc, 0xc2, 0xf5, 0xbc, 0x53, 0xd9, 0xf5, 0x2b, 0xa7, 0x66, 0x53, 0xf6, 0x90,
+	0x4a, 0xc6, 0x4a, 0xe3, 0xed, 0x41, 0x7b, 0x4a, 0xa4, 0xe2, 0xc5, 0x3a, 0x4d, 0x2c, 0xf4, 0x03,
+	0xdc, 0xc8, 0x42, 0x7a, 0x81, 0x1d, 0xc2, 0x56, 0x5f, 0xd4, 0xc6, 0xde, 0x10, 0x89, 0xf5, 0x95,
+	0x4c, 0x08, 0x5a, 0x70, 0xe1, 0x6e, 0xbe, 0x5c, 0xf4, 0x89, 0xf3, 0x11, 0xac, 0x64, 0x11, 0x22,
+	0xd7, 0x0c, 0x15, 0xe7, 0xcc, 0x27, 0x24, 0xaf, 0xe4, 0xbe, 0xec, 0xfc, 0x73, 0x63, 0x20, 0xe3,
+	0xbe, 0xdc, 0xef, 0x73, 0xa8, 0x39, 0x5a, 0x75, 0xfe, 0x7b, 0x39, 0x0b, 0xca, 0x98, 0x9e, 0x1f,
+	0x11, 0x1e, 0xbd, 0x00, 0x93, 0xde, 0x70, 0xb3, 0x3c, 0xba, 0x7f, 0xbf, 0x5b, 0xeb, 0xcc, 0xb1,
+	0xf7, 0xe4, 0xf0, 0x82, 0x35, 0x56, 0xe6, 0x1b, 0x04, 0x4f, 0xe3, 0x39, 0x34, 0x59, 0xc5, 0x73,
+	0x3d, 0xcb, 0xca, 0x96, 0x74, 0xc7, 0x1f, 0x18, 0x11, 0x93, 0x83, 0x76, 0x49, 0x13, 0x7f, 0x08,
+	0x9d, 0xb9, 0xfe, 0xd0, 0x88, 0x18, 0x70, 0x44, 0xfb, 0x11, 0x2d, 0x85, 0x8c, 0x95, 0xf0, 0x01,
+	0x15, 0xef, 0xe3, 0xc4, 0x42, 0x2d, 0xe3, 0x99, 0xad, 0xd8, 0x1c, 0x3a, 0xd6, 0x53, 0x96, 0xc2,
+	0xec, 0x2d, 0x68, 0xa0, 0x42, 0x01, 0xc0, 0xe0, 0x8d, 0x98, 0xa4, 0x83, 0xab, 0x5b, 0x01, 0xe7,
+	0xbb, 0x6a, 0xe5, 0x74, 0xc8, 0xb6, 0x63, 0x7b, 0x46, 0x14, 0x07, 0x0c, 0x42, 0x72, 0x56, 0x3e,
+	0x36, 0xfd, 0x87, 0x87, 0xc7, 0x2d, 0xf4, 0xf9, 0xb8, 0x85, 0xbe, 0x1d, 0xb7, 0xd0, 0xa7, 0x93,
+	0x16, 0x3a, 0x3c, 0x69, 0xa1, 0x17, 0x37, 0xce, 0xee, 0x48, 0x94, 0x84, 0x5d, 0xa8, 0xdd, 0xac,
+	0x89, 0x7f, 0xe6, 0xbd, 0x1f, 0x03, 0x00, 0x21, 0x09, 0xe9, 0xd1, 0xa8, 0x07, 0x00, 0x00,
 }
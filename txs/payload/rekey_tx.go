@@ -0,0 +1,89 @@
+package payload
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func NewRekeyTx(st state.AccountGetter, address crypto.Address, publicKey crypto.PublicKey) (*RekeyTx, error) {
+	acc, err := st.GetAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, fmt.Errorf("invalid address %s", address)
+	}
+	return NewRekeyTxWithSequence(address, publicKey, acc.Sequence()+1), nil
+}
+
+func NewRekeyTxWithSequence(address crypto.Address, publicKey crypto.PublicKey, sequence uint64) *RekeyTx {
+	return &RekeyTx{
+		Input: &TxInput{
+			Address:  address,
+			Sequence: sequence,
+		},
+		PublicKey: publicKey,
+	}
+}
+
+// ProofBytes returns the message PublicKey signs for the Proof. It is the transaction without its Proof together with
+// chainID so that a proof cannot be replayed on another chain or for another account or sequence.
+func (tx *RekeyTx) ProofBytes(chainID string) ([]byte, error) {
+	unproven := *tx
+	unproven.Proof = crypto.Signature{}
+	bs, err := json.Marshal(struct {
+		ChainID string
+		RekeyTx *RekeyTx
+	}{chainID, &unproven})
+	if err != nil {
+		return nil, fmt.Errorf("could not generate ProofBytes for %v: %v", tx, err)
+	}
+	return bs, nil
+}
+
+// Prove signs the transaction's ProofBytes with signer, which must hold the private key of PublicKey
+func (tx *RekeyTx) Prove(chainID string, signer crypto.Signer) error {
+	bs, err := tx.ProofBytes(chainID)
+	if err != nil {
+		return err
+	}
+	tx.Proof, err = signer.Sign(bs)
+	return err
+}
+
+// VerifyProof checks PublicKey is a valid key and that Proof shows it is held by whoever signed the transaction
+func (tx *RekeyTx) VerifyProof(chainID string) error {
+	if !tx.PublicKey.IsValid() {
+		return fmt.Errorf("public key %v is not a valid key", tx.PublicKey)
+	}
+	bs, err := tx.ProofBytes(chainID)
+	if err != nil {
+		return err
+	}
+	err = tx.PublicKey.Verify(bs, tx.Proof)
+	if err != nil {
+		return fmt.Errorf("could not verify proof that public key %v is held: %v", tx.PublicKey, err)
+	}
+	return nil
+}
+
+func (tx *RekeyTx) Type() Type {
+	return TypeRekey
+}
+
+func (tx *RekeyTx) GetInputs() []*TxInput {
+	return []*TxInput{tx.Input}
+}
+
+func (tx *RekeyTx) String() string {
+	return fmt.Sprintf("RekeyTx{%v -> %v}", tx.Input, tx.PublicKey)
+}
+
+func (tx *RekeyTx) Any() *Any {
+	return &Any{
+		RekeyTx: tx,
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/state"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/permission"
@@ -218,3 +219,87 @@ func testTxSignVerify(t *testing.T, tx payload.Payload) {
 	require.NoError(t, txEnv.Sign(signers...), "Error signing tx: %s", debug.Stack())
 	require.NoError(t, txEnv.Verify(nil, chainID), "Error verifying tx: %s", debug.Stack())
 }
+
+func TestEnvelopeAddSignatures(t *testing.T) {
+	var signers []acm.AddressableSigner
+	var inputs []*payload.TxInput
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeEd25519, crypto.CurveTypeBLS12381,
+		crypto.CurveTypeSecp256k1, crypto.CurveTypeBLS12381} {
+		privateKey, err := crypto.GeneratePrivateKey(nil, curveType)
+		require.NoError(t, err)
+		signer := acm.PrivateAccountFromPrivateKey(privateKey)
+		signers = append(signers, signer)
+		inputs = append(inputs, &payload.TxInput{Address: signer.Address(), Amount: 1, Sequence: 1})
+	}
+	txEnv := Enclose(chainID, &payload.SendTx{Inputs: inputs})
+	codec := NewJSONCodec()
+	// Each signatory signs in turn passing the envelope on in serialised form
+	for _, i := range []int{2, 1, 0, 3} {
+		assert.Error(t, txEnv.Verify(nil, chainID))
+		require.NoError(t, txEnv.AddSignatures(signers[i]))
+		bs, err := codec.EncodeTx(txEnv)
+		require.NoError(t, err)
+		txEnv, err = codec.DecodeTx(bs)
+		require.NoError(t, err)
+	}
+	require.Len(t, txEnv.Signatories, 4)
+	require.NotNil(t, txEnv.AggregateSignature)
+	require.NoError(t, txEnv.Verify(nil, chainID))
+
+	// Resigning an input that has not been aggregated is fine
+	require.NoError(t, txEnv.AddSignatures(signers[0]))
+	require.NoError(t, txEnv.Verify(nil, chainID))
+	assert.Error(t, txEnv.AddSignatures(signers[1]), "signature already aggregated")
+	assert.Error(t, txEnv.AddSignatures(makePrivateAccount("stranger")), "not an input")
+}
+
+func TestEnvelopeVerifyEthereumAddresses(t *testing.T) {
+	require.NoError(t, crypto.SetAddressDerivation(crypto.AddressDerivationEthereum))
+	defer crypto.SetAddressDerivation("")
+	privateKey, err := crypto.GeneratePrivateKey(nil, crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	signer := acm.PrivateAccountFromPrivateKey(privateKey)
+	txEnv := Enclose(chainID, &payload.SendTx{
+		Inputs: []*payload.TxInput{{Address: signer.Address(), Amount: 1, Sequence: 1}},
+	})
+	require.NoError(t, txEnv.Sign(signer))
+	require.NoError(t, txEnv.Verify(nil, chainID))
+
+	// The signatory's address is only that of its public key under the same derivation
+	require.NoError(t, txEnv.Signatories[0].RealisePublicKey(nil))
+	require.NoError(t, crypto.SetAddressDerivation(crypto.AddressDerivationBurrow))
+	assert.Error(t, txEnv.Signatories[0].RealisePublicKey(nil))
+	assert.Error(t, txEnv.Verify(nil, chainID))
+}
+
+func TestReceiptContractAddressEthereum(t *testing.T) {
+	require.NoError(t, crypto.SetAddressDerivation(crypto.AddressDerivationEthereum))
+	defer crypto.SetAddressDerivation("")
+	caller, err := crypto.AddressFromHexString("6AC7EA33F8831EA9DCC53393AAA88B25A785DBF0")
+	require.NoError(t, err)
+	// The receipt agrees with the executor which hashes the sequence, so an account's first deploy with sequence 1
+	// is at the address Ethereum gives nonce 1
+	tx := NewTx(&payload.CallTx{Input: &payload.TxInput{Address: caller, Sequence: 1}})
+	receipt := tx.GenerateReceipt()
+	assert.True(t, receipt.CreatesContract)
+	assert.Equal(t, "343C43A37D37DFF08AE8C4A11544C718ABB4FCF8", receipt.ContractAddress.String())
+}
+
+func TestRealisePublicKeyRekeyed(t *testing.T) {
+	oldKey := makePrivateAccount("old")
+	newKey := makePrivateAccount("new")
+	address := oldKey.Address()
+	st := state.NewMemoryState()
+	require.NoError(t, st.UpdateAccount(acm.ConcreteAccount{Address: address, PublicKey: newKey.PublicKey()}.Account()))
+
+	// The key bound to a rekeyed account is realised and accepted though the address is not derived from it
+	sig := &Signatory{Address: &address}
+	require.NoError(t, sig.RealisePublicKey(st))
+	assert.Equal(t, newKey.PublicKey(), *sig.PublicKey)
+	require.NoError(t, sig.RealisePublicKey(st))
+
+	// Whereas the key from which the address was derived no longer is
+	oldPublicKey := oldKey.PublicKey()
+	sig = &Signatory{Address: &address, PublicKey: &oldPublicKey}
+	assert.Error(t, sig.RealisePublicKey(st))
+}
@@ -44,6 +44,8 @@ type Envelope struct {
 	Signatories []Signatory `protobuf:"bytes,1,rep,name=Signatories" json:"Signatories"`
 	// Canonical bytes of the Tx ready to be signed
 	Tx *Tx `protobuf:"bytes,2,opt,name=Tx,proto3,customtype=Tx" json:"Tx,omitempty"`
+	// The aggregation of the signatures of all BLS12-381 signatories, which then carry no signature of their own
+	AggregateSignature *github_com_hyperledger_burrow_crypto.Signature `protobuf:"bytes,3,opt,name=AggregateSignature,proto3,customtype=github.com/hyperledger/burrow/crypto.Signature" json:"AggregateSignature,omitempty"`
 }
 
 func (m *Envelope) Reset()                    { *m = Envelope{} }
@@ -163,6 +165,16 @@ func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.AggregateSignature != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTxs(dAtA, i, uint64(m.AggregateSignature.Size()))
+		n2, err := m.AggregateSignature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -185,30 +197,30 @@ func (m *Signatory) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTxs(dAtA, i, uint64(m.Address.Size()))
-		n2, err := m.Address.MarshalTo(dAtA[i:])
+		n3, err := m.Address.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.PublicKey != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTxs(dAtA, i, uint64(m.PublicKey.Size()))
-		n3, err := m.PublicKey.MarshalTo(dAtA[i:])
+		n4, err := m.PublicKey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintTxs(dAtA, i, uint64(m.Signature.Size()))
-	n4, err := m.Signature.MarshalTo(dAtA[i:])
+	n5, err := m.Signature.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	return i, nil
 }
 
@@ -235,11 +247,11 @@ func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintTxs(dAtA, i, uint64(m.TxHash.Size()))
-	n5, err := m.TxHash.MarshalTo(dAtA[i:])
+	n6, err := m.TxHash.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	if m.CreatesContract {
 		dAtA[i] = 0x18
 		i++
@@ -253,11 +265,11 @@ func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintTxs(dAtA, i, uint64(m.ContractAddress.Size()))
-	n6, err := m.ContractAddress.MarshalTo(dAtA[i:])
+	n7, err := m.ContractAddress.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	return i, nil
 }
 
@@ -283,6 +295,10 @@ func (m *Envelope) Size() (n int) {
 		l = m.Tx.Size()
 		n += 1 + l + sovTxs(uint64(l))
 	}
+	if m.AggregateSignature != nil {
+		l = m.AggregateSignature.Size()
+		n += 1 + l + sovTxs(uint64(l))
+	}
 	return n
 }
 
@@ -423,6 +439,38 @@ func (m *Envelope) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Signature
+			m.AggregateSignature = &v
+			if err := m.AggregateSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxs(dAtA[iNdEx:])
@@ -847,32 +895,33 @@ func init() { proto.RegisterFile("txs.proto", fileDescriptorTxs) }
 func init() { golang_proto.RegisterFile("txs.proto", fileDescriptorTxs) }
 
 var fileDescriptorTxs = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
-	0x10, 0xc6, 0xeb, 0xec, 0x6a, 0xdb, 0xf5, 0x16, 0x2a, 0x7c, 0x40, 0xab, 0x1e, 0x92, 0xb2, 0xa7,
-	0x3d, 0xd0, 0x04, 0x2d, 0x50, 0x24, 0x6e, 0xa4, 0x42, 0xaa, 0x8a, 0x90, 0x90, 0xc9, 0x89, 0x03,
-	0x22, 0x7f, 0x86, 0x6c, 0xa4, 0x10, 0x47, 0xb6, 0x03, 0xf6, 0x9b, 0x70, 0xe4, 0x09, 0x78, 0x06,
-	0x8e, 0x7b, 0xe4, 0xbc, 0x87, 0x08, 0x6d, 0xdf, 0x82, 0x13, 0x8a, 0x71, 0xb6, 0x55, 0x0f, 0x94,
-	0xde, 0x3c, 0xf6, 0x7c, 0xbf, 0xf9, 0x66, 0xc6, 0x78, 0x2c, 0x95, 0xf0, 0x6b, 0xce, 0x24, 0x23,
-	0x03, 0xa9, 0xc4, 0xe1, 0x71, 0x5e, 0xc8, 0x65, 0x93, 0xf8, 0x29, 0xfb, 0x14, 0xe4, 0x2c, 0x67,
-	0x81, 0x79, 0x4b, 0x9a, 0x8f, 0x26, 0x32, 0x81, 0x39, 0xfd, 0xd5, 0x1c, 0xee, 0xa7, 0x5c, 0xd7,
-	0xd2, 0x46, 0xb3, 0x0f, 0x78, 0xef, 0x65, 0xf5, 0x19, 0x4a, 0x56, 0x03, 0x39, 0xc1, 0x93, 0xb7,
-	0x45, 0x5e, 0xc5, 0x92, 0xf1, 0x02, 0xc4, 0x14, 0x1d, 0x0d, 0xe6, 0x93, 0xc5, 0x5d, 0xbf, 0x2b,
-	0xd7, 0xdf, 0xeb, 0x70, 0xb8, 0x6a, 0xbd, 0x1d, 0x7a, 0x35, 0x91, 0xdc, 0xc7, 0x4e, 0xa4, 0xa6,
-	0xce, 0x11, 0x9a, 0xef, 0x87, 0xa3, 0x75, 0xeb, 0x39, 0x91, 0xa2, 0x4e, 0xa4, 0x9e, 0x0f, 0xbf,
-	0x7e, 0xf3, 0x76, 0x66, 0x2d, 0xc2, 0xe3, 0xad, 0x9c, 0x9c, 0xe3, 0xdd, 0x17, 0x59, 0xc6, 0x41,
-	0x74, 0xfc, 0x4e, 0xf0, 0x68, 0xdd, 0x7a, 0x0f, 0xaf, 0x74, 0xb0, 0xd4, 0x35, 0xf0, 0x12, 0xb2,
-	0x1c, 0x78, 0x90, 0x34, 0x9c, 0xb3, 0x2f, 0x81, 0x35, 0x6c, 0x75, 0xb4, 0x07, 0x90, 0x00, 0x8f,
-	0xdf, 0x34, 0x49, 0x59, 0xa4, 0xaf, 0x40, 0x9b, 0xf2, 0x93, 0xc5, 0x3d, 0xdf, 0x26, 0x6f, 0x1f,
-	0xe8, 0x65, 0x0e, 0x89, 0x7a, 0x27, 0x0d, 0x87, 0xe9, 0xc0, 0x94, 0x3f, 0xe9, 0xda, 0x59, 0xb7,
-	0x9e, 0xff, 0x5f, 0x16, 0xb6, 0x6a, 0x7a, 0x09, 0x9a, 0x7d, 0x77, 0xf0, 0x2e, 0x85, 0x14, 0x8a,
-	0x5a, 0x92, 0x73, 0x3c, 0x8a, 0x54, 0xa4, 0x6b, 0x30, 0xdd, 0xdd, 0x09, 0x17, 0xbf, 0x6f, 0x44,
-	0x4b, 0x25, 0x82, 0x3a, 0xd6, 0x25, 0x8b, 0x33, 0xbf, 0x53, 0x52, 0x4b, 0x20, 0xaf, 0x3b, 0xd6,
-	0x59, 0x2c, 0x96, 0x76, 0xb4, 0x4f, 0xad, 0xd5, 0xe3, 0x7f, 0xf3, 0x92, 0xa2, 0x8a, 0xb9, 0xf6,
-	0xcf, 0x40, 0x85, 0x5a, 0x82, 0xa0, 0x16, 0x42, 0xe6, 0xf8, 0xe0, 0x94, 0x43, 0x2c, 0x41, 0x9c,
-	0xb2, 0x4a, 0xf2, 0x38, 0x95, 0x66, 0x04, 0x7b, 0xf4, 0xfa, 0x35, 0x79, 0x8f, 0x0f, 0xfa, 0x73,
-	0xbf, 0xab, 0xa1, 0x71, 0xf0, 0xc4, 0x3a, 0xb8, 0xdd, 0xbe, 0xae, 0xc3, 0xc2, 0x67, 0xab, 0x8d,
-	0x8b, 0x7e, 0x6e, 0x5c, 0xf4, 0x6b, 0xe3, 0xa2, 0x1f, 0x17, 0x2e, 0x5a, 0x5d, 0xb8, 0xe8, 0xdd,
-	0x83, 0x1b, 0xc7, 0x94, 0x8c, 0xcc, 0x9f, 0x7d, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x2f,
-	0x9b, 0x0b, 0x02, 0x03, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xeb, 0x4b, 0x94, 0x36, 0x4e, 0xa1, 0xc2, 0x03, 0x8a, 0x3a, 0xdc, 0x95, 0x4c, 0x19,
+	0xe8, 0x1d, 0x0a, 0x50, 0x24, 0xb6, 0x5e, 0x85, 0x54, 0x15, 0x21, 0x21, 0x73, 0x13, 0x03, 0xd2,
+	0xdd, 0xe5, 0xe1, 0x9c, 0x14, 0xe2, 0x93, 0xed, 0x03, 0xfb, 0x9b, 0x30, 0xf2, 0x09, 0xf8, 0x0c,
+	0x0c, 0x0c, 0x19, 0x99, 0x33, 0x9c, 0x50, 0xfa, 0x2d, 0x98, 0xd0, 0xb9, 0x4e, 0x5a, 0x45, 0x88,
+	0x40, 0x37, 0x3f, 0xfb, 0xbd, 0xdf, 0xfb, 0xff, 0xdf, 0x93, 0x71, 0x57, 0x69, 0x19, 0x96, 0x82,
+	0x2b, 0x4e, 0x5a, 0x4a, 0xcb, 0xc3, 0x63, 0x56, 0xa8, 0x49, 0x95, 0x85, 0x39, 0xff, 0x10, 0x31,
+	0xce, 0x78, 0x64, 0xdf, 0xb2, 0xea, 0xbd, 0x8d, 0x6c, 0x60, 0x4f, 0x57, 0x35, 0x87, 0xfb, 0xb9,
+	0x30, 0xa5, 0x72, 0xd1, 0xe0, 0x3b, 0xc2, 0x7b, 0x2f, 0x66, 0x1f, 0x61, 0xca, 0x4b, 0x20, 0x27,
+	0xb8, 0xf7, 0xa6, 0x60, 0xb3, 0x54, 0x71, 0x51, 0x80, 0xec, 0xa3, 0xa3, 0xd6, 0xb0, 0x37, 0xba,
+	0x1b, 0x36, 0xfd, 0x56, 0xf7, 0x26, 0x6e, 0xcf, 0xeb, 0x60, 0x87, 0xde, 0x4c, 0x24, 0xf7, 0xb1,
+	0x97, 0xe8, 0xbe, 0x77, 0x84, 0x86, 0xfb, 0x71, 0x67, 0x51, 0x07, 0x5e, 0xa2, 0xa9, 0x97, 0x68,
+	0x92, 0x61, 0x72, 0xca, 0x98, 0x00, 0x96, 0x2a, 0xb8, 0xca, 0xaf, 0x04, 0xf4, 0x5b, 0x36, 0x6f,
+	0xb4, 0xa8, 0x83, 0xf0, 0x86, 0xf2, 0x89, 0x29, 0x41, 0x4c, 0x61, 0xcc, 0x40, 0x44, 0x59, 0x25,
+	0x04, 0xff, 0x14, 0x39, 0xa1, 0xeb, 0x4a, 0xfa, 0x07, 0xda, 0xf3, 0xf6, 0xe7, 0x2f, 0xc1, 0xce,
+	0xa0, 0x46, 0xb8, 0xbb, 0x96, 0x48, 0x2e, 0xf0, 0xee, 0xe9, 0x78, 0x2c, 0x40, 0x36, 0x1e, 0x9a,
+	0x66, 0x8f, 0x16, 0x75, 0xf0, 0xf0, 0x9f, 0x9a, 0xb9, 0x3a, 0xba, 0x02, 0x90, 0x08, 0x77, 0x5f,
+	0x57, 0xd9, 0xb4, 0xc8, 0x5f, 0x82, 0xb1, 0x16, 0x7b, 0xa3, 0x7b, 0xa1, 0x4b, 0x5e, 0x3f, 0xd0,
+	0xeb, 0x1c, 0x92, 0xe0, 0xee, 0xa6, 0xd7, 0x93, 0x66, 0x64, 0xb7, 0xf0, 0x7b, 0x0d, 0x1a, 0x7c,
+	0xf5, 0xf0, 0x2e, 0x85, 0x1c, 0x8a, 0x52, 0x91, 0x0b, 0xdc, 0x49, 0x74, 0x62, 0x4a, 0xb0, 0xee,
+	0xee, 0xc4, 0xa3, 0x5f, 0x5b, 0xd1, 0x4a, 0xcb, 0xa8, 0x4c, 0xcd, 0x94, 0xa7, 0xe3, 0xb0, 0xa9,
+	0xa4, 0x8e, 0x40, 0x5e, 0x35, 0xac, 0xf3, 0x54, 0x4e, 0xdc, 0xfa, 0x9e, 0x3a, 0xa9, 0xc7, 0x7f,
+	0xe7, 0x65, 0xc5, 0x2c, 0x15, 0x26, 0x3c, 0x07, 0x1d, 0x1b, 0x05, 0x92, 0x3a, 0x08, 0x19, 0xe2,
+	0x83, 0x33, 0x01, 0xa9, 0x02, 0x79, 0xc6, 0x67, 0x4a, 0xa4, 0xb9, 0xb2, 0x23, 0xd8, 0xa3, 0x9b,
+	0xd7, 0xe4, 0x1d, 0x3e, 0x58, 0x9d, 0x57, 0xbb, 0x6a, 0x5b, 0x05, 0x4f, 0x9c, 0x82, 0xff, 0xdb,
+	0xd7, 0x26, 0x2c, 0x7e, 0x36, 0x5f, 0xfa, 0xe8, 0xc7, 0xd2, 0x47, 0x3f, 0x97, 0x3e, 0xfa, 0x76,
+	0xe9, 0xa3, 0xf9, 0xa5, 0x8f, 0xde, 0x3e, 0xd8, 0x3a, 0xa6, 0xac, 0x63, 0x3f, 0xc6, 0xe3, 0xdf,
+	0x03, 0x00, 0xf2, 0xef, 0xff, 0xad, 0x67, 0x03, 0x00, 0x00,
 }
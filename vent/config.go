@@ -0,0 +1,33 @@
+package vent
+
+import (
+	"github.com/hyperledger/burrow/rpc/tlsconfig"
+	"github.com/hyperledger/burrow/vent/sqldb"
+)
+
+type VentConfig struct {
+	// One of the adapters supported by sqldb (postgres or sqlite3)
+	DBAdapter string
+	DBURL     string
+	// Postgres schema into which tables are projected (ignored by SQLite)
+	DBSchema string
+	// Address of the Burrow GRPC server from which events are consumed
+	GRPCAddr string
+	// TLS for the connection to the GRPC server, which is plaintext if nil or not enabled
+	GRPCTLS *tlsconfig.ClientConfig `json:",omitempty" toml:",omitempty"`
+	// JSON file containing an array of TableSpecs
+	SpecFile string
+	// ABI files defining the events referenced by the TableSpecs
+	AbiFiles []string
+	// Consume events from the first block rather than resuming from the last projected block
+	Replay bool
+}
+
+func DefaultVentConfig() *VentConfig {
+	return &VentConfig{
+		DBAdapter: sqldb.PostgresAdapter,
+		DBURL:     "postgres://postgres@localhost:5432/postgres?sslmode=disable",
+		DBSchema:  "vent",
+		GRPCAddr:  "localhost:10997",
+	}
+}
@@ -0,0 +1,148 @@
+package vent
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"google.golang.org/grpc"
+)
+
+// Only LogEvents can be projected so we avoid streaming anything else
+const logEventQuery = "EventType = 'LogEvent'"
+
+// Consumer streams LogEvents from a Burrow node and commits their projection into an SQL database block by block,
+// recording the last height committed so it can resume where it left off
+type Consumer struct {
+	Config     *VentConfig
+	Projection *Projection
+	DB         *sqldb.SQLDB
+	logger     *logging.Logger
+}
+
+func NewConsumer(config *VentConfig, logger *logging.Logger) (*Consumer, error) {
+	abiSpec, err := readAbiFiles(config.AbiFiles...)
+	if err != nil {
+		return nil, err
+	}
+	specs, err := ReadTableSpecFile(config.SpecFile)
+	if err != nil {
+		return nil, err
+	}
+	projection, err := NewProjection(abiSpec, specs...)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqldb.NewSQLDB(config.DBAdapter, config.DBURL, config.DBSchema, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Consumer{
+		Config:     config,
+		Projection: projection,
+		DB:         db,
+		logger:     logger.WithScope("Consumer"),
+	}, nil
+}
+
+// Run synchronises the database tables and then consumes events until ctx is cancelled or the stream fails
+func (c *Consumer) Run(ctx context.Context) error {
+	transportOpt, err := c.Config.GRPCTLS.GRPCDialOption()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(c.Config.GRPCAddr, transportOpt)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	status, err := rpcquery.NewQueryClient(conn).Status(ctx, &rpcquery.StatusParam{})
+	if err != nil {
+		return fmt.Errorf("could not get status of chain at %s: %v", c.Config.GRPCAddr, err)
+	}
+	start, err := c.Start(status.ChainID)
+	if err != nil {
+		return err
+	}
+	c.logger.InfoMsg("Consuming events", "chain_id", status.ChainID, "start_height", start)
+
+	stream, err := rpcevents.NewExecutionEventsClient(conn).GetEvents(ctx, &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(start), rpcevents.StreamBound()),
+		Query:      logEventQuery,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = c.Commit(status.ChainID, response.Height, response.Events)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Start synchronises the database tables and returns the height from which events for chainID should be consumed
+func (c *Consumer) Start(chainID string) (uint64, error) {
+	err := c.DB.Synchronise(c.Projection.Tables...)
+	if err != nil {
+		return 0, err
+	}
+	if c.Config.Replay {
+		return 0, nil
+	}
+	height, ok, err := c.DB.LastHeight(chainID)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return height + 1, nil
+}
+
+// Commit projects the events from the block at height into the database
+func (c *Consumer) Commit(chainID string, height uint64, evs []*exec.Event) error {
+	changes, err := c.Projection.Changes(evs)
+	if err != nil {
+		return err
+	}
+	c.logger.TraceMsg("Committing block", "height", height, "changes", len(changes))
+	return c.DB.Commit(chainID, height, changes)
+}
+
+func (c *Consumer) Close() error {
+	return c.DB.Close()
+}
+
+// Merges the events of the ABI files into a single spec
+func readAbiFiles(filenames ...string) (*abi.AbiSpec, error) {
+	abiSpec := &abi.AbiSpec{
+		Events:     make(map[string]abi.Event),
+		EventsByID: make(map[abi.EventID]abi.Event),
+	}
+	for _, filename := range filenames {
+		spec, err := abi.ReadAbiSpecFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("could not read ABI from %s: %v", filename, err)
+		}
+		for name, ev := range spec.Events {
+			abiSpec.Events[name] = ev
+			abiSpec.EventsByID[ev.EventID] = ev
+		}
+	}
+	return abiSpec, nil
+}
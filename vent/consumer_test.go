@@ -0,0 +1,155 @@
+package vent
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAbi = `[{
+	"anonymous": false,
+	"name": "UpdateName",
+	"type": "event",
+	"inputs": [
+		{"indexed": true, "name": "owner", "type": "address"},
+		{"indexed": false, "name": "name", "type": "string"},
+		{"indexed": false, "name": "score", "type": "uint32"},
+		{"indexed": false, "name": "removed", "type": "bool"}
+	]
+}]`
+
+func TestConsumer_Commit(t *testing.T) {
+	abiSpec, err := abi.ReadAbiSpec([]byte(testAbi))
+	require.NoError(t, err)
+	contract := crypto.Address{0xC0}
+	projection, err := NewProjection(abiSpec, &TableSpec{
+		TableName:         "names",
+		Event:             "UpdateName",
+		Filter:            "Address = '" + contract.String() + "'",
+		DeleteMarkerField: "removed",
+		FieldMappings: []*FieldMapping{
+			{Field: "owner", Primary: true},
+			{Field: "name", ColumnName: "owner_name"},
+			{Field: "score"},
+		},
+	})
+	require.NoError(t, err)
+	db, err := sqldb.NewSQLDB(sqldb.SQLiteAdapter, ":memory:", "", logging.NewNoopLogger())
+	require.NoError(t, err)
+	consumer := &Consumer{
+		Config:     &VentConfig{},
+		Projection: projection,
+		DB:         db,
+		logger:     logging.NewNoopLogger(),
+	}
+	defer consumer.Close()
+
+	start, err := consumer.Start("chain")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), start)
+
+	alice := crypto.Address{1}
+	bob := crypto.Address{2}
+	ev := abiSpec.Events["UpdateName"]
+	require.NoError(t, consumer.Commit("chain", 1, []*exec.Event{
+		logEvent(t, ev, contract, 1, alice, "Alice", 3, false),
+		logEvent(t, ev, contract, 1, bob, "Bob", 4, false),
+	}))
+	require.NoError(t, consumer.Commit("chain", 2, []*exec.Event{
+		logEvent(t, ev, contract, 2, alice, "Alice Smith", 5, false),
+		logEvent(t, ev, contract, 2, bob, "", 0, true),
+		// Not from our contract so should be ignored
+		logEvent(t, ev, crypto.Address{0xC1}, 2, crypto.Address{3}, "Carol", 1, false),
+	}))
+
+	start, err = consumer.Start("chain")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), start)
+
+	// Replaying is idempotent
+	consumer.Config.Replay = true
+	start, err = consumer.Start("chain")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), start)
+	require.NoError(t, consumer.Commit("chain", 1, []*exec.Event{
+		logEvent(t, ev, contract, 1, alice, "Alice", 3, false),
+	}))
+	require.NoError(t, consumer.Commit("chain", 2, []*exec.Event{
+		logEvent(t, ev, contract, 2, alice, "Alice Smith", 5, false),
+	}))
+
+	rows, err := db.DB.Query(`SELECT owner, owner_name, score, _height FROM names`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var owners []string
+	for rows.Next() {
+		var owner, name string
+		var score, height int64
+		require.NoError(t, rows.Scan(&owner, &name, &score, &height))
+		owners = append(owners, owner)
+		assert.Equal(t, alice.String(), owner)
+		assert.Equal(t, "Alice Smith", name)
+		assert.Equal(t, int64(5), score)
+		assert.Equal(t, int64(2), height)
+	}
+	assert.Len(t, owners, 1)
+}
+
+func TestNewProjection(t *testing.T) {
+	abiSpec, err := abi.ReadAbiSpec([]byte(testAbi))
+	require.NoError(t, err)
+
+	_, err = NewProjection(abiSpec, &TableSpec{
+		TableName:     "names",
+		Event:         "UpdateName",
+		FieldMappings: []*FieldMapping{{Field: "name"}},
+	})
+	assert.Error(t, err, "should require a primary key")
+
+	_, err = NewProjection(abiSpec, &TableSpec{
+		TableName:     "names",
+		Event:         "UpdateName",
+		FieldMappings: []*FieldMapping{{Field: "nonexistent", Primary: true}},
+	})
+	assert.Error(t, err, "should require fields to be event arguments")
+
+	projection, err := NewProjection(abiSpec, &TableSpec{
+		TableName:     "names",
+		Event:         "UpdateName",
+		FieldMappings: []*FieldMapping{{Field: "owner", Primary: true}, {Field: "score"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, projection.Tables, 1)
+	var types []sqldb.ColumnType
+	for _, col := range projection.Tables[0].Columns {
+		types = append(types, col.Type)
+	}
+	assert.Equal(t, []sqldb.ColumnType{sqldb.ColumnTypeText, sqldb.ColumnTypeInteger, sqldb.ColumnTypeInteger,
+		sqldb.ColumnTypeText}, types)
+}
+
+func logEvent(t *testing.T, ev abi.Event, contract crypto.Address, height uint64, owner crypto.Address, name string,
+	score uint32, removed bool) *exec.Event {
+
+	data, err := abi.Pack(ev.Inputs[1:], name, score, removed)
+	require.NoError(t, err)
+	return &exec.Event{
+		Header: &exec.Header{
+			EventType: exec.TypeLog,
+			Height:    height,
+			TxHash:    []byte{byte(height)},
+		},
+		Log: &exec.LogEvent{
+			Address: contract,
+			Topics:  []binary.Word256{binary.Word256(ev.EventID), binary.LeftPadWord256(owner.Bytes())},
+			Data:    data,
+		},
+	}
+}
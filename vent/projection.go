@@ -0,0 +1,255 @@
+package vent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/vent/sqldb"
+)
+
+// TableSpec describes how the arguments of a Solidity event are projected onto the rows of a table
+type TableSpec struct {
+	TableName string
+	// Query over the tags of the LogEvent (e.g. Address, Log0) selecting the events to project
+	Filter string
+	// Name of the ABI event whose arguments are projected
+	Event string
+	// If set names a boolean event argument that when true causes the row to be deleted rather than upserted
+	DeleteMarkerField string
+	FieldMappings     []*FieldMapping
+}
+
+type FieldMapping struct {
+	// Name of the event argument
+	Field      string
+	ColumnName string
+	Primary    bool
+	// Store fixed size bytesN arguments as (zero-trimmed) strings rather than bytes
+	BytesToString bool
+}
+
+// Projection is a compiled set of TableSpecs ready to turn LogEvents into changes to SQL tables
+type Projection struct {
+	Tables []*sqldb.Table
+	specs  []*tableProjection
+}
+
+type tableProjection struct {
+	table  *sqldb.Table
+	filter query.Query
+	event  abi.Event
+	// Index of event argument projected by each field and for the delete marker (or -1)
+	fields      map[int]*FieldMapping
+	deleteIndex int
+}
+
+// ReadTableSpecFile reads a JSON array of TableSpecs from filename
+func ReadTableSpecFile(filename string) ([]*TableSpec, error) {
+	bs, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var specs []*TableSpec
+	err = json.Unmarshal(bs, &specs)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse table specs from %s: %v", filename, err)
+	}
+	return specs, nil
+}
+
+// NewProjection validates the TableSpecs against the events in abiSpec and derives the tables they project into
+func NewProjection(abiSpec *abi.AbiSpec, specs ...*TableSpec) (*Projection, error) {
+	projection := new(Projection)
+	tableNames := make(map[string]bool)
+	for _, spec := range specs {
+		if tableNames[spec.TableName] {
+			return nil, fmt.Errorf("table %s is specified more than once", spec.TableName)
+		}
+		tableNames[spec.TableName] = true
+		tp, err := newTableProjection(abiSpec, spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spec for table %s: %v", spec.TableName, err)
+		}
+		projection.specs = append(projection.specs, tp)
+		projection.Tables = append(projection.Tables, tp.table)
+	}
+	return projection, nil
+}
+
+// Changes returns the changes to tables resulting from the LogEvents in evs
+func (p *Projection) Changes(evs []*exec.Event) ([]*sqldb.Change, error) {
+	var changes []*sqldb.Change
+	for _, ev := range evs {
+		if ev.Log == nil {
+			continue
+		}
+		tagged := ev.Tagged()
+		for _, tp := range p.specs {
+			if !tp.filter.Matches(tagged) {
+				continue
+			}
+			change, err := tp.change(ev)
+			if err != nil {
+				return nil, err
+			}
+			if change != nil {
+				changes = append(changes, change)
+			}
+		}
+	}
+	return changes, nil
+}
+
+func newTableProjection(abiSpec *abi.AbiSpec, spec *TableSpec) (*tableProjection, error) {
+	if spec.TableName == "" {
+		return nil, fmt.Errorf("TableName must be provided")
+	}
+	ev, ok := abiSpec.Events[spec.Event]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found in ABI", spec.Event)
+	}
+	filter, err := query.NewBuilder(spec.Filter).Query()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse filter: %v", err)
+	}
+	tp := &tableProjection{
+		table:       &sqldb.Table{Name: spec.TableName},
+		filter:      filter,
+		event:       ev,
+		fields:      make(map[int]*FieldMapping),
+		deleteIndex: -1,
+	}
+	if spec.DeleteMarkerField != "" {
+		tp.deleteIndex = argumentIndex(ev, spec.DeleteMarkerField)
+		if tp.deleteIndex < 0 {
+			return nil, fmt.Errorf("DeleteMarkerField %s is not an argument of event %s", spec.DeleteMarkerField,
+				ev.Name)
+		}
+		if _, ok := ev.Inputs[tp.deleteIndex].EVM.(abi.EVMBool); !ok {
+			return nil, fmt.Errorf("DeleteMarkerField %s must be a bool", spec.DeleteMarkerField)
+		}
+	}
+	hasPrimary := false
+	for _, fm := range spec.FieldMappings {
+		i := argumentIndex(ev, fm.Field)
+		if i < 0 {
+			return nil, fmt.Errorf("field %s is not an argument of event %s", fm.Field, ev.Name)
+		}
+		arg := ev.Inputs[i]
+		if arg.IsArray && !arg.Indexed {
+			return nil, fmt.Errorf("field %s is an array which cannot be projected into a column", fm.Field)
+		}
+		if fm.ColumnName == "" {
+			fm.ColumnName = fm.Field
+		}
+		hasPrimary = hasPrimary || fm.Primary
+		tp.fields[i] = fm
+		tp.table.Columns = append(tp.table.Columns, &sqldb.Column{
+			Name:    fm.ColumnName,
+			Type:    columnType(arg, fm),
+			Primary: fm.Primary,
+		})
+	}
+	if !hasPrimary {
+		return nil, fmt.Errorf("at least one field must be a primary key")
+	}
+	tp.table.Columns = append(tp.table.Columns,
+		&sqldb.Column{Name: sqldb.HeightColumnName, Type: sqldb.ColumnTypeInteger},
+		&sqldb.Column{Name: sqldb.TxHashColumnName, Type: sqldb.ColumnTypeText})
+	return tp, nil
+}
+
+func (tp *tableProjection) change(ev *exec.Event) (*sqldb.Change, error) {
+	// Unpack everything as strings and convert to the column type afterwards since every EVM type supports that
+	values := make([]string, len(tp.event.Inputs))
+	bytesValues := make([][]byte, len(tp.event.Inputs))
+	args := make([]interface{}, len(tp.event.Inputs))
+	for i, arg := range tp.event.Inputs {
+		fm := tp.fields[i]
+		if fm != nil && columnType(arg, fm) == sqldb.ColumnTypeBytes {
+			args[i] = &bytesValues[i]
+		} else {
+			args[i] = &values[i]
+		}
+	}
+	if !tp.event.Anonymous && ev.Log.GetTopic(0) != binary.Word256(tp.event.EventID) {
+		// The filter matched an event with a different signature so it is not ours to project
+		return nil, nil
+	}
+	err := abi.UnpackEvent(&tp.event, ev.Log.Topics, ev.Log.Data, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack event %s: %v", tp.event.Name, err)
+	}
+	row := sqldb.Row{
+		sqldb.HeightColumnName: int64(ev.Header.Height),
+		sqldb.TxHashColumnName: ev.Header.TxHash.String(),
+	}
+	for i, fm := range tp.fields {
+		value, err := columnValue(columnType(tp.event.Inputs[i], fm), values[i], bytesValues[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not convert field %s of event %s: %v", fm.Field, tp.event.Name, err)
+		}
+		row[fm.ColumnName] = value
+	}
+	return &sqldb.Change{
+		Table:  tp.table,
+		Row:    row,
+		Delete: tp.deleteIndex >= 0 && values[tp.deleteIndex] == "true",
+	}, nil
+}
+
+func argumentIndex(ev abi.Event, name string) int {
+	for i, arg := range ev.Inputs {
+		if arg.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func columnType(arg abi.Argument, fm *FieldMapping) sqldb.ColumnType {
+	if arg.IsArray || (arg.Indexed && arg.EVM.GetSignature() == "string") {
+		// Only the hash of indexed dynamic arguments is available
+		return sqldb.ColumnTypeText
+	}
+	switch evm := arg.EVM.(type) {
+	case abi.EVMBool:
+		return sqldb.ColumnTypeBool
+	case abi.EVMInt:
+		if evm.M <= 64 {
+			return sqldb.ColumnTypeInteger
+		}
+		return sqldb.ColumnTypeNumeric
+	case abi.EVMUint:
+		if evm.M < 64 {
+			return sqldb.ColumnTypeInteger
+		}
+		return sqldb.ColumnTypeNumeric
+	case abi.EVMBytes:
+		if fm.BytesToString || (evm.M == 0 && arg.Indexed) {
+			return sqldb.ColumnTypeText
+		}
+		return sqldb.ColumnTypeBytes
+	default:
+		return sqldb.ColumnTypeText
+	}
+}
+
+func columnValue(typ sqldb.ColumnType, value string, bytesValue []byte) (interface{}, error) {
+	switch typ {
+	case sqldb.ColumnTypeBool:
+		return value == "true", nil
+	case sqldb.ColumnTypeInteger:
+		return strconv.ParseInt(value, 10, 64)
+	case sqldb.ColumnTypeBytes:
+		return bytesValue, nil
+	default:
+		return value, nil
+	}
+}
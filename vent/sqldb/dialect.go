@@ -0,0 +1,128 @@
+package sqldb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	PostgresAdapter = "postgres"
+	SQLiteAdapter   = "sqlite3"
+)
+
+// Dialect captures the differences between the SQL databases we can project into
+type Dialect interface {
+	// Name of the database/sql driver to open
+	DriverName() string
+	// Fully qualified and quoted name of table
+	TableName(schema, table string) string
+	// Statement creating schema if it does not exist, empty if schemas are not supported
+	CreateSchemaQuery(schema string) string
+	// Query and its arguments counting the tables named table in schema, so 1 if the table exists and 0 otherwise
+	TableExistsQuery(schema, table string) (string, []interface{})
+	ColumnType(typ ColumnType) string
+	// Placeholder for the nth (1-indexed) query parameter
+	Placeholder(n int) string
+}
+
+func DialectFromAdapter(adapter string) (Dialect, error) {
+	switch adapter {
+	case PostgresAdapter:
+		return postgresDialect{}, nil
+	case SQLiteAdapter:
+		return sqliteDialect{}, nil
+	default:
+		return nil, fmt.Errorf("unknown database adapter '%s', supported adapters are: %s, %s", adapter,
+			PostgresAdapter, SQLiteAdapter)
+	}
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) DriverName() string {
+	return PostgresAdapter
+}
+
+func (postgresDialect) TableName(schema, table string) string {
+	if schema == "" {
+		return quoteIdentifier(table)
+	}
+	return quoteIdentifier(schema) + "." + quoteIdentifier(table)
+}
+
+func (postgresDialect) CreateSchemaQuery(schema string) string {
+	if schema == "" {
+		return ""
+	}
+	return fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", quoteIdentifier(schema))
+}
+
+func (postgresDialect) TableExistsQuery(schema, table string) (string, []interface{}) {
+	if schema == "" {
+		return "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() " +
+			"AND table_name = $1", []interface{}{table}
+	}
+	return "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = $1 AND table_name = $2",
+		[]interface{}{schema, table}
+}
+
+func (postgresDialect) ColumnType(typ ColumnType) string {
+	switch typ {
+	case ColumnTypeBool:
+		return "BOOLEAN"
+	case ColumnTypeInteger:
+		return "BIGINT"
+	case ColumnTypeNumeric:
+		// Enough digits for a uint256
+		return "NUMERIC(78)"
+	case ColumnTypeBytes:
+		return "BYTEA"
+	default:
+		return "TEXT"
+	}
+}
+
+func (postgresDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) DriverName() string {
+	return SQLiteAdapter
+}
+
+// SQLite has no schemas so we ignore the schema
+func (sqliteDialect) TableName(schema, table string) string {
+	return quoteIdentifier(table)
+}
+
+func (sqliteDialect) CreateSchemaQuery(schema string) string {
+	return ""
+}
+
+func (sqliteDialect) TableExistsQuery(schema, table string) (string, []interface{}) {
+	return "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", []interface{}{table}
+}
+
+func (sqliteDialect) ColumnType(typ ColumnType) string {
+	switch typ {
+	case ColumnTypeBool:
+		return "BOOLEAN"
+	case ColumnTypeInteger:
+		return "INTEGER"
+	case ColumnTypeBytes:
+		return "BLOB"
+	default:
+		// SQLite numerics are at most 64 bits so store larger numbers in their decimal representation
+		return "TEXT"
+	}
+}
+
+func (sqliteDialect) Placeholder(n int) string {
+	return "?"
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
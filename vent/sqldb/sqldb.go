@@ -0,0 +1,286 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/logging"
+	// Register the drivers for our supported dialects
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Table used to track the height of the last block projected for each chain
+const ChainTableName = "_vent_chain"
+
+// Columns projected into every table to identify the origin of a row
+const (
+	HeightColumnName = "_height"
+	TxHashColumnName = "_txhash"
+)
+
+type ColumnType int
+
+const (
+	ColumnTypeText ColumnType = iota
+	ColumnTypeBool
+	// Integers that fit into an int64
+	ColumnTypeInteger
+	// Integers of up to 256 bits, passed as decimal strings
+	ColumnTypeNumeric
+	ColumnTypeBytes
+)
+
+type Column struct {
+	Name    string
+	Type    ColumnType
+	Primary bool
+}
+
+type Table struct {
+	Name    string
+	Columns []*Column
+}
+
+// A Row maps column names to values
+type Row map[string]interface{}
+
+// A Change is a row to be upserted into or deleted from a table
+type Change struct {
+	Table  *Table
+	Row    Row
+	Delete bool
+}
+
+type SQLDB struct {
+	DB      *sql.DB
+	Schema  string
+	dialect Dialect
+	logger  *logging.Logger
+}
+
+func NewSQLDB(adapter, url, schema string, logger *logging.Logger) (*SQLDB, error) {
+	dialect, err := DialectFromAdapter(adapter)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open(dialect.DriverName(), url)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s database: %v", adapter, err)
+	}
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("could not connect to %s database: %v", adapter, err)
+	}
+	if adapter == SQLiteAdapter {
+		// SQLite only supports a single writer
+		db.SetMaxOpenConns(1)
+	}
+	return &SQLDB{
+		DB:      db,
+		Schema:  schema,
+		dialect: dialect,
+		logger:  logger.WithScope("SQLDB"),
+	}, nil
+}
+
+func (db *SQLDB) Close() error {
+	return db.DB.Close()
+}
+
+// Synchronise creates any tables (including the chain table) that do not exist and adds any columns missing from
+// existing tables. Columns are never dropped or altered so existing data is preserved.
+func (db *SQLDB) Synchronise(tables ...*Table) error {
+	if query := db.dialect.CreateSchemaQuery(db.Schema); query != "" {
+		_, err := db.DB.Exec(query)
+		if err != nil {
+			return fmt.Errorf("could not create schema %s: %v", db.Schema, err)
+		}
+	}
+	for _, table := range append([]*Table{chainTable()}, tables...) {
+		err := db.synchroniseTable(table)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LastHeight returns the height of the last block committed for chainID or false if no block has been committed
+func (db *SQLDB) LastHeight(chainID string) (uint64, bool, error) {
+	var height int64
+	err := db.DB.QueryRow(fmt.Sprintf("SELECT height FROM %s WHERE chain_id = %s",
+		db.tableName(ChainTableName), db.dialect.Placeholder(1)), chainID).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("could not read last height for chain %s: %v", chainID, err)
+	}
+	return uint64(height), true, nil
+}
+
+// Commit applies the changes projected from the block at height and records height as the last committed height
+// for chainID atomically. Since rows are upserted on their primary key replaying a block is idempotent.
+func (db *SQLDB) Commit(chainID string, height uint64, changes []*Change) (err error) {
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	for _, change := range changes {
+		query, args, err := db.changeQuery(change)
+		if err != nil {
+			return err
+		}
+		db.logger.TraceMsg("Applying change", "query", query, "args", args)
+		_, err = tx.Exec(query, args...)
+		if err != nil {
+			return fmt.Errorf("could not apply change to table %s at height %d: %v", change.Table.Name, height, err)
+		}
+	}
+	query, args, err := db.changeQuery(&Change{
+		Table: chainTable(),
+		Row: Row{
+			"chain_id": chainID,
+			"height":   int64(height),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("could not record height %d for chain %s: %v", height, chainID, err)
+	}
+	return tx.Commit()
+}
+
+func (db *SQLDB) synchroniseTable(table *Table) error {
+	existing, err := db.existingColumns(table)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		var defs, primaryKey []string
+		for _, col := range table.Columns {
+			defs = append(defs, fmt.Sprintf("%s %s", quoteIdentifier(col.Name), db.dialect.ColumnType(col.Type)))
+			if col.Primary {
+				primaryKey = append(primaryKey, quoteIdentifier(col.Name))
+			}
+		}
+		if len(primaryKey) == 0 {
+			return fmt.Errorf("table %s has no primary key columns", table.Name)
+		}
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(primaryKey, ", ")))
+		db.logger.InfoMsg("Creating table", "table", table.Name)
+		_, err = db.DB.Exec(fmt.Sprintf("CREATE TABLE %s (%s)", db.tableName(table.Name), strings.Join(defs, ", ")))
+		if err != nil {
+			return fmt.Errorf("could not create table %s: %v", table.Name, err)
+		}
+		return nil
+	}
+	for _, col := range table.Columns {
+		if existing[col.Name] {
+			continue
+		}
+		if col.Primary {
+			return fmt.Errorf("cannot add primary key column %s to existing table %s", col.Name, table.Name)
+		}
+		db.logger.InfoMsg("Adding column", "table", table.Name, "column", col.Name)
+		_, err = db.DB.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", db.tableName(table.Name),
+			quoteIdentifier(col.Name), db.dialect.ColumnType(col.Type)))
+		if err != nil {
+			return fmt.Errorf("could not add column %s to table %s: %v", col.Name, table.Name, err)
+		}
+	}
+	return nil
+}
+
+// Returns the set of column names in table or nil if the table does not exist
+func (db *SQLDB) existingColumns(table *Table) (map[string]bool, error) {
+	var count int
+	query, args := db.dialect.TableExistsQuery(db.Schema, table.Name)
+	err := db.DB.QueryRow(query, args...).Scan(&count)
+	if err != nil {
+		return nil, fmt.Errorf("could not check whether table %s exists: %v", table.Name, err)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	rows, err := db.DB.Query(fmt.Sprintf("SELECT * FROM %s WHERE 1 = 0", db.tableName(table.Name)))
+	if err != nil {
+		return nil, fmt.Errorf("could not read columns of table %s: %v", table.Name, err)
+	}
+	defer rows.Close()
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("could not read columns of table %s: %v", table.Name, err)
+	}
+	columns := make(map[string]bool, len(names))
+	for _, name := range names {
+		columns[name] = true
+	}
+	return columns, nil
+}
+
+func (db *SQLDB) changeQuery(change *Change) (string, []interface{}, error) {
+	var columns, primaryKey, updates, placeholders, conditions []string
+	var args []interface{}
+	for _, col := range change.Table.Columns {
+		value, ok := change.Row[col.Name]
+		if !ok {
+			if col.Primary {
+				return "", nil, fmt.Errorf("row for table %s is missing value for primary key column %s",
+					change.Table.Name, col.Name)
+			}
+			continue
+		}
+		name := quoteIdentifier(col.Name)
+		if change.Delete {
+			if col.Primary {
+				args = append(args, value)
+				conditions = append(conditions, fmt.Sprintf("%s = %s", name, db.dialect.Placeholder(len(args))))
+			}
+			continue
+		}
+		args = append(args, value)
+		columns = append(columns, name)
+		placeholders = append(placeholders, db.dialect.Placeholder(len(args)))
+		if col.Primary {
+			primaryKey = append(primaryKey, name)
+		} else {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", name, name))
+		}
+	}
+	tableName := db.tableName(change.Table.Name)
+	if change.Delete {
+		return fmt.Sprintf("DELETE FROM %s WHERE %s", tableName, strings.Join(conditions, " AND ")), args, nil
+	}
+	onConflict := "DO NOTHING"
+	if len(updates) > 0 {
+		onConflict = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) %s", tableName, strings.Join(columns, ", "),
+		strings.Join(placeholders, ", "), strings.Join(primaryKey, ", "), onConflict), args, nil
+}
+
+func (db *SQLDB) tableName(table string) string {
+	return db.dialect.TableName(db.Schema, table)
+}
+
+func chainTable() *Table {
+	return &Table{
+		Name: ChainTableName,
+		Columns: []*Column{
+			{Name: "chain_id", Type: ColumnTypeText, Primary: true},
+			{Name: "height", Type: ColumnTypeInteger},
+		},
+	}
+}
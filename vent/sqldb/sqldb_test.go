@@ -0,0 +1,125 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLDB_Commit(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	table := &Table{
+		Name: "balances",
+		Columns: []*Column{
+			{Name: "owner", Type: ColumnTypeText, Primary: true},
+			{Name: "balance", Type: ColumnTypeInteger},
+		},
+	}
+	require.NoError(t, db.Synchronise(table))
+
+	_, ok, err := db.LastHeight("chain")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, db.Commit("chain", 3, []*Change{
+		{Table: table, Row: Row{"owner": "alice", "balance": 10}},
+		{Table: table, Row: Row{"owner": "bob", "balance": 20}},
+	}))
+	// Replaying the same block and updating rows should not create duplicates
+	require.NoError(t, db.Commit("chain", 3, []*Change{
+		{Table: table, Row: Row{"owner": "alice", "balance": 10}},
+		{Table: table, Row: Row{"owner": "bob", "balance": 20}},
+	}))
+	require.NoError(t, db.Commit("chain", 4, []*Change{
+		{Table: table, Row: Row{"owner": "alice", "balance": 15}},
+		{Table: table, Row: Row{"owner": "bob"}, Delete: true},
+	}))
+
+	height, ok, err := db.LastHeight("chain")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(4), height)
+
+	assert.Equal(t, map[string]int64{"alice": 15}, balances(t, db))
+}
+
+func TestSQLDB_CommitRollback(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	table := &Table{
+		Name:    "things",
+		Columns: []*Column{{Name: "id", Type: ColumnTypeInteger, Primary: true}},
+	}
+	require.NoError(t, db.Synchronise(table))
+	err := db.Commit("chain", 1, []*Change{
+		{Table: table, Row: Row{"id": 1}},
+		{Table: table, Row: Row{}},
+	})
+	require.Error(t, err)
+	_, ok, err := db.LastHeight("chain")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSQLDB_SynchroniseAddsColumns(t *testing.T) {
+	db := newTestDB(t)
+	defer db.Close()
+
+	table := &Table{
+		Name: "balances",
+		Columns: []*Column{
+			{Name: "owner", Type: ColumnTypeText, Primary: true},
+		},
+	}
+	require.NoError(t, db.Synchronise(table))
+	require.NoError(t, db.Commit("chain", 1, []*Change{{Table: table, Row: Row{"owner": "alice"}}}))
+
+	table.Columns = append(table.Columns, &Column{Name: "balance", Type: ColumnTypeInteger})
+	require.NoError(t, db.Synchronise(table))
+	require.NoError(t, db.Commit("chain", 2, []*Change{{Table: table, Row: Row{"owner": "alice", "balance": 7}}}))
+	assert.Equal(t, map[string]int64{"alice": 7}, balances(t, db))
+}
+
+func TestSQLDB_SynchroniseReportsErrors(t *testing.T) {
+	db := newTestDB(t)
+	table := &Table{
+		Name: "balances",
+		Columns: []*Column{
+			{Name: "owner", Type: ColumnTypeText, Primary: true},
+		},
+	}
+	existing, err := db.existingColumns(table)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// A failing database is not mistaken for a missing table
+	require.NoError(t, db.Close())
+	_, err = db.existingColumns(table)
+	assert.Error(t, err)
+	assert.Error(t, db.Synchronise(table))
+}
+
+func newTestDB(t *testing.T) *SQLDB {
+	db, err := NewSQLDB(SQLiteAdapter, ":memory:", "", logging.NewNoopLogger())
+	require.NoError(t, err)
+	return db
+}
+
+func balances(t *testing.T, db *SQLDB) map[string]int64 {
+	rows, err := db.DB.Query(`SELECT owner, balance FROM balances`)
+	require.NoError(t, err)
+	defer rows.Close()
+	bals := make(map[string]int64)
+	for rows.Next() {
+		var owner string
+		var balance int64
+		require.NoError(t, rows.Scan(&owner, &balance))
+		bals[owner] = balance
+	}
+	return bals
+}